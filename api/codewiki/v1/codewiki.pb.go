@@ -29,6 +29,7 @@ type RepoType int32
 const (
 	RepoType_Local  RepoType = 0 // 本地
 	RepoType_Github RepoType = 1 //github
+	RepoType_Git    RepoType = 2 //其他远端 git 仓库
 )
 
 // Enum value maps for RepoType.
@@ -36,10 +37,12 @@ var (
 	RepoType_name = map[int32]string{
 		0: "Local",
 		1: "Github",
+		2: "Git",
 	}
 	RepoType_value = map[string]int32{
 		"Local":  0,
 		"Github": 1,
+		"Git":    2,
 	}
 )
 
@@ -122,89 +125,4006 @@ func (Language) EnumDescriptor() ([]byte, []int) {
 	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{1}
 }
 
-type FunScope int32
+// 调用关系解析方式
+type CallResolver int32
 
 const (
-	FunScope_Default   FunScope = 0
-	FunScope_Struct    FunScope = 1
-	FunScope_Interface FunScope = 2
-	FunScope_Constant  FunScope = 3
-	FunScope_Variable  FunScope = 4
+	CallResolver_Heuristic   CallResolver = 0 // AST 启发式解析
+	CallResolver_TypeChecked CallResolver = 1 // go/types 类型检查解析，失败的文件回退到启发式
 )
 
-// Enum value maps for FunScope.
+// Enum value maps for CallResolver.
 var (
-	FunScope_name = map[int32]string{
-		0: "Default",
-		1: "Struct",
-		2: "Interface",
-		3: "Constant",
-		4: "Variable",
+	CallResolver_name = map[int32]string{
+		0: "Heuristic",
+		1: "TypeChecked",
 	}
-	FunScope_value = map[string]int32{
-		"Default":   0,
-		"Struct":    1,
-		"Interface": 2,
-		"Constant":  3,
-		"Variable":  4,
+	CallResolver_value = map[string]int32{
+		"Heuristic":   0,
+		"TypeChecked": 1,
+	}
+)
+
+func (x CallResolver) Enum() *CallResolver {
+	p := new(CallResolver)
+	*p = x
+	return p
+}
+
+func (x CallResolver) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallResolver) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[2].Descriptor()
+}
+
+func (CallResolver) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[2]
+}
+
+func (x CallResolver) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallResolver.Descriptor instead.
+func (CallResolver) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{2}
+}
+
+// 分析任务状态
+type JobStatus int32
+
+const (
+	JobStatus_Pending   JobStatus = 0 // 排队中
+	JobStatus_Running   JobStatus = 1 // 运行中
+	JobStatus_Succeeded JobStatus = 2 // 成功
+	JobStatus_Failed    JobStatus = 3 // 失败
+	JobStatus_Canceled  JobStatus = 4 // 已取消
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "Pending",
+		1: "Running",
+		2: "Succeeded",
+		3: "Failed",
+		4: "Canceled",
+	}
+	JobStatus_value = map[string]int32{
+		"Pending":   0,
+		"Running":   1,
+		"Succeeded": 2,
+		"Failed":    3,
+		"Canceled":  4,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[3].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[3]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{3}
+}
+
+// 分析任务阶段
+type AnalysisPhase int32
+
+const (
+	AnalysisPhase_PhaseQueued    AnalysisPhase = 0 // 未开始
+	AnalysisPhase_PhaseParse     AnalysisPhase = 1 // 解析源文件
+	AnalysisPhase_PhaseClassify  AnalysisPhase = 2 // 归类继承和方法
+	AnalysisPhase_PhaseRelations AnalysisPhase = 3 // 分析关系
+	AnalysisPhase_PhasePersist   AnalysisPhase = 4 // 写入图数据库
+	AnalysisPhase_PhaseIndex     AnalysisPhase = 5 // 建立向量索引
+	AnalysisPhase_PhaseDone      AnalysisPhase = 6 // 结束
+	AnalysisPhase_PhaseCheckout  AnalysisPhase = 7 // 克隆或拉取远端仓库，在解析之前
+)
+
+// Enum value maps for AnalysisPhase.
+var (
+	AnalysisPhase_name = map[int32]string{
+		0: "PhaseQueued",
+		1: "PhaseParse",
+		2: "PhaseClassify",
+		3: "PhaseRelations",
+		4: "PhasePersist",
+		5: "PhaseIndex",
+		6: "PhaseDone",
+		7: "PhaseCheckout",
+	}
+	AnalysisPhase_value = map[string]int32{
+		"PhaseQueued":    0,
+		"PhaseParse":     1,
+		"PhaseClassify":  2,
+		"PhaseRelations": 3,
+		"PhasePersist":   4,
+		"PhaseIndex":     5,
+		"PhaseDone":      6,
+		"PhaseCheckout":  7,
+	}
+)
+
+func (x AnalysisPhase) Enum() *AnalysisPhase {
+	p := new(AnalysisPhase)
+	*p = x
+	return p
+}
+
+func (x AnalysisPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalysisPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[4].Descriptor()
+}
+
+func (AnalysisPhase) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[4]
+}
+
+func (x AnalysisPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalysisPhase.Descriptor instead.
+func (AnalysisPhase) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{4}
+}
+
+// 调用链展开方向
+type CallDirection int32
+
+const (
+	CallDirection_CallOutgoing CallDirection = 0 // 被调用的函数
+	CallDirection_CallIncoming CallDirection = 1 // 调用方
+	CallDirection_CallBoth     CallDirection = 2 // 两个方向
+)
+
+// Enum value maps for CallDirection.
+var (
+	CallDirection_name = map[int32]string{
+		0: "CallOutgoing",
+		1: "CallIncoming",
+		2: "CallBoth",
+	}
+	CallDirection_value = map[string]int32{
+		"CallOutgoing": 0,
+		"CallIncoming": 1,
+		"CallBoth":     2,
+	}
+)
+
+func (x CallDirection) Enum() *CallDirection {
+	p := new(CallDirection)
+	*p = x
+	return p
+}
+
+func (x CallDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[5].Descriptor()
+}
+
+func (CallDirection) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[5]
+}
+
+func (x CallDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallDirection.Descriptor instead.
+func (CallDirection) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{5}
+}
+
+// 程序入口类型
+type EntrypointKind int32
+
+const (
+	EntrypointKind_EntrypointMain     EntrypointKind = 0 // main 函数
+	EntrypointKind_EntrypointHTTP     EntrypointKind = 1 // HTTP 处理函数，按参数类型识别
+	EntrypointKind_EntrypointExported EntrypointKind = 2 // 包外可见的函数和方法
+	EntrypointKind_EntrypointTest     EntrypointKind = 3 // 测试函数，例如 Go 的 TestXxx、BenchmarkXxx
+)
+
+// Enum value maps for EntrypointKind.
+var (
+	EntrypointKind_name = map[int32]string{
+		0: "EntrypointMain",
+		1: "EntrypointHTTP",
+		2: "EntrypointExported",
+		3: "EntrypointTest",
+	}
+	EntrypointKind_value = map[string]int32{
+		"EntrypointMain":     0,
+		"EntrypointHTTP":     1,
+		"EntrypointExported": 2,
+		"EntrypointTest":     3,
+	}
+)
+
+func (x EntrypointKind) Enum() *EntrypointKind {
+	p := new(EntrypointKind)
+	*p = x
+	return p
+}
+
+func (x EntrypointKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntrypointKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[6].Descriptor()
+}
+
+func (EntrypointKind) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[6]
+}
+
+func (x EntrypointKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntrypointKind.Descriptor instead.
+func (EntrypointKind) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{6}
+}
+
+// 导出 API 的变更类型
+type APIChangeKind int32
+
+const (
+	APIChangeKind_FuncAdded              APIChangeKind = 0
+	APIChangeKind_FuncRemoved            APIChangeKind = 1
+	APIChangeKind_SignatureChanged       APIChangeKind = 2 // 函数、方法或接口方法的参数、返回值或接收者变化
+	APIChangeKind_MethodAdded            APIChangeKind = 3
+	APIChangeKind_MethodRemoved          APIChangeKind = 4
+	APIChangeKind_InterfaceMethodAdded   APIChangeKind = 5 // 已有实现不再满足接口
+	APIChangeKind_InterfaceMethodRemoved APIChangeKind = 6
+	APIChangeKind_FieldAdded             APIChangeKind = 7
+	APIChangeKind_FieldRemoved           APIChangeKind = 8
+	APIChangeKind_FieldTypeChanged       APIChangeKind = 9
+	APIChangeKind_TypeAdded              APIChangeKind = 10
+	APIChangeKind_TypeRemoved            APIChangeKind = 11
+	APIChangeKind_TypeKindChanged        APIChangeKind = 12 // 类型的种类或底层类型变化，例如结构体改为接口
+	APIChangeKind_ValueAdded             APIChangeKind = 13 // 常量或变量
+	APIChangeKind_ValueRemoved           APIChangeKind = 14
+	APIChangeKind_ValueKindChanged       APIChangeKind = 15 // 常量和变量互相转换
+)
+
+// Enum value maps for APIChangeKind.
+var (
+	APIChangeKind_name = map[int32]string{
+		0:  "FuncAdded",
+		1:  "FuncRemoved",
+		2:  "SignatureChanged",
+		3:  "MethodAdded",
+		4:  "MethodRemoved",
+		5:  "InterfaceMethodAdded",
+		6:  "InterfaceMethodRemoved",
+		7:  "FieldAdded",
+		8:  "FieldRemoved",
+		9:  "FieldTypeChanged",
+		10: "TypeAdded",
+		11: "TypeRemoved",
+		12: "TypeKindChanged",
+		13: "ValueAdded",
+		14: "ValueRemoved",
+		15: "ValueKindChanged",
+	}
+	APIChangeKind_value = map[string]int32{
+		"FuncAdded":              0,
+		"FuncRemoved":            1,
+		"SignatureChanged":       2,
+		"MethodAdded":            3,
+		"MethodRemoved":          4,
+		"InterfaceMethodAdded":   5,
+		"InterfaceMethodRemoved": 6,
+		"FieldAdded":             7,
+		"FieldRemoved":           8,
+		"FieldTypeChanged":       9,
+		"TypeAdded":              10,
+		"TypeRemoved":            11,
+		"TypeKindChanged":        12,
+		"ValueAdded":             13,
+		"ValueRemoved":           14,
+		"ValueKindChanged":       15,
+	}
+)
+
+func (x APIChangeKind) Enum() *APIChangeKind {
+	p := new(APIChangeKind)
+	*p = x
+	return p
+}
+
+func (x APIChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APIChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[7].Descriptor()
+}
+
+func (APIChangeKind) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[7]
+}
+
+func (x APIChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use APIChangeKind.Descriptor instead.
+func (APIChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{7}
+}
+
+// 建议的语义化版本升级
+type SemverBump int32
+
+const (
+	SemverBump_BumpPatch SemverBump = 0 // 导出 API 没有变化
+	SemverBump_BumpMinor SemverBump = 1 // 只有兼容的新增
+	SemverBump_BumpMajor SemverBump = 2 // 有不兼容的变更
+)
+
+// Enum value maps for SemverBump.
+var (
+	SemverBump_name = map[int32]string{
+		0: "BumpPatch",
+		1: "BumpMinor",
+		2: "BumpMajor",
+	}
+	SemverBump_value = map[string]int32{
+		"BumpPatch": 0,
+		"BumpMinor": 1,
+		"BumpMajor": 2,
+	}
+)
+
+func (x SemverBump) Enum() *SemverBump {
+	p := new(SemverBump)
+	*p = x
+	return p
+}
+
+func (x SemverBump) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SemverBump) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[8].Descriptor()
+}
+
+func (SemverBump) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[8]
+}
+
+func (x SemverBump) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SemverBump.Descriptor instead.
+func (SemverBump) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{8}
+}
+
+// 符号类型，对应图中的节点标签
+type SymbolKind int32
+
+const (
+	SymbolKind_SymbolPackage  SymbolKind = 0
+	SymbolKind_SymbolFile     SymbolKind = 1
+	SymbolKind_SymbolEntity   SymbolKind = 2
+	SymbolKind_SymbolFunction SymbolKind = 3
+	SymbolKind_SymbolField    SymbolKind = 4
+)
+
+// Enum value maps for SymbolKind.
+var (
+	SymbolKind_name = map[int32]string{
+		0: "SymbolPackage",
+		1: "SymbolFile",
+		2: "SymbolEntity",
+		3: "SymbolFunction",
+		4: "SymbolField",
+	}
+	SymbolKind_value = map[string]int32{
+		"SymbolPackage":  0,
+		"SymbolFile":     1,
+		"SymbolEntity":   2,
+		"SymbolFunction": 3,
+		"SymbolField":    4,
+	}
+)
+
+func (x SymbolKind) Enum() *SymbolKind {
+	p := new(SymbolKind)
+	*p = x
+	return p
+}
+
+func (x SymbolKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SymbolKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[9].Descriptor()
+}
+
+func (SymbolKind) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[9]
+}
+
+func (x SymbolKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SymbolKind.Descriptor instead.
+func (SymbolKind) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{9}
+}
+
+// 符号名称匹配方式
+type SymbolMatchMode int32
+
+const (
+	SymbolMatchMode_MatchPrefix SymbolMatchMode = 0 // 名称或名称中的单词以 query 开头，不区分大小写
+	SymbolMatchMode_MatchFuzzy  SymbolMatchMode = 1 // 允许拼写错误，最多两处编辑
+	SymbolMatchMode_MatchRegex  SymbolMatchMode = 2 // 正则表达式，匹配名称的任意部分
+)
+
+// Enum value maps for SymbolMatchMode.
+var (
+	SymbolMatchMode_name = map[int32]string{
+		0: "MatchPrefix",
+		1: "MatchFuzzy",
+		2: "MatchRegex",
+	}
+	SymbolMatchMode_value = map[string]int32{
+		"MatchPrefix": 0,
+		"MatchFuzzy":  1,
+		"MatchRegex":  2,
+	}
+)
+
+func (x SymbolMatchMode) Enum() *SymbolMatchMode {
+	p := new(SymbolMatchMode)
+	*p = x
+	return p
+}
+
+func (x SymbolMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SymbolMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[10].Descriptor()
+}
+
+func (SymbolMatchMode) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[10]
+}
+
+func (x SymbolMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SymbolMatchMode.Descriptor instead.
+func (SymbolMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{10}
+}
+
+// 引用的方式
+type ReferenceKind int32
+
+const (
+	ReferenceKind_RefOther       ReferenceKind = 0 // 其他用法，例如把函数作为值传递
+	ReferenceKind_RefCall        ReferenceKind = 1 // 调用函数或方法
+	ReferenceKind_RefField       ReferenceKind = 2 // 读写字段
+	ReferenceKind_RefType        ReferenceKind = 3 // 使用类型，例如声明变量、字段、参数的类型或继承
+	ReferenceKind_RefDeclaration ReferenceKind = 4 // 符号自身的声明
+)
+
+// Enum value maps for ReferenceKind.
+var (
+	ReferenceKind_name = map[int32]string{
+		0: "RefOther",
+		1: "RefCall",
+		2: "RefField",
+		3: "RefType",
+		4: "RefDeclaration",
+	}
+	ReferenceKind_value = map[string]int32{
+		"RefOther":       0,
+		"RefCall":        1,
+		"RefField":       2,
+		"RefType":        3,
+		"RefDeclaration": 4,
+	}
+)
+
+func (x ReferenceKind) Enum() *ReferenceKind {
+	p := new(ReferenceKind)
+	*p = x
+	return p
+}
+
+func (x ReferenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[11].Descriptor()
+}
+
+func (ReferenceKind) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[11]
+}
+
+func (x ReferenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferenceKind.Descriptor instead.
+func (ReferenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{11}
+}
+
+// 架构规则的类型
+type ArchitectureRuleKind int32
+
+const (
+	ArchitectureRuleKind_RuleForbidDependency ArchitectureRuleKind = 0 // from 中的包不能依赖 to 中的包
+	ArchitectureRuleKind_RuleAllowDependency  ArchitectureRuleKind = 1 // from 中的包只能依赖 to 中的包，from 相同的多条规则取并集
+	ArchitectureRuleKind_RuleForbidCall       ArchitectureRuleKind = 2 // from 中的函数不能调用 to 中的函数
+)
+
+// Enum value maps for ArchitectureRuleKind.
+var (
+	ArchitectureRuleKind_name = map[int32]string{
+		0: "RuleForbidDependency",
+		1: "RuleAllowDependency",
+		2: "RuleForbidCall",
+	}
+	ArchitectureRuleKind_value = map[string]int32{
+		"RuleForbidDependency": 0,
+		"RuleAllowDependency":  1,
+		"RuleForbidCall":       2,
+	}
+)
+
+func (x ArchitectureRuleKind) Enum() *ArchitectureRuleKind {
+	p := new(ArchitectureRuleKind)
+	*p = x
+	return p
+}
+
+func (x ArchitectureRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchitectureRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[12].Descriptor()
+}
+
+func (ArchitectureRuleKind) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[12]
+}
+
+func (x ArchitectureRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchitectureRuleKind.Descriptor instead.
+func (ArchitectureRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{12}
+}
+
+type FunScope int32
+
+const (
+	FunScope_Default   FunScope = 0
+	FunScope_Struct    FunScope = 1
+	FunScope_Interface FunScope = 2
+	FunScope_Constant  FunScope = 3
+	FunScope_Variable  FunScope = 4
+)
+
+// Enum value maps for FunScope.
+var (
+	FunScope_name = map[int32]string{
+		0: "Default",
+		1: "Struct",
+		2: "Interface",
+		3: "Constant",
+		4: "Variable",
+	}
+	FunScope_value = map[string]int32{
+		"Default":   0,
+		"Struct":    1,
+		"Interface": 2,
+		"Constant":  3,
+		"Variable":  4,
+	}
+)
+
+func (x FunScope) Enum() *FunScope {
+	p := new(FunScope)
+	*p = x
+	return p
+}
+
+func (x FunScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FunScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_codewiki_v1_codewiki_proto_enumTypes[13].Descriptor()
+}
+
+func (FunScope) Type() protoreflect.EnumType {
+	return &file_codewiki_v1_codewiki_proto_enumTypes[13]
+}
+
+func (x FunScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FunScope.Descriptor instead.
+func (FunScope) EnumDescriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{13}
+}
+
+type AnalyzeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoType      RepoType               `protobuf:"varint,1,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Includes      []string               `protobuf:"bytes,6,rep,name=includes,proto3" json:"includes,omitempty"`
+	Excludes      []string               `protobuf:"bytes,7,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeReq) Reset() {
+	*x = AnalyzeReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeReq) ProtoMessage() {}
+
+func (x *AnalyzeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeReq.ProtoReflect.Descriptor instead.
+func (*AnalyzeReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyzeReq) GetRepoType() RepoType {
+	if x != nil {
+		return x.RepoType
+	}
+	return RepoType_Local
+}
+
+func (x *AnalyzeReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AnalyzeReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AnalyzeReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AnalyzeReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *AnalyzeReq) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *AnalyzeReq) GetExcludes() []string {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+type AnalyzeResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Deprecated: Marked as deprecated in codewiki/v1/codewiki.proto.
+	Summary       *AnalyzeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"` //分析改为异步任务，结果见 AnalysisJob.summary
+	JobId         string          `protobuf:"bytes,4,opt,name=jobId,proto3" json:"jobId,omitempty"`     //分析任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeResp) Reset() {
+	*x = AnalyzeResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResp) ProtoMessage() {}
+
+func (x *AnalyzeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResp.ProtoReflect.Descriptor instead.
+func (*AnalyzeResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnalyzeResp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AnalyzeResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in codewiki/v1/codewiki.proto.
+func (x *AnalyzeResp) GetSummary() *AnalyzeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *AnalyzeResp) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// 与上次分析结果相比的文件变更
+type AnalyzeSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []string               `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`        // 新增文件ID
+	Changed       []string               `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`    // 内容变化的文件ID
+	Removed       []string               `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`    // 删除的文件ID
+	Analyzed      int32                  `protobuf:"varint,4,opt,name=analyzed,proto3" json:"analyzed,omitempty"` // 重新分析的文件数，包含关系指向变更文件的文件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeSummary) Reset() {
+	*x = AnalyzeSummary{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeSummary) ProtoMessage() {}
+
+func (x *AnalyzeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeSummary.ProtoReflect.Descriptor instead.
+func (*AnalyzeSummary) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnalyzeSummary) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *AnalyzeSummary) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *AnalyzeSummary) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *AnalyzeSummary) GetAnalyzed() int32 {
+	if x != nil {
+		return x.Analyzed
+	}
+	return 0
+}
+
+type CallChainReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FollowDispatch bool                   `protobuf:"varint,2,opt,name=followDispatch,proto3" json:"followDispatch,omitempty"` //是否沿 DispatchesTo 从接口方法进入实现方法
+	Snapshot       string                 `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`              //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
+	MaxDepth       int32                  `protobuf:"varint,4,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`             //最大层数，默认 10
+	MaxNodes       int32                  `protobuf:"varint,5,opt,name=maxNodes,proto3" json:"maxNodes,omitempty"`             //最多返回的函数数（包括起点），默认 500
+	Direction      CallDirection          `protobuf:"varint,6,opt,name=direction,proto3,enum=codewiki.v1.CallDirection" json:"direction,omitempty"`
+	RelationTypes  []string               `protobuf:"bytes,7,rep,name=relationTypes,proto3" json:"relationTypes,omitempty"` //沿哪些关系展开：Call、DispatchesTo，为空时为 Call，followDispatch 时加上 DispatchesTo
+	Cursor         string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`               //上次结果中 frontier 的 cursor，从该节点继续展开，此时忽略 id、direction 和 relationTypes
+	ExcludeTests   bool                   `protobuf:"varint,9,opt,name=excludeTests,proto3" json:"excludeTests,omitempty"`  //不展开测试文件中的函数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CallChainReq) Reset() {
+	*x = CallChainReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallChainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallChainReq) ProtoMessage() {}
+
+func (x *CallChainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallChainReq.ProtoReflect.Descriptor instead.
+func (*CallChainReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CallChainReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallChainReq) GetFollowDispatch() bool {
+	if x != nil {
+		return x.FollowDispatch
+	}
+	return false
+}
+
+func (x *CallChainReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *CallChainReq) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CallChainReq) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *CallChainReq) GetDirection() CallDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return CallDirection_CallOutgoing
+}
+
+func (x *CallChainReq) GetRelationTypes() []string {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
+func (x *CallChainReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CallChainReq) GetExcludeTests() bool {
+	if x != nil {
+		return x.ExcludeTests
+	}
+	return false
+}
+
+type CallChainResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	CallRelations []*CallRelationship    `protobuf:"bytes,3,rep,name=callRelations,proto3" json:"callRelations,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` //达到 maxDepth 或 maxNodes，还有未展开的关系
+	Frontier      []*CallFrontier        `protobuf:"bytes,5,rep,name=frontier,proto3" json:"frontier,omitempty"`    //未展开完的函数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallChainResp) Reset() {
+	*x = CallChainResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallChainResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallChainResp) ProtoMessage() {}
+
+func (x *CallChainResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallChainResp.ProtoReflect.Descriptor instead.
+func (*CallChainResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CallChainResp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CallChainResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CallChainResp) GetCallRelations() []*CallRelationship {
+	if x != nil {
+		return x.CallRelations
+	}
+	return nil
+}
+
+func (x *CallChainResp) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *CallChainResp) GetFrontier() []*CallFrontier {
+	if x != nil {
+		return x.Frontier
+	}
+	return nil
+}
+
+// CallFrontier 因层数或节点数限制没有展开完的函数
+type CallFrontier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`  //与起点的距离
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` //传给 CallChainReq.cursor 从该函数继续展开
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallFrontier) Reset() {
+	*x = CallFrontier{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallFrontier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallFrontier) ProtoMessage() {}
+
+func (x *CallFrontier) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallFrontier.ProtoReflect.Descriptor instead.
+func (*CallFrontier) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CallFrontier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallFrontier) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CallFrontier) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type CallersChainReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaxDepth       int32                  `protobuf:"varint,2,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`                                    //向上查找的最大层数，默认 5
+	StopAt         []EntrypointKind       `protobuf:"varint,3,rep,packed,name=stopAt,proto3,enum=codewiki.v1.EntrypointKind" json:"stopAt,omitempty"` //调用方是这些入口时不再继续向上查找，为空时不停止
+	FollowDispatch bool                   `protobuf:"varint,4,opt,name=followDispatch,proto3" json:"followDispatch,omitempty"`                        //是否经过 DispatchesTo 从实现方法找到接口方法的调用方
+	Snapshot       string                 `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                                     //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
+	ExcludeTests   bool                   `protobuf:"varint,6,opt,name=excludeTests,proto3" json:"excludeTests,omitempty"`                            //不返回测试文件中的调用方
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CallersChainReq) Reset() {
+	*x = CallersChainReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallersChainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallersChainReq) ProtoMessage() {}
+
+func (x *CallersChainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallersChainReq.ProtoReflect.Descriptor instead.
+func (*CallersChainReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CallersChainReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallersChainReq) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CallersChainReq) GetStopAt() []EntrypointKind {
+	if x != nil {
+		return x.StopAt
+	}
+	return nil
+}
+
+func (x *CallersChainReq) GetFollowDispatch() bool {
+	if x != nil {
+		return x.FollowDispatch
+	}
+	return false
+}
+
+func (x *CallersChainReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *CallersChainReq) GetExcludeTests() bool {
+	if x != nil {
+		return x.ExcludeTests
+	}
+	return false
+}
+
+type CallPathReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	K             int32                  `protobuf:"varint,3,opt,name=k,proto3" json:"k,omitempty"`                        //返回最短的 k 条路径，默认 1
+	MaxDepth      int32                  `protobuf:"varint,4,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`          //路径最大长度，默认 10
+	RelationTypes []string               `protobuf:"bytes,5,rep,name=relationTypes,proto3" json:"relationTypes,omitempty"` //沿哪些关系查找：Call、DispatchesTo，为空时两者都使用
+	Snapshot      string                 `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot,omitempty"`           //快照 ID 或提交 SHA（前缀），为空时查询 source 所在的快照
+	ExcludeTests  bool                   `protobuf:"varint,7,opt,name=excludeTests,proto3" json:"excludeTests,omitempty"`  //路径不经过测试文件中的函数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallPathReq) Reset() {
+	*x = CallPathReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPathReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPathReq) ProtoMessage() {}
+
+func (x *CallPathReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPathReq.ProtoReflect.Descriptor instead.
+func (*CallPathReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CallPathReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CallPathReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CallPathReq) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *CallPathReq) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CallPathReq) GetRelationTypes() []string {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
+func (x *CallPathReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *CallPathReq) GetExcludeTests() bool {
+	if x != nil {
+		return x.ExcludeTests
+	}
+	return false
+}
+
+type CallPathResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []*FunctionPath        `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`          //按长度从短到长
+	Truncated     bool                   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"` //展开的函数数达到上限，可能缺少路径
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallPathResp) Reset() {
+	*x = CallPathResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallPathResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPathResp) ProtoMessage() {}
+
+func (x *CallPathResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPathResp.ProtoReflect.Descriptor instead.
+func (*CallPathResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CallPathResp) GetPaths() []*FunctionPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CallPathResp) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// FunctionPath 一条调用路径，hops 首尾相接
+type FunctionPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hops          []*CallRelationship    `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FunctionPath) Reset() {
+	*x = FunctionPath{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunctionPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionPath) ProtoMessage() {}
+
+func (x *FunctionPath) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionPath.ProtoReflect.Descriptor instead.
+func (*FunctionPath) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FunctionPath) GetHops() []*CallRelationship {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+type CallRelationship struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CallerId       string                 `protobuf:"bytes,1,opt,name=callerId,proto3" json:"callerId,omitempty"`
+	CallerName     string                 `protobuf:"bytes,2,opt,name=callerName,proto3" json:"callerName,omitempty"`
+	CalleeId       string                 `protobuf:"bytes,3,opt,name=calleeId,proto3" json:"calleeId,omitempty"`
+	CalleeName     string                 `protobuf:"bytes,4,opt,name=calleeName,proto3" json:"calleeName,omitempty"`
+	CalleeFileId   string                 `protobuf:"bytes,5,opt,name=calleeFileId,proto3" json:"calleeFileId,omitempty"`
+	CallerFileId   string                 `protobuf:"bytes,6,opt,name=callerFileId,proto3" json:"callerFileId,omitempty"`
+	CalleeScope    int64                  `protobuf:"varint,7,opt,name=calleeScope,proto3" json:"calleeScope,omitempty"`
+	CallerScope    int64                  `protobuf:"varint,8,opt,name=callerScope,proto3" json:"callerScope,omitempty"`
+	CalleeEntityId string                 `protobuf:"bytes,9,opt,name=calleeEntityId,proto3" json:"calleeEntityId,omitempty"`
+	CallerEntityId string                 `protobuf:"bytes,10,opt,name=callerEntityId,proto3" json:"callerEntityId,omitempty"`
+	Relation       string                 `protobuf:"bytes,11,opt,name=relation,proto3" json:"relation,omitempty"` //关系类型 Call/DispatchesTo
+	CallSite       *SourceSpan            `protobuf:"bytes,12,opt,name=callSite,proto3" json:"callSite,omitempty"` //Call 关系在调用方文件中的位置，同一函数多次调用时为第一处
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CallRelationship) Reset() {
+	*x = CallRelationship{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallRelationship) ProtoMessage() {}
+
+func (x *CallRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallRelationship.ProtoReflect.Descriptor instead.
+func (*CallRelationship) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CallRelationship) GetCallerId() string {
+	if x != nil {
+		return x.CallerId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCallerName() string {
+	if x != nil {
+		return x.CallerName
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCalleeId() string {
+	if x != nil {
+		return x.CalleeId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCalleeName() string {
+	if x != nil {
+		return x.CalleeName
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCalleeFileId() string {
+	if x != nil {
+		return x.CalleeFileId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCallerFileId() string {
+	if x != nil {
+		return x.CallerFileId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCalleeScope() int64 {
+	if x != nil {
+		return x.CalleeScope
+	}
+	return 0
+}
+
+func (x *CallRelationship) GetCallerScope() int64 {
+	if x != nil {
+		return x.CallerScope
+	}
+	return 0
+}
+
+func (x *CallRelationship) GetCalleeEntityId() string {
+	if x != nil {
+		return x.CalleeEntityId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCallerEntityId() string {
+	if x != nil {
+		return x.CallerEntityId
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *CallRelationship) GetCallSite() *SourceSpan {
+	if x != nil {
+		return x.CallSite
+	}
+	return nil
+}
+
+// SourceSpan 源码位置，行列从 1 开始，结束位置不包含在内
+type SourceSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartLine     int32                  `protobuf:"varint,1,opt,name=startLine,proto3" json:"startLine,omitempty"`
+	StartColumn   int32                  `protobuf:"varint,2,opt,name=startColumn,proto3" json:"startColumn,omitempty"`
+	EndLine       int32                  `protobuf:"varint,3,opt,name=endLine,proto3" json:"endLine,omitempty"`
+	EndColumn     int32                  `protobuf:"varint,4,opt,name=endColumn,proto3" json:"endColumn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceSpan) Reset() {
+	*x = SourceSpan{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceSpan) ProtoMessage() {}
+
+func (x *SourceSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceSpan.ProtoReflect.Descriptor instead.
+func (*SourceSpan) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SourceSpan) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *SourceSpan) GetStartColumn() int32 {
+	if x != nil {
+		return x.StartColumn
+	}
+	return 0
+}
+
+func (x *SourceSpan) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *SourceSpan) GetEndColumn() int32 {
+	if x != nil {
+		return x.EndColumn
+	}
+	return 0
+}
+
+// ===== Repo Management =====
+type Repo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RepoType      RepoType               `protobuf:"varint,3,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`     // 本地路径，可选
+	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"` // 远端地址或本地路径
+	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`   // 令牌（如 GitHub），可选
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Excludes      []string               `protobuf:"bytes,8,rep,name=excludes,proto3" json:"excludes,omitempty"` //不需要分析的目录
+	Language      Language               `protobuf:"varint,9,opt,name=language,proto3,enum=codewiki.v1.Language" json:"language,omitempty"`
+	CallResolver  CallResolver           `protobuf:"varint,10,opt,name=callResolver,proto3,enum=codewiki.v1.CallResolver" json:"callResolver,omitempty"` //调用关系解析方式
+	Ref           string                 `protobuf:"bytes,11,opt,name=ref,proto3" json:"ref,omitempty"`                                                  // 远端仓库分析的分支、标签或提交，为空时使用默认分支
+	Rules         []*ArchitectureRule    `protobuf:"bytes,12,rep,name=rules,proto3" json:"rules,omitempty"`                                              //架构规则，每次分析后检查
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repo) Reset() {
+	*x = Repo{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repo) ProtoMessage() {}
+
+func (x *Repo) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
+func (*Repo) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Repo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Repo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Repo) GetRepoType() RepoType {
+	if x != nil {
+		return x.RepoType
+	}
+	return RepoType_Local
+}
+
+func (x *Repo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Repo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Repo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Repo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Repo) GetExcludes() []string {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+func (x *Repo) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_Golang
+}
+
+func (x *Repo) GetCallResolver() CallResolver {
+	if x != nil {
+		return x.CallResolver
+	}
+	return CallResolver_Heuristic
+}
+
+func (x *Repo) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Repo) GetRules() []*ArchitectureRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateRepoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RepoType      RepoType               `protobuf:"varint,2,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Excludes      []string               `protobuf:"bytes,7,rep,name=excludes,proto3" json:"excludes,omitempty"` //不需要分析的目录
+	Language      Language               `protobuf:"varint,8,opt,name=language,proto3,enum=codewiki.v1.Language" json:"language,omitempty"`
+	CallResolver  CallResolver           `protobuf:"varint,9,opt,name=callResolver,proto3,enum=codewiki.v1.CallResolver" json:"callResolver,omitempty"` //调用关系解析方式
+	Ref           string                 `protobuf:"bytes,10,opt,name=ref,proto3" json:"ref,omitempty"`                                                 // 远端仓库分析的分支、标签或提交，为空时使用默认分支
+	Rules         []*ArchitectureRule    `protobuf:"bytes,11,rep,name=rules,proto3" json:"rules,omitempty"`                                             //架构规则，每次分析后检查
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRepoReq) Reset() {
+	*x = CreateRepoReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRepoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepoReq) ProtoMessage() {}
+
+func (x *CreateRepoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepoReq.ProtoReflect.Descriptor instead.
+func (*CreateRepoReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateRepoReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetRepoType() RepoType {
+	if x != nil {
+		return x.RepoType
+	}
+	return RepoType_Local
+}
+
+func (x *CreateRepoReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetExcludes() []string {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+func (x *CreateRepoReq) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_Golang
+}
+
+func (x *CreateRepoReq) GetCallResolver() CallResolver {
+	if x != nil {
+		return x.CallResolver
+	}
+	return CallResolver_Heuristic
+}
+
+func (x *CreateRepoReq) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *CreateRepoReq) GetRules() []*ArchitectureRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateRepoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRepoResp) Reset() {
+	*x = CreateRepoResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRepoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepoResp) ProtoMessage() {}
+
+func (x *CreateRepoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepoResp.ProtoReflect.Descriptor instead.
+func (*CreateRepoResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateRepoResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListReposReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReposReq) Reset() {
+	*x = ListReposReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReposReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposReq) ProtoMessage() {}
+
+func (x *ListReposReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposReq.ProtoReflect.Descriptor instead.
+func (*ListReposReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{15}
+}
+
+type ListReposResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repos         []*Repo                `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReposResp) Reset() {
+	*x = ListReposResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReposResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposResp) ProtoMessage() {}
+
+func (x *ListReposResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposResp.ProtoReflect.Descriptor instead.
+func (*ListReposResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReposResp) GetRepos() []*Repo {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+type GetRepoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoReq) Reset() {
+	*x = GetRepoReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoReq) ProtoMessage() {}
+
+func (x *GetRepoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoReq.ProtoReflect.Descriptor instead.
+func (*GetRepoReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRepoReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRepoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repo          *Repo                  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoResp) Reset() {
+	*x = GetRepoResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoResp) ProtoMessage() {}
+
+func (x *GetRepoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoResp.ProtoReflect.Descriptor instead.
+func (*GetRepoResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRepoResp) GetRepo() *Repo {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+type DeleteRepoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepoReq) Reset() {
+	*x = DeleteRepoReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoReq) ProtoMessage() {}
+
+func (x *DeleteRepoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoReq.ProtoReflect.Descriptor instead.
+func (*DeleteRepoReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteRepoReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRepoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepoResp) Reset() {
+	*x = DeleteRepoResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoResp) ProtoMessage() {}
+
+func (x *DeleteRepoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoResp.ProtoReflect.Descriptor instead.
+func (*DeleteRepoResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{20}
+}
+
+type AnalyzeRepoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Incremental   bool                   `protobuf:"varint,2,opt,name=incremental,proto3" json:"incremental,omitempty"` //增量分析，只重新分析内容变化的文件及依赖它们的文件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeRepoReq) Reset() {
+	*x = AnalyzeRepoReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRepoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRepoReq) ProtoMessage() {}
+
+func (x *AnalyzeRepoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRepoReq.ProtoReflect.Descriptor instead.
+func (*AnalyzeRepoReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AnalyzeRepoReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnalyzeRepoReq) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+type AnalysisJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoId         string                 `protobuf:"bytes,2,opt,name=repoId,proto3" json:"repoId,omitempty"`
+	Status         JobStatus              `protobuf:"varint,3,opt,name=status,proto3,enum=codewiki.v1.JobStatus" json:"status,omitempty"`
+	Phase          AnalysisPhase          `protobuf:"varint,4,opt,name=phase,proto3,enum=codewiki.v1.AnalysisPhase" json:"phase,omitempty"`
+	Incremental    bool                   `protobuf:"varint,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	TotalFiles     int32                  `protobuf:"varint,6,opt,name=totalFiles,proto3" json:"totalFiles,omitempty"`         // 当前阶段需要处理的文件数
+	ProcessedFiles int32                  `protobuf:"varint,7,opt,name=processedFiles,proto3" json:"processedFiles,omitempty"` // 当前阶段已处理的文件数
+	Error          string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs     int64                  `protobuf:"varint,9,opt,name=durationMs,proto3" json:"durationMs,omitempty"` // 运行时长，运行中的任务为已运行时长
+	CreatedAt      int64                  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`  // unix 毫秒
+	StartedAt      int64                  `protobuf:"varint,11,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt     int64                  `protobuf:"varint,12,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Summary        *AnalyzeSummary        `protobuf:"bytes,13,opt,name=summary,proto3" json:"summary,omitempty"`
+	Commit         string                 `protobuf:"bytes,14,opt,name=commit,proto3" json:"commit,omitempty"`     // 分析的提交 SHA，非 git 仓库时为空
+	Snapshot       string                 `protobuf:"bytes,15,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // 分析结果所在的快照 ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnalysisJob) Reset() {
+	*x = AnalysisJob{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisJob) ProtoMessage() {}
+
+func (x *AnalysisJob) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisJob.ProtoReflect.Descriptor instead.
+func (*AnalysisJob) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnalysisJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetStatus() JobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobStatus_Pending
+}
+
+func (x *AnalysisJob) GetPhase() AnalysisPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return AnalysisPhase_PhaseQueued
+}
+
+func (x *AnalysisJob) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *AnalysisJob) GetTotalFiles() int32 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetProcessedFiles() int32 {
+	if x != nil {
+		return x.ProcessedFiles
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *AnalysisJob) GetSummary() *AnalyzeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *AnalysisJob) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *AnalysisJob) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+// Snapshot 仓库在某个提交上的分析结果，快照中节点 ID 以快照 ID 为前缀
+type Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 仓库 ID~提交前 12 位，非 git 仓库为仓库 ID
+	RepoId        string                 `protobuf:"bytes,2,opt,name=repoId,proto3" json:"repoId,omitempty"`
+	Commit        string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Ref           string                 `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`                // 分析时指定的分支、标签或提交
+	JobId         string                 `protobuf:"bytes,5,opt,name=jobId,proto3" json:"jobId,omitempty"`            // 最近一次写入快照的分析任务
+	AnalyzedAt    int64                  `protobuf:"varint,6,opt,name=analyzedAt,proto3" json:"analyzedAt,omitempty"` // unix 毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Snapshot) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Snapshot) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetAnalyzedAt() int64 {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return 0
+}
+
+type ListSnapshotsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsReq) Reset() {
+	*x = ListSnapshotsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsReq) ProtoMessage() {}
+
+func (x *ListSnapshotsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsReq.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSnapshotsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSnapshotsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*Snapshot            `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResp) Reset() {
+	*x = ListSnapshotsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResp) ProtoMessage() {}
+
+func (x *ListSnapshotsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResp.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSnapshotsResp) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DiffGraphsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base          string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`                   //快照 ID 或提交 SHA（前缀）
+	Head          string                 `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`                   //为空时使用最新快照
+	ExportedOnly  bool                   `protobuf:"varint,4,opt,name=exportedOnly,proto3" json:"exportedOnly,omitempty"`  //只比较包外可见的实体和函数，关系两端都要可见
+	RelationTypes []string               `protobuf:"bytes,5,rep,name=relationTypes,proto3" json:"relationTypes,omitempty"` //比较的关系类型，为空时比较除包含/声明以外的所有关系
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffGraphsReq) Reset() {
+	*x = DiffGraphsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffGraphsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGraphsReq) ProtoMessage() {}
+
+func (x *DiffGraphsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGraphsReq.ProtoReflect.Descriptor instead.
+func (*DiffGraphsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DiffGraphsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffGraphsReq) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *DiffGraphsReq) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *DiffGraphsReq) GetExportedOnly() bool {
+	if x != nil {
+		return x.ExportedOnly
+	}
+	return false
+}
+
+func (x *DiffGraphsReq) GetRelationTypes() []string {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
+type DiffGraphsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"` //解析后的快照 ID
+	Head          string                 `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Packages      *NodeDiff              `protobuf:"bytes,3,opt,name=packages,proto3" json:"packages,omitempty"`
+	Entities      *NodeDiff              `protobuf:"bytes,4,opt,name=entities,proto3" json:"entities,omitempty"`
+	Functions     *NodeDiff              `protobuf:"bytes,5,opt,name=functions,proto3" json:"functions,omitempty"`
+	Relations     *RelationDiff          `protobuf:"bytes,6,opt,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffGraphsResp) Reset() {
+	*x = DiffGraphsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffGraphsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGraphsResp) ProtoMessage() {}
+
+func (x *DiffGraphsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGraphsResp.ProtoReflect.Descriptor instead.
+func (*DiffGraphsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DiffGraphsResp) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *DiffGraphsResp) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *DiffGraphsResp) GetPackages() *NodeDiff {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *DiffGraphsResp) GetEntities() *NodeDiff {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *DiffGraphsResp) GetFunctions() *NodeDiff {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *DiffGraphsResp) GetRelations() *RelationDiff {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+// NodeDiff 新增和变化的节点使用 head 中的 ID，删除的节点使用 base 中的 ID
+type NodeDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []*DiffNode            `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*DiffNode            `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Changed       []*DiffNode            `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"` //包的文件、实体的定义或函数的源码不同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeDiff) Reset() {
+	*x = NodeDiff{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDiff) ProtoMessage() {}
+
+func (x *NodeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDiff.ProtoReflect.Descriptor instead.
+func (*NodeDiff) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NodeDiff) GetAdded() []*DiffNode {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *NodeDiff) GetRemoved() []*DiffNode {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *NodeDiff) GetChanged() []*DiffNode {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type DiffNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FileId        string                 `protobuf:"bytes,3,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNode) Reset() {
+	*x = DiffNode{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNode) ProtoMessage() {}
+
+func (x *DiffNode) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNode.ProtoReflect.Descriptor instead.
+func (*DiffNode) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DiffNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffNode) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type RelationDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []*DiffRelation        `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*DiffRelation        `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationDiff) Reset() {
+	*x = RelationDiff{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationDiff) ProtoMessage() {}
+
+func (x *RelationDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationDiff.ProtoReflect.Descriptor instead.
+func (*RelationDiff) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RelationDiff) GetAdded() []*DiffRelation {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *RelationDiff) GetRemoved() []*DiffRelation {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type DiffRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRelation) Reset() {
+	*x = DiffRelation{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRelation) ProtoMessage() {}
+
+func (x *DiffRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRelation.ProtoReflect.Descriptor instead.
+func (*DiffRelation) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DiffRelation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DiffRelation) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *DiffRelation) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type CheckAPICompatibilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base          string                 `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"` //基线版本：分支、标签或提交 SHA
+	Head          string                 `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"` //为空时本地仓库使用工作区，远端仓库使用 ref
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAPICompatibilityReq) Reset() {
+	*x = CheckAPICompatibilityReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAPICompatibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAPICompatibilityReq) ProtoMessage() {}
+
+func (x *CheckAPICompatibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAPICompatibilityReq.ProtoReflect.Descriptor instead.
+func (*CheckAPICompatibilityReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CheckAPICompatibilityReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckAPICompatibilityReq) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CheckAPICompatibilityReq) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+type CheckAPICompatibilityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"` //解析后的提交
+	Head          string                 `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"` //解析后的提交，使用工作区时为空
+	Changes       []*APIChange           `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	Breaking      bool                   `protobuf:"varint,4,opt,name=breaking,proto3" json:"breaking,omitempty"`                     //是否有不兼容的变更
+	Bump          SemverBump             `protobuf:"varint,5,opt,name=bump,proto3,enum=codewiki.v1.SemverBump" json:"bump,omitempty"` //建议的版本升级
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAPICompatibilityResp) Reset() {
+	*x = CheckAPICompatibilityResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAPICompatibilityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAPICompatibilityResp) ProtoMessage() {}
+
+func (x *CheckAPICompatibilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAPICompatibilityResp.ProtoReflect.Descriptor instead.
+func (*CheckAPICompatibilityResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CheckAPICompatibilityResp) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CheckAPICompatibilityResp) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *CheckAPICompatibilityResp) GetChanges() []*APIChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *CheckAPICompatibilityResp) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+func (x *CheckAPICompatibilityResp) GetBump() SemverBump {
+	if x != nil {
+		return x.Bump
+	}
+	return SemverBump_BumpPatch
+}
+
+type APIChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          APIChangeKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=codewiki.v1.APIChangeKind" json:"kind,omitempty"`
+	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"` //包的导入路径
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`   //包内的符号，成员为 类型.成员
+	Before        string                 `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`   //base 中的签名或类型，新增时为空
+	After         string                 `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`     //head 中的签名或类型，删除时为空
+	Breaking      bool                   `protobuf:"varint,6,opt,name=breaking,proto3" json:"breaking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIChange) Reset() {
+	*x = APIChange{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIChange) ProtoMessage() {}
+
+func (x *APIChange) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIChange.ProtoReflect.Descriptor instead.
+func (*APIChange) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *APIChange) GetKind() APIChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return APIChangeKind_FuncAdded
+}
+
+func (x *APIChange) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *APIChange) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *APIChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *APIChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *APIChange) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+type GetAnalysisJobReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalysisJobReq) Reset() {
+	*x = GetAnalysisJobReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalysisJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisJobReq) ProtoMessage() {}
+
+func (x *GetAnalysisJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisJobReq.ProtoReflect.Descriptor instead.
+func (*GetAnalysisJobReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAnalysisJobReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAnalysisJobResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *AnalysisJob           `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalysisJobResp) Reset() {
+	*x = GetAnalysisJobResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalysisJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisJobResp) ProtoMessage() {}
+
+func (x *GetAnalysisJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisJobResp.ProtoReflect.Descriptor instead.
+func (*GetAnalysisJobResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAnalysisJobResp) GetJob() *AnalysisJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListAnalysisJobsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoId        string                 `protobuf:"bytes,1,opt,name=repoId,proto3" json:"repoId,omitempty"` //为空时返回所有仓库的任务
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  //默认 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnalysisJobsReq) Reset() {
+	*x = ListAnalysisJobsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnalysisJobsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnalysisJobsReq) ProtoMessage() {}
+
+func (x *ListAnalysisJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnalysisJobsReq.ProtoReflect.Descriptor instead.
+func (*ListAnalysisJobsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAnalysisJobsReq) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *ListAnalysisJobsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAnalysisJobsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*AnalysisJob         `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnalysisJobsResp) Reset() {
+	*x = ListAnalysisJobsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnalysisJobsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnalysisJobsResp) ProtoMessage() {}
+
+func (x *ListAnalysisJobsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnalysisJobsResp.ProtoReflect.Descriptor instead.
+func (*ListAnalysisJobsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListAnalysisJobsResp) GetJobs() []*AnalysisJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type CancelAnalysisJobReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAnalysisJobReq) Reset() {
+	*x = CancelAnalysisJobReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAnalysisJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAnalysisJobReq) ProtoMessage() {}
+
+func (x *CancelAnalysisJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAnalysisJobReq.ProtoReflect.Descriptor instead.
+func (*CancelAnalysisJobReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelAnalysisJobReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelAnalysisJobResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAnalysisJobResp) Reset() {
+	*x = CancelAnalysisJobResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAnalysisJobResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAnalysisJobResp) ProtoMessage() {}
+
+func (x *CancelAnalysisJobResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAnalysisJobResp.ProtoReflect.Descriptor instead.
+func (*CancelAnalysisJobResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{40}
+}
+
+type SearchSymbolsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Mode          SymbolMatchMode        `protobuf:"varint,3,opt,name=mode,proto3,enum=codewiki.v1.SymbolMatchMode" json:"mode,omitempty"`
+	Kinds         []SymbolKind           `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=codewiki.v1.SymbolKind" json:"kinds,omitempty"` //为空时搜索所有类型
+	Package       string                 `protobuf:"bytes,5,opt,name=package,proto3" json:"package,omitempty"`                                 //包 ID，只搜索该包及其子包
+	ExportedOnly  bool                   `protobuf:"varint,6,opt,name=exportedOnly,proto3" json:"exportedOnly,omitempty"`                      //只返回包外可见的实体和函数，包、文件和字段不受影响
+	Snapshot      string                 `protobuf:"bytes,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                               //快照 ID 或提交 SHA（前缀），为空时使用最新快照
+	PageSize      int32                  `protobuf:"varint,8,opt,name=pageSize,proto3" json:"pageSize,omitempty"`                              //默认 20
+	PageToken     string                 `protobuf:"bytes,9,opt,name=pageToken,proto3" json:"pageToken,omitempty"`                             //上一页返回的 nextPageToken
+	ExcludeTests  bool                   `protobuf:"varint,10,opt,name=excludeTests,proto3" json:"excludeTests,omitempty"`                     //不返回测试文件以及其中声明的符号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSymbolsReq) Reset() {
+	*x = SearchSymbolsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSymbolsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSymbolsReq) ProtoMessage() {}
+
+func (x *SearchSymbolsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSymbolsReq.ProtoReflect.Descriptor instead.
+func (*SearchSymbolsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SearchSymbolsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchSymbolsReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSymbolsReq) GetMode() SymbolMatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SymbolMatchMode_MatchPrefix
+}
+
+func (x *SearchSymbolsReq) GetKinds() []SymbolKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *SearchSymbolsReq) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *SearchSymbolsReq) GetExportedOnly() bool {
+	if x != nil {
+		return x.ExportedOnly
+	}
+	return false
+}
+
+func (x *SearchSymbolsReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *SearchSymbolsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchSymbolsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchSymbolsReq) GetExcludeTests() bool {
+	if x != nil {
+		return x.ExcludeTests
+	}
+	return false
+}
+
+type SearchSymbolsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []*Symbol              `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`             //按 score 从高到低
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"` //为空时没有下一页
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                //匹配的符号数
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`        //候选数达到上限，可能缺少匹配度较低的符号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSymbolsResp) Reset() {
+	*x = SearchSymbolsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSymbolsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSymbolsResp) ProtoMessage() {}
+
+func (x *SearchSymbolsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSymbolsResp.ProtoReflect.Descriptor instead.
+func (*SearchSymbolsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SearchSymbolsResp) GetSymbols() []*Symbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *SearchSymbolsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchSymbolsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchSymbolsResp) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type Symbol struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          SymbolKind             `protobuf:"varint,3,opt,name=kind,proto3,enum=codewiki.v1.SymbolKind" json:"kind,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parentId,proto3" json:"parentId,omitempty"` //包的上级包，文件所在的包，实体和函数所在的文件，字段所属的实体
+	Exported      bool                   `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
+	FanIn         int32                  `protobuf:"varint,6,opt,name=fanIn,proto3" json:"fanIn,omitempty"`           //调用、分派、实现、继承和导入它的关系数
+	Score         float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`          //匹配度加上被引用次数的加权
+	FileId        string                 `protobuf:"bytes,8,opt,name=fileId,proto3" json:"fileId,omitempty"`          //声明所在的文件，包和文件为空
+	Span          *SourceSpan            `protobuf:"bytes,9,opt,name=span,proto3" json:"span,omitempty"`              //声明的位置
+	Entrypoint    string                 `protobuf:"bytes,10,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"` //函数作为程序入口的类型：main、http、test，不是入口时为空
+	Test          bool                   `protobuf:"varint,11,opt,name=test,proto3" json:"test,omitempty"`            //测试文件以及其中声明的符号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Symbol) Reset() {
+	*x = Symbol{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Symbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Symbol) ProtoMessage() {}
+
+func (x *Symbol) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
+func (*Symbol) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Symbol) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Symbol) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Symbol) GetKind() SymbolKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SymbolKind_SymbolPackage
+}
+
+func (x *Symbol) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Symbol) GetExported() bool {
+	if x != nil {
+		return x.Exported
+	}
+	return false
+}
+
+func (x *Symbol) GetFanIn() int32 {
+	if x != nil {
+		return x.FanIn
+	}
+	return 0
+}
+
+func (x *Symbol) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Symbol) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *Symbol) GetSpan() *SourceSpan {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+func (x *Symbol) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return ""
+}
+
+func (x *Symbol) GetTest() bool {
+	if x != nil {
+		return x.Test
+	}
+	return false
+}
+
+type GetFunctionTestsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFunctionTestsReq) Reset() {
+	*x = GetFunctionTestsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFunctionTestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFunctionTestsReq) ProtoMessage() {}
+
+func (x *GetFunctionTestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFunctionTestsReq.ProtoReflect.Descriptor instead.
+func (*GetFunctionTestsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetFunctionTestsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFunctionTestsReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+type GetFunctionTestsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      string                 `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Tests         []*FunctionTest        `protobuf:"bytes,2,rep,name=tests,proto3" json:"tests,omitempty"` //按 depth 从小到大
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFunctionTestsResp) Reset() {
+	*x = GetFunctionTestsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFunctionTestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFunctionTestsResp) ProtoMessage() {}
+
+func (x *GetFunctionTestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFunctionTestsResp.ProtoReflect.Descriptor instead.
+func (*GetFunctionTestsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetFunctionTestsResp) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *GetFunctionTestsResp) GetTests() []*FunctionTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+// FunctionTest 经过 depth 层调用到达函数的测试函数
+type FunctionTest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        *Symbol                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"` //1 为测试函数直接调用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FunctionTest) Reset() {
+	*x = FunctionTest{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunctionTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionTest) ProtoMessage() {}
+
+func (x *FunctionTest) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionTest.ProtoReflect.Descriptor instead.
+func (*FunctionTest) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FunctionTest) GetSymbol() *Symbol {
+	if x != nil {
+		return x.Symbol
+	}
+	return nil
+}
+
+func (x *FunctionTest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type ResolveSymbolAtReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoId        string                 `protobuf:"bytes,1,opt,name=repoId,proto3" json:"repoId,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	Line          int32                  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`        //行号，从 1 开始
+	Column        int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`    //列号，从 1 开始，按字节计算
+	Snapshot      string                 `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时查询 fileId 所在的快照
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveSymbolAtReq) Reset() {
+	*x = ResolveSymbolAtReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveSymbolAtReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSymbolAtReq) ProtoMessage() {}
+
+func (x *ResolveSymbolAtReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-)
+	return mi.MessageOf(x)
+}
 
-func (x FunScope) Enum() *FunScope {
-	p := new(FunScope)
-	*p = x
-	return p
+// Deprecated: Use ResolveSymbolAtReq.ProtoReflect.Descriptor instead.
+func (*ResolveSymbolAtReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{47}
 }
 
-func (x FunScope) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ResolveSymbolAtReq) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
 }
 
-func (FunScope) Descriptor() protoreflect.EnumDescriptor {
-	return file_codewiki_v1_codewiki_proto_enumTypes[2].Descriptor()
+func (x *ResolveSymbolAtReq) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
 }
 
-func (FunScope) Type() protoreflect.EnumType {
-	return &file_codewiki_v1_codewiki_proto_enumTypes[2]
+func (x *ResolveSymbolAtReq) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
 }
 
-func (x FunScope) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ResolveSymbolAtReq) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
 }
 
-// Deprecated: Use FunScope.Descriptor instead.
-func (FunScope) EnumDescriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{2}
+func (x *ResolveSymbolAtReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
 }
 
-type AnalyzeReq struct {
+type ResolveSymbolAtResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepoType      RepoType               `protobuf:"varint,1,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Language      string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
-	Includes      []string               `protobuf:"bytes,6,rep,name=includes,proto3" json:"includes,omitempty"`
-	Excludes      []string               `protobuf:"bytes,7,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             //位置上的标识符，不在标识符上时为空
+	Span          *SourceSpan            `protobuf:"bytes,2,opt,name=span,proto3" json:"span,omitempty"`             //标识符的位置
+	Definition    *Symbol                `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"` //标识符的定义，定义在仓库外或无法解析时为空
+	Candidates    []*Symbol              `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"` //按名称无法唯一确定时的其他候选，按可能性从高到低
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalyzeReq) Reset() {
-	*x = AnalyzeReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[0]
+func (x *ResolveSymbolAtResp) Reset() {
+	*x = ResolveSymbolAtResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeReq) String() string {
+func (x *ResolveSymbolAtResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeReq) ProtoMessage() {}
+func (*ResolveSymbolAtResp) ProtoMessage() {}
 
-func (x *AnalyzeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[0]
+func (x *ResolveSymbolAtResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,83 +4135,139 @@ func (x *AnalyzeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeReq.ProtoReflect.Descriptor instead.
-func (*AnalyzeReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ResolveSymbolAtResp.ProtoReflect.Descriptor instead.
+func (*ResolveSymbolAtResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AnalyzeReq) GetRepoType() RepoType {
+func (x *ResolveSymbolAtResp) GetName() string {
 	if x != nil {
-		return x.RepoType
+		return x.Name
 	}
-	return RepoType_Local
+	return ""
 }
 
-func (x *AnalyzeReq) GetPath() string {
+func (x *ResolveSymbolAtResp) GetSpan() *SourceSpan {
 	if x != nil {
-		return x.Path
+		return x.Span
 	}
-	return ""
+	return nil
 }
 
-func (x *AnalyzeReq) GetTarget() string {
+func (x *ResolveSymbolAtResp) GetDefinition() *Symbol {
 	if x != nil {
-		return x.Target
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *ResolveSymbolAtResp) GetCandidates() []*Symbol {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type FindReferencesReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RepoId             string                 `protobuf:"bytes,1,opt,name=repoId,proto3" json:"repoId,omitempty"`
+	Id                 string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                  //实体、函数或字段的 ID
+	Snapshot           string                 `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                      //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
+	IncludeDeclaration bool                   `protobuf:"varint,4,opt,name=includeDeclaration,proto3" json:"includeDeclaration,omitempty"` //是否包括符号自身的声明
+	Limit              int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                           //默认 200
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FindReferencesReq) Reset() {
+	*x = FindReferencesReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindReferencesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindReferencesReq) ProtoMessage() {}
+
+func (x *FindReferencesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindReferencesReq.ProtoReflect.Descriptor instead.
+func (*FindReferencesReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FindReferencesReq) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
 	}
 	return ""
 }
 
-func (x *AnalyzeReq) GetToken() string {
+func (x *FindReferencesReq) GetId() string {
 	if x != nil {
-		return x.Token
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AnalyzeReq) GetLanguage() string {
+func (x *FindReferencesReq) GetSnapshot() string {
 	if x != nil {
-		return x.Language
+		return x.Snapshot
 	}
 	return ""
 }
 
-func (x *AnalyzeReq) GetIncludes() []string {
+func (x *FindReferencesReq) GetIncludeDeclaration() bool {
 	if x != nil {
-		return x.Includes
+		return x.IncludeDeclaration
 	}
-	return nil
+	return false
 }
 
-func (x *AnalyzeReq) GetExcludes() []string {
+func (x *FindReferencesReq) GetLimit() int32 {
 	if x != nil {
-		return x.Excludes
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type AnalyzeResp struct {
+type FindReferencesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Definition    *Symbol                `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	References    []*Reference           `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"` //按文件和位置排序
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`  //引用数或候选文件数达到上限，结果可能不完整
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalyzeResp) Reset() {
-	*x = AnalyzeResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[1]
+func (x *FindReferencesResp) Reset() {
+	*x = FindReferencesResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeResp) String() string {
+func (x *FindReferencesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeResp) ProtoMessage() {}
+func (*FindReferencesResp) ProtoMessage() {}
 
-func (x *AnalyzeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[1]
+func (x *FindReferencesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,47 +4278,57 @@ func (x *AnalyzeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeResp.ProtoReflect.Descriptor instead.
-func (*AnalyzeResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use FindReferencesResp.ProtoReflect.Descriptor instead.
+func (*FindReferencesResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AnalyzeResp) GetCode() int32 {
+func (x *FindReferencesResp) GetDefinition() *Symbol {
 	if x != nil {
-		return x.Code
+		return x.Definition
 	}
-	return 0
+	return nil
 }
 
-func (x *AnalyzeResp) GetMsg() string {
+func (x *FindReferencesResp) GetReferences() []*Reference {
 	if x != nil {
-		return x.Msg
+		return x.References
 	}
-	return ""
+	return nil
 }
 
-type CallChainReq struct {
+func (x *FindReferencesResp) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type Reference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	Span          *SourceSpan            `protobuf:"bytes,2,opt,name=span,proto3" json:"span,omitempty"` //标识符的位置
+	Kind          ReferenceKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=codewiki.v1.ReferenceKind" json:"kind,omitempty"`
+	EnclosingId   string                 `protobuf:"bytes,4,opt,name=enclosingId,proto3" json:"enclosingId,omitempty"` //引用所在的函数，不在函数中时为所在的实体或为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallChainReq) Reset() {
-	*x = CallChainReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[2]
+func (x *Reference) Reset() {
+	*x = Reference{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallChainReq) String() string {
+func (x *Reference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallChainReq) ProtoMessage() {}
+func (*Reference) ProtoMessage() {}
 
-func (x *CallChainReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[2]
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,42 +4339,67 @@ func (x *CallChainReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallChainReq.ProtoReflect.Descriptor instead.
-func (*CallChainReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CallChainReq) GetId() string {
+func (x *Reference) GetFileId() string {
 	if x != nil {
-		return x.Id
+		return x.FileId
 	}
 	return ""
 }
 
-type CallChainResp struct {
+func (x *Reference) GetSpan() *SourceSpan {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+func (x *Reference) GetKind() ReferenceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReferenceKind_RefOther
+}
+
+func (x *Reference) GetEnclosingId() string {
+	if x != nil {
+		return x.EnclosingId
+	}
+	return ""
+}
+
+type AnalyzeImpactReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	CallRelations []*CallRelationship    `protobuf:"bytes,3,rep,name=callRelations,proto3" json:"callRelations,omitempty"`
+	RepoId        string                 `protobuf:"bytes,1,opt,name=repoId,proto3" json:"repoId,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`       //快照 ID 或提交 SHA（前缀），为空时使用最新快照，变更的 ID 换到该快照中
+	FileIds       []string               `protobuf:"bytes,3,rep,name=fileIds,proto3" json:"fileIds,omitempty"`         //变更的文件，文件中的实体和函数都视为变更
+	FunctionIds   []string               `protobuf:"bytes,4,rep,name=functionIds,proto3" json:"functionIds,omitempty"` //变更的函数或实体
+	Diff          string                 `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`               //unified diff，按变更前的行号映射到快照中包含这些行的函数和实体
+	MaxDepth      int32                  `protobuf:"varint,6,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`      //沿反向关系查找的最大距离，默认 5
+	MaxNodes      int32                  `protobuf:"varint,7,opt,name=maxNodes,proto3" json:"maxNodes,omitempty"`      //最多返回的符号数，默认 1000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallChainResp) Reset() {
-	*x = CallChainResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[3]
+func (x *AnalyzeImpactReq) Reset() {
+	*x = AnalyzeImpactReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallChainResp) String() string {
+func (x *AnalyzeImpactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallChainResp) ProtoMessage() {}
+func (*AnalyzeImpactReq) ProtoMessage() {}
 
-func (x *CallChainResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[3]
+func (x *AnalyzeImpactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,63 +4410,89 @@ func (x *CallChainResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallChainResp.ProtoReflect.Descriptor instead.
-func (*CallChainResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AnalyzeImpactReq.ProtoReflect.Descriptor instead.
+func (*AnalyzeImpactReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CallChainResp) GetCode() int32 {
+func (x *AnalyzeImpactReq) GetRepoId() string {
 	if x != nil {
-		return x.Code
+		return x.RepoId
 	}
-	return 0
+	return ""
 }
 
-func (x *CallChainResp) GetMsg() string {
+func (x *AnalyzeImpactReq) GetSnapshot() string {
 	if x != nil {
-		return x.Msg
+		return x.Snapshot
 	}
 	return ""
 }
 
-func (x *CallChainResp) GetCallRelations() []*CallRelationship {
+func (x *AnalyzeImpactReq) GetFileIds() []string {
 	if x != nil {
-		return x.CallRelations
+		return x.FileIds
 	}
 	return nil
 }
 
-type CallRelationship struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CallerId       string                 `protobuf:"bytes,1,opt,name=callerId,proto3" json:"callerId,omitempty"`
-	CallerName     string                 `protobuf:"bytes,2,opt,name=callerName,proto3" json:"callerName,omitempty"`
-	CalleeId       string                 `protobuf:"bytes,3,opt,name=calleeId,proto3" json:"calleeId,omitempty"`
-	CalleeName     string                 `protobuf:"bytes,4,opt,name=calleeName,proto3" json:"calleeName,omitempty"`
-	CalleeFileId   string                 `protobuf:"bytes,5,opt,name=calleeFileId,proto3" json:"calleeFileId,omitempty"`
-	CallerFileId   string                 `protobuf:"bytes,6,opt,name=callerFileId,proto3" json:"callerFileId,omitempty"`
-	CalleeScope    int64                  `protobuf:"varint,7,opt,name=calleeScope,proto3" json:"calleeScope,omitempty"`
-	CallerScope    int64                  `protobuf:"varint,8,opt,name=callerScope,proto3" json:"callerScope,omitempty"`
-	CalleeEntityId string                 `protobuf:"bytes,9,opt,name=calleeEntityId,proto3" json:"calleeEntityId,omitempty"`
-	CallerEntityId string                 `protobuf:"bytes,10,opt,name=callerEntityId,proto3" json:"callerEntityId,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AnalyzeImpactReq) GetFunctionIds() []string {
+	if x != nil {
+		return x.FunctionIds
+	}
+	return nil
 }
 
-func (x *CallRelationship) Reset() {
-	*x = CallRelationship{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[4]
+func (x *AnalyzeImpactReq) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AnalyzeImpactReq) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *AnalyzeImpactReq) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+type AnalyzeImpactResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      string                 `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Symbols       []*ImpactedSymbol      `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`         //变更（距离 0）和受影响的实体、函数，按距离、ID 排序
+	Packages      []*ImpactedPackage     `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`       //符号所在的包，按距离、ID 排序
+	Exported      []*ImpactedSymbol      `protobuf:"bytes,4,rep,name=exported,proto3" json:"exported,omitempty"`       //symbols 中非测试文件里导出的符号
+	Entrypoints   []*ImpactedSymbol      `protobuf:"bytes,5,rep,name=entrypoints,proto3" json:"entrypoints,omitempty"` //symbols 中作为程序入口的函数，不包括测试函数
+	Tests         []*ImpactedSymbol      `protobuf:"bytes,6,rep,name=tests,proto3" json:"tests,omitempty"`             //symbols 中的测试函数，例如 _test.go 文件里的 Test、Benchmark、Fuzz、Example 函数
+	Unresolved    []string               `protobuf:"bytes,7,rep,name=unresolved,proto3" json:"unresolved,omitempty"`   //快照中找不到的 ID 和 diff 中的文件路径
+	Truncated     bool                   `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`    //达到 maxDepth 或 maxNodes，还有未展开的关系
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeImpactResp) Reset() {
+	*x = AnalyzeImpactResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallRelationship) String() string {
+func (x *AnalyzeImpactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallRelationship) ProtoMessage() {}
+func (*AnalyzeImpactResp) ProtoMessage() {}
 
-func (x *CallRelationship) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[4]
+func (x *AnalyzeImpactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,112 +4503,93 @@ func (x *CallRelationship) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallRelationship.ProtoReflect.Descriptor instead.
-func (*CallRelationship) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *CallRelationship) GetCallerId() string {
-	if x != nil {
-		return x.CallerId
-	}
-	return ""
-}
-
-func (x *CallRelationship) GetCallerName() string {
-	if x != nil {
-		return x.CallerName
-	}
-	return ""
+// Deprecated: Use AnalyzeImpactResp.ProtoReflect.Descriptor instead.
+func (*AnalyzeImpactResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CallRelationship) GetCalleeId() string {
+func (x *AnalyzeImpactResp) GetSnapshot() string {
 	if x != nil {
-		return x.CalleeId
+		return x.Snapshot
 	}
 	return ""
 }
 
-func (x *CallRelationship) GetCalleeName() string {
+func (x *AnalyzeImpactResp) GetSymbols() []*ImpactedSymbol {
 	if x != nil {
-		return x.CalleeName
+		return x.Symbols
 	}
-	return ""
+	return nil
 }
 
-func (x *CallRelationship) GetCalleeFileId() string {
+func (x *AnalyzeImpactResp) GetPackages() []*ImpactedPackage {
 	if x != nil {
-		return x.CalleeFileId
+		return x.Packages
 	}
-	return ""
+	return nil
 }
 
-func (x *CallRelationship) GetCallerFileId() string {
+func (x *AnalyzeImpactResp) GetExported() []*ImpactedSymbol {
 	if x != nil {
-		return x.CallerFileId
+		return x.Exported
 	}
-	return ""
+	return nil
 }
 
-func (x *CallRelationship) GetCalleeScope() int64 {
+func (x *AnalyzeImpactResp) GetEntrypoints() []*ImpactedSymbol {
 	if x != nil {
-		return x.CalleeScope
+		return x.Entrypoints
 	}
-	return 0
+	return nil
 }
 
-func (x *CallRelationship) GetCallerScope() int64 {
+func (x *AnalyzeImpactResp) GetTests() []*ImpactedSymbol {
 	if x != nil {
-		return x.CallerScope
+		return x.Tests
 	}
-	return 0
+	return nil
 }
 
-func (x *CallRelationship) GetCalleeEntityId() string {
+func (x *AnalyzeImpactResp) GetUnresolved() []string {
 	if x != nil {
-		return x.CalleeEntityId
+		return x.Unresolved
 	}
-	return ""
+	return nil
 }
 
-func (x *CallRelationship) GetCallerEntityId() string {
+func (x *AnalyzeImpactResp) GetTruncated() bool {
 	if x != nil {
-		return x.CallerEntityId
+		return x.Truncated
 	}
-	return ""
+	return false
 }
 
-// ===== Repo Management =====
-type Repo struct {
+type ImpactedSymbol struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	RepoType      RepoType               `protobuf:"varint,3,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`     // 本地路径，可选
-	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"` // 远端地址或本地路径
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`   // 令牌（如 GitHub），可选
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Excludes      []string               `protobuf:"bytes,8,rep,name=excludes,proto3" json:"excludes,omitempty"` //不需要分析的目录
-	Language      Language               `protobuf:"varint,9,opt,name=language,proto3,enum=codewiki.v1.Language" json:"language,omitempty"`
+	Symbol        *Symbol                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Distance      int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"` //与变更的距离
+	Via           string                 `protobuf:"bytes,3,opt,name=via,proto3" json:"via,omitempty"`            //距离少一的符号，symbol 通过 relation 关系指向它
+	Relation      string                 `protobuf:"bytes,4,opt,name=relation,proto3" json:"relation,omitempty"`  //Call、DispatchesTo、Implement 或 HasFields
+	Test          bool                   `protobuf:"varint,5,opt,name=test,proto3" json:"test,omitempty"`         //测试函数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Repo) Reset() {
-	*x = Repo{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[5]
+func (x *ImpactedSymbol) Reset() {
+	*x = ImpactedSymbol{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Repo) String() string {
+func (x *ImpactedSymbol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repo) ProtoMessage() {}
+func (*ImpactedSymbol) ProtoMessage() {}
 
-func (x *Repo) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[5]
+func (x *ImpactedSymbol) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,103 +4600,70 @@ func (x *Repo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
-func (*Repo) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *Repo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Repo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Repo) GetRepoType() RepoType {
-	if x != nil {
-		return x.RepoType
-	}
-	return RepoType_Local
+// Deprecated: Use ImpactedSymbol.ProtoReflect.Descriptor instead.
+func (*ImpactedSymbol) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Repo) GetPath() string {
+func (x *ImpactedSymbol) GetSymbol() *Symbol {
 	if x != nil {
-		return x.Path
+		return x.Symbol
 	}
-	return ""
+	return nil
 }
 
-func (x *Repo) GetTarget() string {
+func (x *ImpactedSymbol) GetDistance() int32 {
 	if x != nil {
-		return x.Target
+		return x.Distance
 	}
-	return ""
+	return 0
 }
 
-func (x *Repo) GetToken() string {
+func (x *ImpactedSymbol) GetVia() string {
 	if x != nil {
-		return x.Token
+		return x.Via
 	}
 	return ""
 }
 
-func (x *Repo) GetDescription() string {
+func (x *ImpactedSymbol) GetRelation() string {
 	if x != nil {
-		return x.Description
+		return x.Relation
 	}
 	return ""
 }
 
-func (x *Repo) GetExcludes() []string {
-	if x != nil {
-		return x.Excludes
-	}
-	return nil
-}
-
-func (x *Repo) GetLanguage() Language {
+func (x *ImpactedSymbol) GetTest() bool {
 	if x != nil {
-		return x.Language
+		return x.Test
 	}
-	return Language_Golang
+	return false
 }
 
-type CreateRepoReq struct {
+type ImpactedPackage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	RepoType      RepoType               `protobuf:"varint,2,opt,name=repoType,proto3,enum=codewiki.v1.RepoType" json:"repoType,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Excludes      []string               `protobuf:"bytes,7,rep,name=excludes,proto3" json:"excludes,omitempty"` //不需要分析的目录
-	Language      Language               `protobuf:"varint,8,opt,name=language,proto3,enum=codewiki.v1.Language" json:"language,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Distance      int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"` //包中符号的最小距离
+	Symbols       int32                  `protobuf:"varint,3,opt,name=symbols,proto3" json:"symbols,omitempty"`   //包中受影响的符号数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRepoReq) Reset() {
-	*x = CreateRepoReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[6]
+func (x *ImpactedPackage) Reset() {
+	*x = ImpactedPackage{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepoReq) String() string {
+func (x *ImpactedPackage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepoReq) ProtoMessage() {}
+func (*ImpactedPackage) ProtoMessage() {}
 
-func (x *CreateRepoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[6]
+func (x *ImpactedPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,89 +4674,107 @@ func (x *CreateRepoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepoReq.ProtoReflect.Descriptor instead.
-func (*CreateRepoReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ImpactedPackage.ProtoReflect.Descriptor instead.
+func (*ImpactedPackage) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateRepoReq) GetName() string {
+func (x *ImpactedPackage) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateRepoReq) GetRepoType() RepoType {
+func (x *ImpactedPackage) GetDistance() int32 {
 	if x != nil {
-		return x.RepoType
+		return x.Distance
 	}
-	return RepoType_Local
+	return 0
 }
 
-func (x *CreateRepoReq) GetPath() string {
+func (x *ImpactedPackage) GetSymbols() int32 {
 	if x != nil {
-		return x.Path
+		return x.Symbols
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateRepoReq) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
+type GetRepoTreeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时使用最新快照
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRepoReq) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+func (x *GetRepoTreeReq) Reset() {
+	*x = GetRepoTreeReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepoReq) GetDescription() string {
+func (x *GetRepoTreeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoTreeReq) ProtoMessage() {}
+
+func (x *GetRepoTreeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[56]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateRepoReq) GetExcludes() []string {
+// Deprecated: Use GetRepoTreeReq.ProtoReflect.Descriptor instead.
+func (*GetRepoTreeReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRepoTreeReq) GetId() string {
 	if x != nil {
-		return x.Excludes
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateRepoReq) GetLanguage() Language {
+func (x *GetRepoTreeReq) GetSnapshot() string {
 	if x != nil {
-		return x.Language
+		return x.Snapshot
 	}
-	return Language_Golang
+	return ""
 }
 
-type CreateRepoResp struct {
+type GetRepoTreeResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Packages      []*PackageNode         `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	Files         []*FileNode            `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRepoResp) Reset() {
-	*x = CreateRepoResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[7]
+func (x *GetRepoTreeResp) Reset() {
+	*x = GetRepoTreeResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRepoResp) String() string {
+func (x *GetRepoTreeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepoResp) ProtoMessage() {}
+func (*GetRepoTreeResp) ProtoMessage() {}
 
-func (x *CreateRepoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[7]
+func (x *GetRepoTreeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,39 +4785,49 @@ func (x *CreateRepoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepoResp.ProtoReflect.Descriptor instead.
-func (*CreateRepoResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetRepoTreeResp.ProtoReflect.Descriptor instead.
+func (*GetRepoTreeResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateRepoResp) GetId() string {
+func (x *GetRepoTreeResp) GetPackages() []*PackageNode {
 	if x != nil {
-		return x.Id
+		return x.Packages
 	}
-	return ""
+	return nil
 }
 
-type ListReposReq struct {
+func (x *GetRepoTreeResp) GetFiles() []*FileNode {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetPackageDependenciesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`   //包 ID，只返回该包及其子包的依赖，为空时返回整个仓库
+	Snapshot      string                 `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时使用最新快照，package 不为空时为空表示 package 所在的快照
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReposReq) Reset() {
-	*x = ListReposReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[8]
+func (x *GetPackageDependenciesReq) Reset() {
+	*x = GetPackageDependenciesReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReposReq) String() string {
+func (x *GetPackageDependenciesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReposReq) ProtoMessage() {}
+func (*GetPackageDependenciesReq) ProtoMessage() {}
 
-func (x *ListReposReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[8]
+func (x *GetPackageDependenciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,33 +4838,56 @@ func (x *ListReposReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReposReq.ProtoReflect.Descriptor instead.
-func (*ListReposReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetPackageDependenciesReq.ProtoReflect.Descriptor instead.
+func (*GetPackageDependenciesReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{58}
 }
 
-type ListReposResp struct {
+func (x *GetPackageDependenciesReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPackageDependenciesReq) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *GetPackageDependenciesReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+type GetPackageDependenciesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repos         []*Repo                `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	Packages      []*PackageNode         `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`         //依赖边两端的包，按 ID 排序
+	Dependencies  []*PackageDependency   `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"` //源包在范围内的依赖，按源包、目标包排序
+	Cycles        []*PackageCycle        `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`             //范围内互相依赖的包（强连通分量）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReposResp) Reset() {
-	*x = ListReposResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[9]
+func (x *GetPackageDependenciesResp) Reset() {
+	*x = GetPackageDependenciesResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReposResp) String() string {
+func (x *GetPackageDependenciesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReposResp) ProtoMessage() {}
+func (*GetPackageDependenciesResp) ProtoMessage() {}
 
-func (x *ListReposResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[9]
+func (x *GetPackageDependenciesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,40 +4898,56 @@ func (x *ListReposResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReposResp.ProtoReflect.Descriptor instead.
-func (*ListReposResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetPackageDependenciesResp.ProtoReflect.Descriptor instead.
+func (*GetPackageDependenciesResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetPackageDependenciesResp) GetPackages() []*PackageNode {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *GetPackageDependenciesResp) GetDependencies() []*PackageDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
 }
 
-func (x *ListReposResp) GetRepos() []*Repo {
+func (x *GetPackageDependenciesResp) GetCycles() []*PackageCycle {
 	if x != nil {
-		return x.Repos
+		return x.Cycles
 	}
 	return nil
 }
 
-type GetRepoReq struct {
+type PackageDependency struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` //源包中导入目标包的文件数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepoReq) Reset() {
-	*x = GetRepoReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[10]
+func (x *PackageDependency) Reset() {
+	*x = PackageDependency{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepoReq) String() string {
+func (x *PackageDependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepoReq) ProtoMessage() {}
+func (*PackageDependency) ProtoMessage() {}
 
-func (x *GetRepoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[10]
+func (x *PackageDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,40 +4958,54 @@ func (x *GetRepoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepoReq.ProtoReflect.Descriptor instead.
-func (*GetRepoReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PackageDependency.ProtoReflect.Descriptor instead.
+func (*PackageDependency) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetRepoReq) GetId() string {
+func (x *PackageDependency) GetSource() string {
 	if x != nil {
-		return x.Id
+		return x.Source
 	}
 	return ""
 }
 
-type GetRepoResp struct {
+func (x *PackageDependency) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *PackageDependency) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PackageCycle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          *Repo                  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Packages      []string               `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"` //按 ID 排序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepoResp) Reset() {
-	*x = GetRepoResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[11]
+func (x *PackageCycle) Reset() {
+	*x = PackageCycle{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepoResp) String() string {
+func (x *PackageCycle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepoResp) ProtoMessage() {}
+func (*PackageCycle) ProtoMessage() {}
 
-func (x *GetRepoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[11]
+func (x *PackageCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,40 +5016,46 @@ func (x *GetRepoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepoResp.ProtoReflect.Descriptor instead.
-func (*GetRepoResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PackageCycle.ProtoReflect.Descriptor instead.
+func (*PackageCycle) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetRepoResp) GetRepo() *Repo {
+func (x *PackageCycle) GetPackages() []string {
 	if x != nil {
-		return x.Repo
+		return x.Packages
 	}
 	return nil
 }
 
-type DeleteRepoReq struct {
+// ArchitectureRule 架构规则，from、to 为相对仓库根目录的包路径模式，* 匹配路径中的一段，** 匹配任意多段
+// 调用规则的 from、to 可以写成 包模式:函数模式，例如 internal/data/**:New*，方法的名称为 类型.方法
+type ArchitectureRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          ArchitectureRuleKind   `protobuf:"varint,2,opt,name=kind,proto3,enum=codewiki.v1.ArchitectureRuleKind" json:"kind,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepoReq) Reset() {
-	*x = DeleteRepoReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[12]
+func (x *ArchitectureRule) Reset() {
+	*x = ArchitectureRule{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepoReq) String() string {
+func (x *ArchitectureRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepoReq) ProtoMessage() {}
+func (*ArchitectureRule) ProtoMessage() {}
 
-func (x *DeleteRepoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[12]
+func (x *ArchitectureRule) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,39 +5066,69 @@ func (x *DeleteRepoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepoReq.ProtoReflect.Descriptor instead.
-func (*DeleteRepoReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ArchitectureRule.ProtoReflect.Descriptor instead.
+func (*ArchitectureRule) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DeleteRepoReq) GetId() string {
+func (x *ArchitectureRule) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteRepoResp struct {
+func (x *ArchitectureRule) GetKind() ArchitectureRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ArchitectureRuleKind_RuleForbidDependency
+}
+
+func (x *ArchitectureRule) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ArchitectureRule) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ArchitectureRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ArchitectureRules 规则文件的内容
+type ArchitectureRules struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ArchitectureRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepoResp) Reset() {
-	*x = DeleteRepoResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[13]
+func (x *ArchitectureRules) Reset() {
+	*x = ArchitectureRules{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepoResp) String() string {
+func (x *ArchitectureRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepoResp) ProtoMessage() {}
+func (*ArchitectureRules) ProtoMessage() {}
 
-func (x *DeleteRepoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[13]
+func (x *ArchitectureRules) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,33 +5139,45 @@ func (x *DeleteRepoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepoResp.ProtoReflect.Descriptor instead.
-func (*DeleteRepoResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ArchitectureRules.ProtoReflect.Descriptor instead.
+func (*ArchitectureRules) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{63}
 }
 
-type AnalyzeRepoReq struct {
+func (x *ArchitectureRules) GetRules() []*ArchitectureRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ArchitectureViolation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` //违反的规则名称
+	Kind          ArchitectureRuleKind   `protobuf:"varint,2,opt,name=kind,proto3,enum=codewiki.v1.ArchitectureRuleKind" json:"kind,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`         //依赖方的包 ID 或调用方的函数 ID
+	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`         //被依赖的包 ID 或被调用的函数 ID
+	SourcePath    string                 `protobuf:"bytes,5,opt,name=sourcePath,proto3" json:"sourcePath,omitempty"` //相对仓库根目录的路径，函数为 路径:名称
+	TargetPath    string                 `protobuf:"bytes,6,opt,name=targetPath,proto3" json:"targetPath,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalyzeRepoReq) Reset() {
-	*x = AnalyzeRepoReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[14]
+func (x *ArchitectureViolation) Reset() {
+	*x = ArchitectureViolation{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeRepoReq) String() string {
+func (x *ArchitectureViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeRepoReq) ProtoMessage() {}
+func (*ArchitectureViolation) ProtoMessage() {}
 
-func (x *AnalyzeRepoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[14]
+func (x *ArchitectureViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,40 +5188,76 @@ func (x *AnalyzeRepoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeRepoReq.ProtoReflect.Descriptor instead.
-func (*AnalyzeRepoReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ArchitectureViolation.ProtoReflect.Descriptor instead.
+func (*ArchitectureViolation) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *AnalyzeRepoReq) GetId() string {
+func (x *ArchitectureViolation) GetRule() string {
 	if x != nil {
-		return x.Id
+		return x.Rule
 	}
 	return ""
 }
 
-type GetRepoTreeReq struct {
+func (x *ArchitectureViolation) GetKind() ArchitectureRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ArchitectureRuleKind_RuleForbidDependency
+}
+
+func (x *ArchitectureViolation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ArchitectureViolation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ArchitectureViolation) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *ArchitectureViolation) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+type GetArchitectureViolationsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时使用最新快照
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepoTreeReq) Reset() {
-	*x = GetRepoTreeReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[15]
+func (x *GetArchitectureViolationsReq) Reset() {
+	*x = GetArchitectureViolationsReq{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepoTreeReq) String() string {
+func (x *GetArchitectureViolationsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepoTreeReq) ProtoMessage() {}
+func (*GetArchitectureViolationsReq) ProtoMessage() {}
 
-func (x *GetRepoTreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[15]
+func (x *GetArchitectureViolationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,41 +5268,48 @@ func (x *GetRepoTreeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepoTreeReq.ProtoReflect.Descriptor instead.
-func (*GetRepoTreeReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetArchitectureViolationsReq.ProtoReflect.Descriptor instead.
+func (*GetArchitectureViolationsReq) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetRepoTreeReq) GetId() string {
+func (x *GetArchitectureViolationsReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetRepoTreeResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Packages      []*PackageNode         `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
-	Files         []*FileNode            `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+func (x *GetArchitectureViolationsReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+type GetArchitectureViolationsResp struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Snapshot      string                   `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Violations    []*ArchitectureViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"` //按规则、源、目标排序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepoTreeResp) Reset() {
-	*x = GetRepoTreeResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[16]
+func (x *GetArchitectureViolationsResp) Reset() {
+	*x = GetArchitectureViolationsResp{}
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepoTreeResp) String() string {
+func (x *GetArchitectureViolationsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepoTreeResp) ProtoMessage() {}
+func (*GetArchitectureViolationsResp) ProtoMessage() {}
 
-func (x *GetRepoTreeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[16]
+func (x *GetArchitectureViolationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,21 +5320,21 @@ func (x *GetRepoTreeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepoTreeResp.ProtoReflect.Descriptor instead.
-func (*GetRepoTreeResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetArchitectureViolationsResp.ProtoReflect.Descriptor instead.
+func (*GetArchitectureViolationsResp) Descriptor() ([]byte, []int) {
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetRepoTreeResp) GetPackages() []*PackageNode {
+func (x *GetArchitectureViolationsResp) GetSnapshot() string {
 	if x != nil {
-		return x.Packages
+		return x.Snapshot
 	}
-	return nil
+	return ""
 }
 
-func (x *GetRepoTreeResp) GetFiles() []*FileNode {
+func (x *GetArchitectureViolationsResp) GetViolations() []*ArchitectureViolation {
 	if x != nil {
-		return x.Files
+		return x.Violations
 	}
 	return nil
 }
@@ -1193,7 +5350,7 @@ type PackageNode struct {
 
 func (x *PackageNode) Reset() {
 	*x = PackageNode{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[17]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1205,7 +5362,7 @@ func (x *PackageNode) String() string {
 func (*PackageNode) ProtoMessage() {}
 
 func (x *PackageNode) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[17]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,7 +5375,7 @@ func (x *PackageNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageNode.ProtoReflect.Descriptor instead.
 func (*PackageNode) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{17}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *PackageNode) GetId() string {
@@ -1253,7 +5410,7 @@ type FileNode struct {
 
 func (x *FileNode) Reset() {
 	*x = FileNode{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[18]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1265,7 +5422,7 @@ func (x *FileNode) String() string {
 func (*FileNode) ProtoMessage() {}
 
 func (x *FileNode) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[18]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,7 +5435,7 @@ func (x *FileNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileNode.ProtoReflect.Descriptor instead.
 func (*FileNode) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{18}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *FileNode) GetId() string {
@@ -1306,13 +5463,14 @@ type ViewFileReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RepoId        string                 `protobuf:"bytes,2,opt,name=repoId,proto3" json:"repoId,omitempty"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ViewFileReq) Reset() {
 	*x = ViewFileReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[19]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1324,7 +5482,7 @@ func (x *ViewFileReq) String() string {
 func (*ViewFileReq) ProtoMessage() {}
 
 func (x *ViewFileReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[19]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +5495,7 @@ func (x *ViewFileReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ViewFileReq.ProtoReflect.Descriptor instead.
 func (*ViewFileReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{19}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ViewFileReq) GetRepoId() string {
@@ -1354,6 +5512,13 @@ func (x *ViewFileReq) GetId() string {
 	return ""
 }
 
+func (x *ViewFileReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
 type ViewFileResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Content       string                 `protobuf:"bytes,1,opt,name=Content,proto3" json:"Content,omitempty"`
@@ -1365,7 +5530,7 @@ type ViewFileResp struct {
 
 func (x *ViewFileResp) Reset() {
 	*x = ViewFileResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[20]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1377,7 +5542,7 @@ func (x *ViewFileResp) String() string {
 func (*ViewFileResp) ProtoMessage() {}
 
 func (x *ViewFileResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[20]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,7 +5555,7 @@ func (x *ViewFileResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ViewFileResp.ProtoReflect.Descriptor instead.
 func (*ViewFileResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{20}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ViewFileResp) GetContent() string {
@@ -1420,13 +5585,14 @@ type Function struct {
 	FileId        string                 `protobuf:"bytes,2,opt,name=fileId,proto3" json:"fileId,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Receiver      string                 `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Span          *SourceSpan            `protobuf:"bytes,5,opt,name=span,proto3" json:"span,omitempty"` //声明在文件中的位置
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Function) Reset() {
 	*x = Function{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[21]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1438,7 +5604,7 @@ func (x *Function) String() string {
 func (*Function) ProtoMessage() {}
 
 func (x *Function) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[21]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,7 +5617,7 @@ func (x *Function) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Function.ProtoReflect.Descriptor instead.
 func (*Function) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{21}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *Function) GetId() string {
@@ -1482,6 +5648,13 @@ func (x *Function) GetReceiver() string {
 	return ""
 }
 
+func (x *Function) GetSpan() *SourceSpan {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
 type Entity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1494,7 +5667,7 @@ type Entity struct {
 
 func (x *Entity) Reset() {
 	*x = Entity{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[22]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +5679,7 @@ func (x *Entity) String() string {
 func (*Entity) ProtoMessage() {}
 
 func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[22]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +5692,7 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entity.ProtoReflect.Descriptor instead.
 func (*Entity) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{22}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *Entity) GetName() string {
@@ -1553,13 +5726,14 @@ func (x *Entity) GetFunctions() []*Function {
 type GetImplementReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Snapshot      string                 `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` //快照 ID 或提交 SHA（前缀），为空时查询 id 所在的快照
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetImplementReq) Reset() {
 	*x = GetImplementReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[23]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +5745,7 @@ func (x *GetImplementReq) String() string {
 func (*GetImplementReq) ProtoMessage() {}
 
 func (x *GetImplementReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[23]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +5758,7 @@ func (x *GetImplementReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImplementReq.ProtoReflect.Descriptor instead.
 func (*GetImplementReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{23}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetImplementReq) GetId() string {
@@ -1594,6 +5768,13 @@ func (x *GetImplementReq) GetId() string {
 	return ""
 }
 
+func (x *GetImplementReq) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
 type GetImplementResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Entities      []*Entity              `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
@@ -1603,7 +5784,7 @@ type GetImplementResp struct {
 
 func (x *GetImplementResp) Reset() {
 	*x = GetImplementResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[24]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +5796,7 @@ func (x *GetImplementResp) String() string {
 func (*GetImplementResp) ProtoMessage() {}
 
 func (x *GetImplementResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[24]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +5809,7 @@ func (x *GetImplementResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImplementResp.ProtoReflect.Descriptor instead.
 func (*GetImplementResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{24}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetImplementResp) GetEntities() []*Entity {
@@ -1648,7 +5829,7 @@ type AnswerReq struct {
 
 func (x *AnswerReq) Reset() {
 	*x = AnswerReq{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[25]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1660,7 +5841,7 @@ func (x *AnswerReq) String() string {
 func (*AnswerReq) ProtoMessage() {}
 
 func (x *AnswerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[25]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +5854,7 @@ func (x *AnswerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerReq.ProtoReflect.Descriptor instead.
 func (*AnswerReq) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{25}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *AnswerReq) GetId() string {
@@ -1704,7 +5885,7 @@ type AnswerResp struct {
 
 func (x *AnswerResp) Reset() {
 	*x = AnswerResp{}
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[26]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1716,7 +5897,7 @@ func (x *AnswerResp) String() string {
 func (*AnswerResp) ProtoMessage() {}
 
 func (x *AnswerResp) ProtoReflect() protoreflect.Message {
-	mi := &file_codewiki_v1_codewiki_proto_msgTypes[26]
+	mi := &file_codewiki_v1_codewiki_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1729,7 +5910,7 @@ func (x *AnswerResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerResp.ProtoReflect.Descriptor instead.
 func (*AnswerResp) Descriptor() ([]byte, []int) {
-	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{26}
+	return file_codewiki_v1_codewiki_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *AnswerResp) GetAnswer() string {
@@ -1788,16 +5969,59 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1a\n" +
 	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1a\n" +
 	"\bincludes\x18\x06 \x03(\tR\bincludes\x12\x1a\n" +
-	"\bexcludes\x18\a \x03(\tR\bexcludes\"3\n" +
+	"\bexcludes\x18\a \x03(\tR\bexcludes\"\x84\x01\n" +
 	"\vAnalyzeResp\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\x1e\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x129\n" +
+	"\asummary\x18\x03 \x01(\v2\x1b.codewiki.v1.AnalyzeSummaryB\x02\x18\x01R\asummary\x12\x14\n" +
+	"\x05jobId\x18\x04 \x01(\tR\x05jobId\"v\n" +
+	"\x0eAnalyzeSummary\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\tR\x05added\x12\x18\n" +
+	"\achanged\x18\x02 \x03(\tR\achanged\x12\x18\n" +
+	"\aremoved\x18\x03 \x03(\tR\aremoved\x12\x1a\n" +
+	"\banalyzed\x18\x04 \x01(\x05R\banalyzed\"\xcd\x02\n" +
 	"\fCallChainReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"z\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0efollowDispatch\x18\x02 \x01(\bR\x0efollowDispatch\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\x12%\n" +
+	"\bmaxDepth\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\bmaxDepth\x12&\n" +
+	"\bmaxNodes\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x88'(\x00R\bmaxNodes\x128\n" +
+	"\tdirection\x18\x06 \x01(\x0e2\x1a.codewiki.v1.CallDirectionR\tdirection\x12$\n" +
+	"\rrelationTypes\x18\a \x03(\tR\rrelationTypes\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\"\n" +
+	"\fexcludeTests\x18\t \x01(\bR\fexcludeTests\"\xcf\x01\n" +
 	"\rCallChainResp\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12C\n" +
-	"\rcallRelations\x18\x03 \x03(\v2\x1d.codewiki.v1.CallRelationshipR\rcallRelations\"\xe6\x02\n" +
+	"\rcallRelations\x18\x03 \x03(\v2\x1d.codewiki.v1.CallRelationshipR\rcallRelations\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x125\n" +
+	"\bfrontier\x18\x05 \x03(\v2\x19.codewiki.v1.CallFrontierR\bfrontier\"L\n" +
+	"\fCallFrontier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\xee\x01\n" +
+	"\x0fCallersChainReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12%\n" +
+	"\bmaxDepth\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\bmaxDepth\x123\n" +
+	"\x06stopAt\x18\x03 \x03(\x0e2\x1b.codewiki.v1.EntrypointKindR\x06stopAt\x12&\n" +
+	"\x0efollowDispatch\x18\x04 \x01(\bR\x0efollowDispatch\x12\x1a\n" +
+	"\bsnapshot\x18\x05 \x01(\tR\bsnapshot\x12\"\n" +
+	"\fexcludeTests\x18\x06 \x01(\bR\fexcludeTests\"\xf5\x01\n" +
+	"\vCallPathReq\x12\x1f\n" +
+	"\x06source\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06source\x12\x1f\n" +
+	"\x06target\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06target\x12\x17\n" +
+	"\x01k\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\n" +
+	"(\x00R\x01k\x12%\n" +
+	"\bmaxDepth\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x1e(\x00R\bmaxDepth\x12$\n" +
+	"\rrelationTypes\x18\x05 \x03(\tR\rrelationTypes\x12\x1a\n" +
+	"\bsnapshot\x18\x06 \x01(\tR\bsnapshot\x12\"\n" +
+	"\fexcludeTests\x18\a \x01(\bR\fexcludeTests\"]\n" +
+	"\fCallPathResp\x12/\n" +
+	"\x05paths\x18\x01 \x03(\v2\x19.codewiki.v1.FunctionPathR\x05paths\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"A\n" +
+	"\fFunctionPath\x121\n" +
+	"\x04hops\x18\x01 \x03(\v2\x1d.codewiki.v1.CallRelationshipR\x04hops\"\xb7\x03\n" +
 	"\x10CallRelationship\x12\x1a\n" +
 	"\bcallerId\x18\x01 \x01(\tR\bcallerId\x12\x1e\n" +
 	"\n" +
@@ -1813,7 +6037,15 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\vcallerScope\x18\b \x01(\x03R\vcallerScope\x12&\n" +
 	"\x0ecalleeEntityId\x18\t \x01(\tR\x0ecalleeEntityId\x12&\n" +
 	"\x0ecallerEntityId\x18\n" +
-	" \x01(\tR\x0ecallerEntityId\"\x90\x02\n" +
+	" \x01(\tR\x0ecallerEntityId\x12\x1a\n" +
+	"\brelation\x18\v \x01(\tR\brelation\x123\n" +
+	"\bcallSite\x18\f \x01(\v2\x17.codewiki.v1.SourceSpanR\bcallSite\"\x84\x01\n" +
+	"\n" +
+	"SourceSpan\x12\x1c\n" +
+	"\tstartLine\x18\x01 \x01(\x05R\tstartLine\x12 \n" +
+	"\vstartColumn\x18\x02 \x01(\x05R\vstartColumn\x12\x18\n" +
+	"\aendLine\x18\x03 \x01(\x05R\aendLine\x12\x1c\n" +
+	"\tendColumn\x18\x04 \x01(\x05R\tendColumn\"\x96\x03\n" +
 	"\x04Repo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
@@ -1823,7 +6055,11 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\x05token\x18\x06 \x01(\tR\x05token\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1a\n" +
 	"\bexcludes\x18\b \x03(\tR\bexcludes\x121\n" +
-	"\blanguage\x18\t \x01(\x0e2\x15.codewiki.v1.LanguageR\blanguage\"\xa1\x02\n" +
+	"\blanguage\x18\t \x01(\x0e2\x15.codewiki.v1.LanguageR\blanguage\x12=\n" +
+	"\fcallResolver\x18\n" +
+	" \x01(\x0e2\x19.codewiki.v1.CallResolverR\fcallResolver\x12\x10\n" +
+	"\x03ref\x18\v \x01(\tR\x03ref\x123\n" +
+	"\x05rules\x18\f \x03(\v2\x1d.codewiki.v1.ArchitectureRuleR\x05rules\"\xa7\x03\n" +
 	"\rCreateRepoReq\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x121\n" +
@@ -1834,7 +6070,11 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\x05token\x18\x05 \x01(\tR\x05token\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1a\n" +
 	"\bexcludes\x18\a \x03(\tR\bexcludes\x121\n" +
-	"\blanguage\x18\b \x01(\x0e2\x15.codewiki.v1.LanguageR\blanguage\" \n" +
+	"\blanguage\x18\b \x01(\x0e2\x15.codewiki.v1.LanguageR\blanguage\x12=\n" +
+	"\fcallResolver\x18\t \x01(\x0e2\x19.codewiki.v1.CallResolverR\fcallResolver\x12\x10\n" +
+	"\x03ref\x18\n" +
+	" \x01(\tR\x03ref\x123\n" +
+	"\x05rules\x18\v \x03(\v2\x1d.codewiki.v1.ArchitectureRuleR\x05rules\" \n" +
 	"\x0eCreateRepoResp\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x0e\n" +
 	"\fListReposReq\"8\n" +
@@ -1847,14 +6087,260 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\x04repo\x18\x01 \x01(\v2\x11.codewiki.v1.RepoR\x04repo\"\x1f\n" +
 	"\rDeleteRepoReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x10\n" +
-	"\x0eDeleteRepoResp\" \n" +
+	"\x0eDeleteRepoResp\"B\n" +
 	"\x0eAnalyzeRepoReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vincremental\x18\x02 \x01(\bR\vincremental\"\xfe\x03\n" +
+	"\vAnalysisJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06repoId\x18\x02 \x01(\tR\x06repoId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.codewiki.v1.JobStatusR\x06status\x120\n" +
+	"\x05phase\x18\x04 \x01(\x0e2\x1a.codewiki.v1.AnalysisPhaseR\x05phase\x12 \n" +
+	"\vincremental\x18\x05 \x01(\bR\vincremental\x12\x1e\n" +
+	"\n" +
+	"totalFiles\x18\x06 \x01(\x05R\n" +
+	"totalFiles\x12&\n" +
+	"\x0eprocessedFiles\x18\a \x01(\x05R\x0eprocessedFiles\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"durationMs\x18\t \x01(\x03R\n" +
+	"durationMs\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tstartedAt\x18\v \x01(\x03R\tstartedAt\x12\x1e\n" +
+	"\n" +
+	"finishedAt\x18\f \x01(\x03R\n" +
+	"finishedAt\x125\n" +
+	"\asummary\x18\r \x01(\v2\x1b.codewiki.v1.AnalyzeSummaryR\asummary\x12\x16\n" +
+	"\x06commit\x18\x0e \x01(\tR\x06commit\x12\x1a\n" +
+	"\bsnapshot\x18\x0f \x01(\tR\bsnapshot\"\x92\x01\n" +
+	"\bSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06repoId\x18\x02 \x01(\tR\x06repoId\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\x12\x10\n" +
+	"\x03ref\x18\x04 \x01(\tR\x03ref\x12\x14\n" +
+	"\x05jobId\x18\x05 \x01(\tR\x05jobId\x12\x1e\n" +
+	"\n" +
+	"analyzedAt\x18\x06 \x01(\x03R\n" +
+	"analyzedAt\"\"\n" +
+	"\x10ListSnapshotsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x11ListSnapshotsResp\x123\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x15.codewiki.v1.SnapshotR\tsnapshots\"\xa3\x01\n" +
+	"\rDiffGraphsReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
+	"\x04base\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04base\x12\x12\n" +
+	"\x04head\x18\x03 \x01(\tR\x04head\x12\"\n" +
+	"\fexportedOnly\x18\x04 \x01(\bR\fexportedOnly\x12$\n" +
+	"\rrelationTypes\x18\x05 \x03(\tR\rrelationTypes\"\x8c\x02\n" +
+	"\x0eDiffGraphsResp\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x12\n" +
+	"\x04head\x18\x02 \x01(\tR\x04head\x121\n" +
+	"\bpackages\x18\x03 \x01(\v2\x15.codewiki.v1.NodeDiffR\bpackages\x121\n" +
+	"\bentities\x18\x04 \x01(\v2\x15.codewiki.v1.NodeDiffR\bentities\x123\n" +
+	"\tfunctions\x18\x05 \x01(\v2\x15.codewiki.v1.NodeDiffR\tfunctions\x127\n" +
+	"\trelations\x18\x06 \x01(\v2\x19.codewiki.v1.RelationDiffR\trelations\"\x99\x01\n" +
+	"\bNodeDiff\x12+\n" +
+	"\x05added\x18\x01 \x03(\v2\x15.codewiki.v1.DiffNodeR\x05added\x12/\n" +
+	"\aremoved\x18\x02 \x03(\v2\x15.codewiki.v1.DiffNodeR\aremoved\x12/\n" +
+	"\achanged\x18\x03 \x03(\v2\x15.codewiki.v1.DiffNodeR\achanged\"F\n" +
+	"\bDiffNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06fileId\x18\x03 \x01(\tR\x06fileId\"t\n" +
+	"\fRelationDiff\x12/\n" +
+	"\x05added\x18\x01 \x03(\v2\x19.codewiki.v1.DiffRelationR\x05added\x123\n" +
+	"\aremoved\x18\x02 \x03(\v2\x19.codewiki.v1.DiffRelationR\aremoved\"Z\n" +
+	"\fDiffRelation\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bsourceId\x18\x02 \x01(\tR\bsourceId\x12\x1a\n" +
+	"\btargetId\x18\x03 \x01(\tR\btargetId\"d\n" +
+	"\x18CheckAPICompatibilityReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
+	"\x04base\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04base\x12\x12\n" +
+	"\x04head\x18\x03 \x01(\tR\x04head\"\xbe\x01\n" +
+	"\x19CheckAPICompatibilityResp\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x12\n" +
+	"\x04head\x18\x02 \x01(\tR\x04head\x120\n" +
+	"\achanges\x18\x03 \x03(\v2\x16.codewiki.v1.APIChangeR\achanges\x12\x1a\n" +
+	"\bbreaking\x18\x04 \x01(\bR\bbreaking\x12+\n" +
+	"\x04bump\x18\x05 \x01(\x0e2\x17.codewiki.v1.SemverBumpR\x04bump\"\xb7\x01\n" +
+	"\tAPIChange\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.codewiki.v1.APIChangeKindR\x04kind\x12\x18\n" +
+	"\apackage\x18\x02 \x01(\tR\apackage\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06before\x18\x04 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x05 \x01(\tR\x05after\x12\x1a\n" +
+	"\bbreaking\x18\x06 \x01(\bR\bbreaking\"#\n" +
+	"\x11GetAnalysisJobReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x12GetAnalysisJobResp\x12*\n" +
+	"\x03job\x18\x01 \x01(\v2\x18.codewiki.v1.AnalysisJobR\x03job\"C\n" +
+	"\x13ListAnalysisJobsReq\x12\x16\n" +
+	"\x06repoId\x18\x01 \x01(\tR\x06repoId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"D\n" +
+	"\x14ListAnalysisJobsResp\x12,\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x18.codewiki.v1.AnalysisJobR\x04jobs\"&\n" +
+	"\x14CancelAnalysisJobReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15CancelAnalysisJobResp\"\xe8\x02\n" +
+	"\x10SearchSymbolsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x05query\x120\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x1c.codewiki.v1.SymbolMatchModeR\x04mode\x12-\n" +
+	"\x05kinds\x18\x04 \x03(\x0e2\x17.codewiki.v1.SymbolKindR\x05kinds\x12\x18\n" +
+	"\apackage\x18\x05 \x01(\tR\apackage\x12\"\n" +
+	"\fexportedOnly\x18\x06 \x01(\bR\fexportedOnly\x12\x1a\n" +
+	"\bsnapshot\x18\a \x01(\tR\bsnapshot\x12%\n" +
+	"\bpageSize\x18\b \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\t \x01(\tR\tpageToken\x12\"\n" +
+	"\fexcludeTests\x18\n" +
+	" \x01(\bR\fexcludeTests\"\x9c\x01\n" +
+	"\x11SearchSymbolsResp\x12-\n" +
+	"\asymbols\x18\x01 \x03(\v2\x13.codewiki.v1.SymbolR\asymbols\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\"\xb6\x02\n" +
+	"\x06Symbol\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x17.codewiki.v1.SymbolKindR\x04kind\x12\x1a\n" +
+	"\bparentId\x18\x04 \x01(\tR\bparentId\x12\x1a\n" +
+	"\bexported\x18\x05 \x01(\bR\bexported\x12\x14\n" +
+	"\x05fanIn\x18\x06 \x01(\x05R\x05fanIn\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12\x16\n" +
+	"\x06fileId\x18\b \x01(\tR\x06fileId\x12+\n" +
+	"\x04span\x18\t \x01(\v2\x17.codewiki.v1.SourceSpanR\x04span\x12\x1e\n" +
+	"\n" +
+	"entrypoint\x18\n" +
+	" \x01(\tR\n" +
+	"entrypoint\x12\x12\n" +
+	"\x04test\x18\v \x01(\bR\x04test\"J\n" +
+	"\x13GetFunctionTestsReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\"c\n" +
+	"\x14GetFunctionTestsResp\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\tR\bsnapshot\x12/\n" +
+	"\x05tests\x18\x02 \x03(\v2\x19.codewiki.v1.FunctionTestR\x05tests\"Q\n" +
+	"\fFunctionTest\x12+\n" +
+	"\x06symbol\x18\x01 \x01(\v2\x13.codewiki.v1.SymbolR\x06symbol\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"\xb0\x01\n" +
+	"\x12ResolveSymbolAtReq\x12\x1f\n" +
+	"\x06repoId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06repoId\x12\x1f\n" +
+	"\x06fileId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06fileId\x12\x1b\n" +
+	"\x04line\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04line\x12\x1f\n" +
+	"\x06column\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x06column\x12\x1a\n" +
+	"\bsnapshot\x18\x05 \x01(\tR\bsnapshot\"\xc0\x01\n" +
+	"\x13ResolveSymbolAtResp\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x04span\x18\x02 \x01(\v2\x17.codewiki.v1.SourceSpanR\x04span\x123\n" +
+	"\n" +
+	"definition\x18\x03 \x01(\v2\x13.codewiki.v1.SymbolR\n" +
+	"definition\x123\n" +
+	"\n" +
+	"candidates\x18\x04 \x03(\v2\x13.codewiki.v1.SymbolR\n" +
+	"candidates\"\xbb\x01\n" +
+	"\x11FindReferencesReq\x12\x1f\n" +
+	"\x06repoId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06repoId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\x12.\n" +
+	"\x12includeDeclaration\x18\x04 \x01(\bR\x12includeDeclaration\x12 \n" +
+	"\x05limit\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"\x9f\x01\n" +
+	"\x12FindReferencesResp\x123\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2\x13.codewiki.v1.SymbolR\n" +
+	"definition\x126\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2\x16.codewiki.v1.ReferenceR\n" +
+	"references\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xa2\x01\n" +
+	"\tReference\x12\x16\n" +
+	"\x06fileId\x18\x01 \x01(\tR\x06fileId\x12+\n" +
+	"\x04span\x18\x02 \x01(\v2\x17.codewiki.v1.SourceSpanR\x04span\x12.\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1a.codewiki.v1.ReferenceKindR\x04kind\x12 \n" +
+	"\venclosingId\x18\x04 \x01(\tR\venclosingId\"\xee\x01\n" +
+	"\x10AnalyzeImpactReq\x12\x1f\n" +
+	"\x06repoId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06repoId\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x12\x18\n" +
+	"\afileIds\x18\x03 \x03(\tR\afileIds\x12 \n" +
+	"\vfunctionIds\x18\x04 \x03(\tR\vfunctionIds\x12\x12\n" +
+	"\x04diff\x18\x05 \x01(\tR\x04diff\x12%\n" +
+	"\bmaxDepth\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\bmaxDepth\x12&\n" +
+	"\bmaxNodes\x18\a \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\bmaxNodes\"\x89\x03\n" +
+	"\x11AnalyzeImpactResp\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\tR\bsnapshot\x125\n" +
+	"\asymbols\x18\x02 \x03(\v2\x1b.codewiki.v1.ImpactedSymbolR\asymbols\x128\n" +
+	"\bpackages\x18\x03 \x03(\v2\x1c.codewiki.v1.ImpactedPackageR\bpackages\x127\n" +
+	"\bexported\x18\x04 \x03(\v2\x1b.codewiki.v1.ImpactedSymbolR\bexported\x12=\n" +
+	"\ventrypoints\x18\x05 \x03(\v2\x1b.codewiki.v1.ImpactedSymbolR\ventrypoints\x121\n" +
+	"\x05tests\x18\x06 \x03(\v2\x1b.codewiki.v1.ImpactedSymbolR\x05tests\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\a \x03(\tR\n" +
+	"unresolved\x12\x1c\n" +
+	"\ttruncated\x18\b \x01(\bR\ttruncated\"\x9b\x01\n" +
+	"\x0eImpactedSymbol\x12+\n" +
+	"\x06symbol\x18\x01 \x01(\v2\x13.codewiki.v1.SymbolR\x06symbol\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12\x10\n" +
+	"\x03via\x18\x03 \x01(\tR\x03via\x12\x1a\n" +
+	"\brelation\x18\x04 \x01(\tR\brelation\x12\x12\n" +
+	"\x04test\x18\x05 \x01(\bR\x04test\"W\n" +
+	"\x0fImpactedPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12\x18\n" +
+	"\asymbols\x18\x03 \x01(\x05R\asymbols\"<\n" +
 	"\x0eGetRepoTreeReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\"t\n" +
 	"\x0fGetRepoTreeResp\x124\n" +
 	"\bpackages\x18\x01 \x03(\v2\x18.codewiki.v1.PackageNodeR\bpackages\x12+\n" +
-	"\x05files\x18\x02 \x03(\v2\x15.codewiki.v1.FileNodeR\x05files\"M\n" +
+	"\x05files\x18\x02 \x03(\v2\x15.codewiki.v1.FileNodeR\x05files\"a\n" +
+	"\x19GetPackageDependenciesReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\apackage\x18\x02 \x01(\tR\apackage\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\"\xc9\x01\n" +
+	"\x1aGetPackageDependenciesResp\x124\n" +
+	"\bpackages\x18\x01 \x03(\v2\x18.codewiki.v1.PackageNodeR\bpackages\x12B\n" +
+	"\fdependencies\x18\x02 \x03(\v2\x1e.codewiki.v1.PackageDependencyR\fdependencies\x121\n" +
+	"\x06cycles\x18\x03 \x03(\v2\x19.codewiki.v1.PackageCycleR\x06cycles\"Y\n" +
+	"\x11PackageDependency\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"*\n" +
+	"\fPackageCycle\x12\x1a\n" +
+	"\bpackages\x18\x01 \x03(\tR\bpackages\"\xc7\x01\n" +
+	"\x10ArchitectureRule\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.codewiki.v1.ArchitectureRuleKindR\x04kind\x12\x1e\n" +
+	"\x04from\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04from\x12\x1a\n" +
+	"\x02to\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x02to\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"H\n" +
+	"\x11ArchitectureRules\x123\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1d.codewiki.v1.ArchitectureRuleR\x05rules\"\xd2\x01\n" +
+	"\x15ArchitectureViolation\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.codewiki.v1.ArchitectureRuleKindR\x04kind\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x1e\n" +
+	"\n" +
+	"sourcePath\x18\x05 \x01(\tR\n" +
+	"sourcePath\x12\x1e\n" +
+	"\n" +
+	"targetPath\x18\x06 \x01(\tR\n" +
+	"targetPath\"J\n" +
+	"\x1cGetArchitectureViolationsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\"\x7f\n" +
+	"\x1dGetArchitectureViolationsResp\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\tR\bsnapshot\x12B\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\".codewiki.v1.ArchitectureViolationR\n" +
+	"violations\"M\n" +
 	"\vPackageNode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -1862,26 +6348,29 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\bFileNode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05pkgId\x18\x03 \x01(\tR\x05pkgId\"5\n" +
+	"\x05pkgId\x18\x03 \x01(\tR\x05pkgId\"Q\n" +
 	"\vViewFileReq\x12\x16\n" +
 	"\x06repoId\x18\x02 \x01(\tR\x06repoId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\"\x90\x01\n" +
 	"\fViewFileResp\x12\x18\n" +
 	"\aContent\x18\x01 \x01(\tR\aContent\x121\n" +
 	"\blanguage\x18\x02 \x01(\x0e2\x15.codewiki.v1.LanguageR\blanguage\x123\n" +
-	"\tfunctions\x18\x03 \x03(\v2\x15.codewiki.v1.FunctionR\tfunctions\"b\n" +
+	"\tfunctions\x18\x03 \x03(\v2\x15.codewiki.v1.FunctionR\tfunctions\"\x8f\x01\n" +
 	"\bFunction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06fileId\x18\x02 \x01(\tR\x06fileId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
-	"\breceiver\x18\x04 \x01(\tR\breceiver\"y\n" +
+	"\breceiver\x18\x04 \x01(\tR\breceiver\x12+\n" +
+	"\x04span\x18\x05 \x01(\v2\x17.codewiki.v1.SourceSpanR\x04span\"y\n" +
 	"\x06Entity\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06fileId\x18\x02 \x01(\tR\x06fileId\x12\x0e\n" +
 	"\x02id\x18\x03 \x01(\tR\x02id\x123\n" +
-	"\tfunctions\x18\x04 \x03(\v2\x15.codewiki.v1.FunctionR\tfunctions\"!\n" +
+	"\tfunctions\x18\x04 \x03(\v2\x15.codewiki.v1.FunctionR\tfunctions\"=\n" +
 	"\x0fGetImplementReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\"C\n" +
 	"\x10GetImplementResp\x12/\n" +
 	"\bentities\x18\x01 \x03(\v2\x13.codewiki.v1.EntityR\bentities\"7\n" +
 	"\tAnswerReq\x12\x0e\n" +
@@ -1896,34 +6385,130 @@ const file_codewiki_v1_codewiki_proto_rawDesc = "" +
 	"\x05chunk\x18\x04 \x01(\tR\x05chunk\x12\x1f\n" +
 	"\vchunk_index\x18\x05 \x01(\x05R\n" +
 	"chunkIndex\x12\x14\n" +
-	"\x05error\x18\x06 \x01(\tR\x05error*!\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error**\n" +
 	"\bRepoType\x12\t\n" +
 	"\x05Local\x10\x00\x12\n" +
 	"\n" +
-	"\x06Github\x10\x01*6\n" +
+	"\x06Github\x10\x01\x12\a\n" +
+	"\x03Git\x10\x02*6\n" +
 	"\bLanguage\x12\n" +
 	"\n" +
 	"\x06Golang\x10\x00\x12\b\n" +
 	"\x04Java\x10\x01\x12\n" +
 	"\n" +
 	"\x06Python\x10\x02\x12\b\n" +
-	"\x04Rust\x10\x03*N\n" +
+	"\x04Rust\x10\x03*.\n" +
+	"\fCallResolver\x12\r\n" +
+	"\tHeuristic\x10\x00\x12\x0f\n" +
+	"\vTypeChecked\x10\x01*N\n" +
+	"\tJobStatus\x12\v\n" +
+	"\aPending\x10\x00\x12\v\n" +
+	"\aRunning\x10\x01\x12\r\n" +
+	"\tSucceeded\x10\x02\x12\n" +
+	"\n" +
+	"\x06Failed\x10\x03\x12\f\n" +
+	"\bCanceled\x10\x04*\x9b\x01\n" +
+	"\rAnalysisPhase\x12\x0f\n" +
+	"\vPhaseQueued\x10\x00\x12\x0e\n" +
+	"\n" +
+	"PhaseParse\x10\x01\x12\x11\n" +
+	"\rPhaseClassify\x10\x02\x12\x12\n" +
+	"\x0ePhaseRelations\x10\x03\x12\x10\n" +
+	"\fPhasePersist\x10\x04\x12\x0e\n" +
+	"\n" +
+	"PhaseIndex\x10\x05\x12\r\n" +
+	"\tPhaseDone\x10\x06\x12\x11\n" +
+	"\rPhaseCheckout\x10\a*A\n" +
+	"\rCallDirection\x12\x10\n" +
+	"\fCallOutgoing\x10\x00\x12\x10\n" +
+	"\fCallIncoming\x10\x01\x12\f\n" +
+	"\bCallBoth\x10\x02*d\n" +
+	"\x0eEntrypointKind\x12\x12\n" +
+	"\x0eEntrypointMain\x10\x00\x12\x12\n" +
+	"\x0eEntrypointHTTP\x10\x01\x12\x16\n" +
+	"\x12EntrypointExported\x10\x02\x12\x12\n" +
+	"\x0eEntrypointTest\x10\x03*\xc4\x02\n" +
+	"\rAPIChangeKind\x12\r\n" +
+	"\tFuncAdded\x10\x00\x12\x0f\n" +
+	"\vFuncRemoved\x10\x01\x12\x14\n" +
+	"\x10SignatureChanged\x10\x02\x12\x0f\n" +
+	"\vMethodAdded\x10\x03\x12\x11\n" +
+	"\rMethodRemoved\x10\x04\x12\x18\n" +
+	"\x14InterfaceMethodAdded\x10\x05\x12\x1a\n" +
+	"\x16InterfaceMethodRemoved\x10\x06\x12\x0e\n" +
+	"\n" +
+	"FieldAdded\x10\a\x12\x10\n" +
+	"\fFieldRemoved\x10\b\x12\x14\n" +
+	"\x10FieldTypeChanged\x10\t\x12\r\n" +
+	"\tTypeAdded\x10\n" +
+	"\x12\x0f\n" +
+	"\vTypeRemoved\x10\v\x12\x13\n" +
+	"\x0fTypeKindChanged\x10\f\x12\x0e\n" +
+	"\n" +
+	"ValueAdded\x10\r\x12\x10\n" +
+	"\fValueRemoved\x10\x0e\x12\x14\n" +
+	"\x10ValueKindChanged\x10\x0f*9\n" +
+	"\n" +
+	"SemverBump\x12\r\n" +
+	"\tBumpPatch\x10\x00\x12\r\n" +
+	"\tBumpMinor\x10\x01\x12\r\n" +
+	"\tBumpMajor\x10\x02*f\n" +
+	"\n" +
+	"SymbolKind\x12\x11\n" +
+	"\rSymbolPackage\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SymbolFile\x10\x01\x12\x10\n" +
+	"\fSymbolEntity\x10\x02\x12\x12\n" +
+	"\x0eSymbolFunction\x10\x03\x12\x0f\n" +
+	"\vSymbolField\x10\x04*B\n" +
+	"\x0fSymbolMatchMode\x12\x0f\n" +
+	"\vMatchPrefix\x10\x00\x12\x0e\n" +
+	"\n" +
+	"MatchFuzzy\x10\x01\x12\x0e\n" +
+	"\n" +
+	"MatchRegex\x10\x02*Y\n" +
+	"\rReferenceKind\x12\f\n" +
+	"\bRefOther\x10\x00\x12\v\n" +
+	"\aRefCall\x10\x01\x12\f\n" +
+	"\bRefField\x10\x02\x12\v\n" +
+	"\aRefType\x10\x03\x12\x12\n" +
+	"\x0eRefDeclaration\x10\x04*]\n" +
+	"\x14ArchitectureRuleKind\x12\x18\n" +
+	"\x14RuleForbidDependency\x10\x00\x12\x17\n" +
+	"\x13RuleAllowDependency\x10\x01\x12\x12\n" +
+	"\x0eRuleForbidCall\x10\x02*N\n" +
 	"\bFunScope\x12\v\n" +
 	"\aDefault\x10\x00\x12\n" +
 	"\n" +
 	"\x06Struct\x10\x01\x12\r\n" +
 	"\tInterface\x10\x02\x12\f\n" +
 	"\bConstant\x10\x03\x12\f\n" +
-	"\bVariable\x10\x042\xe7\t\n" +
+	"\bVariable\x10\x042\x92\x1d\n" +
 	"\x0fCodeWikiService\x12y\n" +
-	"\tCallChain\x12\x19.codewiki.v1.CallChainReq\x1a\x1a.codewiki.v1.CallChainResp\"5\xbaG\x0e\x12\f分析代码\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/api/functions/{id}/calls\x12p\n" +
+	"\tCallChain\x12\x19.codewiki.v1.CallChainReq\x1a\x1a.codewiki.v1.CallChainResp\"5\xbaG\x0e\x12\f分析代码\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/api/functions/{id}/calls\x12\x8b\x01\n" +
+	"\fCallersChain\x12\x1c.codewiki.v1.CallersChainReq\x1a\x1a.codewiki.v1.CallChainResp\"A\xbaG\x18\x12\x16函数/反向调用链\x82\xd3\xe4\x93\x02 \x12\x1e/v1/api/functions/{id}/callers\x12\x9c\x01\n" +
+	"\bCallPath\x12\x18.codewiki.v1.CallPathReq\x1a\x19.codewiki.v1.CallPathResp\"[\xbaG0\x12.函数/两个函数之间的最短调用路径\x82\xd3\xe4\x93\x02\"\x12 /v1/api/functions/{source}/paths\x12p\n" +
 	"\n" +
 	"CreateRepo\x12\x1a.codewiki.v1.CreateRepoReq\x1a\x1b.codewiki.v1.CreateRepoResp\")\xbaG\x0e\x12\f创建仓库\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/api/repos\x12j\n" +
 	"\tListRepos\x12\x19.codewiki.v1.ListReposReq\x1a\x1a.codewiki.v1.ListReposResp\"&\xbaG\x0e\x12\f仓库列表\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/api/repos\x12i\n" +
 	"\aGetRepo\x12\x17.codewiki.v1.GetRepoReq\x1a\x18.codewiki.v1.GetRepoResp\"+\xbaG\x0e\x12\f仓库详情\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/api/repos/{id}\x12r\n" +
 	"\n" +
 	"DeleteRepo\x12\x1a.codewiki.v1.DeleteRepoReq\x1a\x1b.codewiki.v1.DeleteRepoResp\"+\xbaG\x0e\x12\f删除仓库\x82\xd3\xe4\x93\x02\x14*\x12/v1/api/repos/{id}\x12\x85\x01\n" +
-	"\vAnalyzeRepo\x12\x1b.codewiki.v1.AnalyzeRepoReq\x1a\x18.codewiki.v1.AnalyzeResp\"?\xbaG\x17\x12\x15按仓库触发分析\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/api/repos/{id}/analyze\x12\x81\x01\n" +
+	"\vAnalyzeRepo\x12\x1b.codewiki.v1.AnalyzeRepoReq\x1a\x18.codewiki.v1.AnalyzeResp\"?\xbaG\x17\x12\x15按仓库触发分析\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/api/repos/{id}/analyze\x12\x83\x01\n" +
+	"\x0eGetAnalysisJob\x12\x1e.codewiki.v1.GetAnalysisJobReq\x1a\x1f.codewiki.v1.GetAnalysisJobResp\"0\xbaG\x14\x12\x12分析任务详情\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/api/jobs/{id}\x12\x84\x01\n" +
+	"\x10ListAnalysisJobs\x12 .codewiki.v1.ListAnalysisJobsReq\x1a!.codewiki.v1.ListAnalysisJobsResp\"+\xbaG\x14\x12\x12分析任务列表\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/api/jobs\x12\x96\x01\n" +
+	"\x11CancelAnalysisJob\x12!.codewiki.v1.CancelAnalysisJobReq\x1a\".codewiki.v1.CancelAnalysisJobResp\":\xbaG\x14\x12\x12取消分析任务\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api/jobs/{id}/cancel\x12\x8b\x01\n" +
+	"\rListSnapshots\x12\x1d.codewiki.v1.ListSnapshotsReq\x1a\x1e.codewiki.v1.ListSnapshotsResp\";\xbaG\x14\x12\x12仓库快照列表\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/api/repos/{id}/snapshots\x12\x83\x01\n" +
+	"\n" +
+	"DiffGraphs\x12\x1a.codewiki.v1.DiffGraphsReq\x1a\x1b.codewiki.v1.DiffGraphsResp\"<\xbaG\x1a\x12\x18比较两个快照的图\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/api/repos/{id}/diff\x12\xb1\x01\n" +
+	"\x15CheckAPICompatibility\x12%.codewiki.v1.CheckAPICompatibilityReq\x1a&.codewiki.v1.CheckAPICompatibilityResp\"I\xbaG!\x12\x1f比较两个版本的导出 API\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/api/repos/{id}/api-compat\x12\xad\x01\n" +
+	"\rSearchSymbols\x12\x1d.codewiki.v1.SearchSymbolsReq\x1a\x1e.codewiki.v1.SearchSymbolsResp\"]\xbaG8\x126按名称搜索包、文件、实体、函数和字段\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/api/repos/{id}/symbols\x12\xb8\x01\n" +
+	"\x0fResolveSymbolAt\x12\x1f.codewiki.v1.ResolveSymbolAtReq\x1a .codewiki.v1.ResolveSymbolAtResp\"b\xbaG2\x120跳转到文件中某个位置的符号的定义\x82\xd3\xe4\x93\x02'\x12%/v1/api/{repoId}/file/{fileId}/symbol\x12\xaf\x01\n" +
+	"\x0eFindReferences\x12\x1e.codewiki.v1.FindReferencesReq\x1a\x1f.codewiki.v1.FindReferencesResp\"\\\xbaG)\x12'查找符号在仓库中的所有引用\x82\xd3\xe4\x93\x02*\x12(/v1/api/{repoId}/symbols/{id}/references\x12\xb7\x01\n" +
+	"\rAnalyzeImpact\x12\x1d.codewiki.v1.AnalyzeImpactReq\x1a\x1e.codewiki.v1.AnalyzeImpactResp\"g\xbaG<\x12:变更影响的函数、包、导出 API、入口和测试\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/api/repos/{repoId}/impact\x12\xb0\x01\n" +
+	"\x10GetFunctionTests\x12 .codewiki.v1.GetFunctionTestsReq\x1a!.codewiki.v1.GetFunctionTestsResp\"W\xbaG0\x12.函数/直接或间接调用它的测试函数\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/api/functions/{id}/tests\x12\xb2\x01\n" +
+	"\x16GetPackageDependencies\x12&.codewiki.v1.GetPackageDependenciesReq\x1a'.codewiki.v1.GetPackageDependenciesResp\"G\xbaG\x1d\x12\x1b包依赖图和循环依赖\x82\xd3\xe4\x93\x02!\x12\x1f/v1/api/repos/{id}/dependencies\x12\xce\x01\n" +
+	"\x19GetArchitectureViolations\x12).codewiki.v1.GetArchitectureViolationsReq\x1a*.codewiki.v1.GetArchitectureViolationsResp\"Z\xbaG2\x120快照违反仓库架构规则的依赖和调用\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/api/repos/{id}/violations\x12\x81\x01\n" +
 	"\vGetRepoTree\x12\x1b.codewiki.v1.GetRepoTreeReq\x1a\x1c.codewiki.v1.GetRepoTreeResp\"7\xbaG\x15\x12\x13仓库包/文件树\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/api/repos/{id}/tree\x12\x87\x01\n" +
 	"\x0fViewFileContent\x12\x18.codewiki.v1.ViewFileReq\x1a\x19.codewiki.v1.ViewFileResp\"?\xbaG\x15\x12\x13文件/文件内容\x82\xd3\xe4\x93\x02!\x12\x1f/v1/api/{repoId}/file/{id}/view\x12\x91\x01\n" +
 	"\fGetImplement\x12\x1c.codewiki.v1.GetImplementReq\x1a\x1d.codewiki.v1.GetImplementResp\"D\xbaG\x1b\x12\x19实体/得到所有实现\x82\xd3\xe4\x93\x02 \x12\x1e/v1/api/entity/{id}/implements\x12r\n" +
@@ -1943,80 +6528,228 @@ func file_codewiki_v1_codewiki_proto_rawDescGZIP() []byte {
 	return file_codewiki_v1_codewiki_proto_rawDescData
 }
 
-var file_codewiki_v1_codewiki_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_codewiki_v1_codewiki_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_codewiki_v1_codewiki_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_codewiki_v1_codewiki_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_codewiki_v1_codewiki_proto_goTypes = []any{
-	(RepoType)(0),            // 0: codewiki.v1.RepoType
-	(Language)(0),            // 1: codewiki.v1.Language
-	(FunScope)(0),            // 2: codewiki.v1.FunScope
-	(*AnalyzeReq)(nil),       // 3: codewiki.v1.AnalyzeReq
-	(*AnalyzeResp)(nil),      // 4: codewiki.v1.AnalyzeResp
-	(*CallChainReq)(nil),     // 5: codewiki.v1.CallChainReq
-	(*CallChainResp)(nil),    // 6: codewiki.v1.CallChainResp
-	(*CallRelationship)(nil), // 7: codewiki.v1.CallRelationship
-	(*Repo)(nil),             // 8: codewiki.v1.Repo
-	(*CreateRepoReq)(nil),    // 9: codewiki.v1.CreateRepoReq
-	(*CreateRepoResp)(nil),   // 10: codewiki.v1.CreateRepoResp
-	(*ListReposReq)(nil),     // 11: codewiki.v1.ListReposReq
-	(*ListReposResp)(nil),    // 12: codewiki.v1.ListReposResp
-	(*GetRepoReq)(nil),       // 13: codewiki.v1.GetRepoReq
-	(*GetRepoResp)(nil),      // 14: codewiki.v1.GetRepoResp
-	(*DeleteRepoReq)(nil),    // 15: codewiki.v1.DeleteRepoReq
-	(*DeleteRepoResp)(nil),   // 16: codewiki.v1.DeleteRepoResp
-	(*AnalyzeRepoReq)(nil),   // 17: codewiki.v1.AnalyzeRepoReq
-	(*GetRepoTreeReq)(nil),   // 18: codewiki.v1.GetRepoTreeReq
-	(*GetRepoTreeResp)(nil),  // 19: codewiki.v1.GetRepoTreeResp
-	(*PackageNode)(nil),      // 20: codewiki.v1.PackageNode
-	(*FileNode)(nil),         // 21: codewiki.v1.FileNode
-	(*ViewFileReq)(nil),      // 22: codewiki.v1.ViewFileReq
-	(*ViewFileResp)(nil),     // 23: codewiki.v1.ViewFileResp
-	(*Function)(nil),         // 24: codewiki.v1.Function
-	(*Entity)(nil),           // 25: codewiki.v1.Entity
-	(*GetImplementReq)(nil),  // 26: codewiki.v1.GetImplementReq
-	(*GetImplementResp)(nil), // 27: codewiki.v1.GetImplementResp
-	(*AnswerReq)(nil),        // 28: codewiki.v1.AnswerReq
-	(*AnswerResp)(nil),       // 29: codewiki.v1.AnswerResp
+	(RepoType)(0),                         // 0: codewiki.v1.RepoType
+	(Language)(0),                         // 1: codewiki.v1.Language
+	(CallResolver)(0),                     // 2: codewiki.v1.CallResolver
+	(JobStatus)(0),                        // 3: codewiki.v1.JobStatus
+	(AnalysisPhase)(0),                    // 4: codewiki.v1.AnalysisPhase
+	(CallDirection)(0),                    // 5: codewiki.v1.CallDirection
+	(EntrypointKind)(0),                   // 6: codewiki.v1.EntrypointKind
+	(APIChangeKind)(0),                    // 7: codewiki.v1.APIChangeKind
+	(SemverBump)(0),                       // 8: codewiki.v1.SemverBump
+	(SymbolKind)(0),                       // 9: codewiki.v1.SymbolKind
+	(SymbolMatchMode)(0),                  // 10: codewiki.v1.SymbolMatchMode
+	(ReferenceKind)(0),                    // 11: codewiki.v1.ReferenceKind
+	(ArchitectureRuleKind)(0),             // 12: codewiki.v1.ArchitectureRuleKind
+	(FunScope)(0),                         // 13: codewiki.v1.FunScope
+	(*AnalyzeReq)(nil),                    // 14: codewiki.v1.AnalyzeReq
+	(*AnalyzeResp)(nil),                   // 15: codewiki.v1.AnalyzeResp
+	(*AnalyzeSummary)(nil),                // 16: codewiki.v1.AnalyzeSummary
+	(*CallChainReq)(nil),                  // 17: codewiki.v1.CallChainReq
+	(*CallChainResp)(nil),                 // 18: codewiki.v1.CallChainResp
+	(*CallFrontier)(nil),                  // 19: codewiki.v1.CallFrontier
+	(*CallersChainReq)(nil),               // 20: codewiki.v1.CallersChainReq
+	(*CallPathReq)(nil),                   // 21: codewiki.v1.CallPathReq
+	(*CallPathResp)(nil),                  // 22: codewiki.v1.CallPathResp
+	(*FunctionPath)(nil),                  // 23: codewiki.v1.FunctionPath
+	(*CallRelationship)(nil),              // 24: codewiki.v1.CallRelationship
+	(*SourceSpan)(nil),                    // 25: codewiki.v1.SourceSpan
+	(*Repo)(nil),                          // 26: codewiki.v1.Repo
+	(*CreateRepoReq)(nil),                 // 27: codewiki.v1.CreateRepoReq
+	(*CreateRepoResp)(nil),                // 28: codewiki.v1.CreateRepoResp
+	(*ListReposReq)(nil),                  // 29: codewiki.v1.ListReposReq
+	(*ListReposResp)(nil),                 // 30: codewiki.v1.ListReposResp
+	(*GetRepoReq)(nil),                    // 31: codewiki.v1.GetRepoReq
+	(*GetRepoResp)(nil),                   // 32: codewiki.v1.GetRepoResp
+	(*DeleteRepoReq)(nil),                 // 33: codewiki.v1.DeleteRepoReq
+	(*DeleteRepoResp)(nil),                // 34: codewiki.v1.DeleteRepoResp
+	(*AnalyzeRepoReq)(nil),                // 35: codewiki.v1.AnalyzeRepoReq
+	(*AnalysisJob)(nil),                   // 36: codewiki.v1.AnalysisJob
+	(*Snapshot)(nil),                      // 37: codewiki.v1.Snapshot
+	(*ListSnapshotsReq)(nil),              // 38: codewiki.v1.ListSnapshotsReq
+	(*ListSnapshotsResp)(nil),             // 39: codewiki.v1.ListSnapshotsResp
+	(*DiffGraphsReq)(nil),                 // 40: codewiki.v1.DiffGraphsReq
+	(*DiffGraphsResp)(nil),                // 41: codewiki.v1.DiffGraphsResp
+	(*NodeDiff)(nil),                      // 42: codewiki.v1.NodeDiff
+	(*DiffNode)(nil),                      // 43: codewiki.v1.DiffNode
+	(*RelationDiff)(nil),                  // 44: codewiki.v1.RelationDiff
+	(*DiffRelation)(nil),                  // 45: codewiki.v1.DiffRelation
+	(*CheckAPICompatibilityReq)(nil),      // 46: codewiki.v1.CheckAPICompatibilityReq
+	(*CheckAPICompatibilityResp)(nil),     // 47: codewiki.v1.CheckAPICompatibilityResp
+	(*APIChange)(nil),                     // 48: codewiki.v1.APIChange
+	(*GetAnalysisJobReq)(nil),             // 49: codewiki.v1.GetAnalysisJobReq
+	(*GetAnalysisJobResp)(nil),            // 50: codewiki.v1.GetAnalysisJobResp
+	(*ListAnalysisJobsReq)(nil),           // 51: codewiki.v1.ListAnalysisJobsReq
+	(*ListAnalysisJobsResp)(nil),          // 52: codewiki.v1.ListAnalysisJobsResp
+	(*CancelAnalysisJobReq)(nil),          // 53: codewiki.v1.CancelAnalysisJobReq
+	(*CancelAnalysisJobResp)(nil),         // 54: codewiki.v1.CancelAnalysisJobResp
+	(*SearchSymbolsReq)(nil),              // 55: codewiki.v1.SearchSymbolsReq
+	(*SearchSymbolsResp)(nil),             // 56: codewiki.v1.SearchSymbolsResp
+	(*Symbol)(nil),                        // 57: codewiki.v1.Symbol
+	(*GetFunctionTestsReq)(nil),           // 58: codewiki.v1.GetFunctionTestsReq
+	(*GetFunctionTestsResp)(nil),          // 59: codewiki.v1.GetFunctionTestsResp
+	(*FunctionTest)(nil),                  // 60: codewiki.v1.FunctionTest
+	(*ResolveSymbolAtReq)(nil),            // 61: codewiki.v1.ResolveSymbolAtReq
+	(*ResolveSymbolAtResp)(nil),           // 62: codewiki.v1.ResolveSymbolAtResp
+	(*FindReferencesReq)(nil),             // 63: codewiki.v1.FindReferencesReq
+	(*FindReferencesResp)(nil),            // 64: codewiki.v1.FindReferencesResp
+	(*Reference)(nil),                     // 65: codewiki.v1.Reference
+	(*AnalyzeImpactReq)(nil),              // 66: codewiki.v1.AnalyzeImpactReq
+	(*AnalyzeImpactResp)(nil),             // 67: codewiki.v1.AnalyzeImpactResp
+	(*ImpactedSymbol)(nil),                // 68: codewiki.v1.ImpactedSymbol
+	(*ImpactedPackage)(nil),               // 69: codewiki.v1.ImpactedPackage
+	(*GetRepoTreeReq)(nil),                // 70: codewiki.v1.GetRepoTreeReq
+	(*GetRepoTreeResp)(nil),               // 71: codewiki.v1.GetRepoTreeResp
+	(*GetPackageDependenciesReq)(nil),     // 72: codewiki.v1.GetPackageDependenciesReq
+	(*GetPackageDependenciesResp)(nil),    // 73: codewiki.v1.GetPackageDependenciesResp
+	(*PackageDependency)(nil),             // 74: codewiki.v1.PackageDependency
+	(*PackageCycle)(nil),                  // 75: codewiki.v1.PackageCycle
+	(*ArchitectureRule)(nil),              // 76: codewiki.v1.ArchitectureRule
+	(*ArchitectureRules)(nil),             // 77: codewiki.v1.ArchitectureRules
+	(*ArchitectureViolation)(nil),         // 78: codewiki.v1.ArchitectureViolation
+	(*GetArchitectureViolationsReq)(nil),  // 79: codewiki.v1.GetArchitectureViolationsReq
+	(*GetArchitectureViolationsResp)(nil), // 80: codewiki.v1.GetArchitectureViolationsResp
+	(*PackageNode)(nil),                   // 81: codewiki.v1.PackageNode
+	(*FileNode)(nil),                      // 82: codewiki.v1.FileNode
+	(*ViewFileReq)(nil),                   // 83: codewiki.v1.ViewFileReq
+	(*ViewFileResp)(nil),                  // 84: codewiki.v1.ViewFileResp
+	(*Function)(nil),                      // 85: codewiki.v1.Function
+	(*Entity)(nil),                        // 86: codewiki.v1.Entity
+	(*GetImplementReq)(nil),               // 87: codewiki.v1.GetImplementReq
+	(*GetImplementResp)(nil),              // 88: codewiki.v1.GetImplementResp
+	(*AnswerReq)(nil),                     // 89: codewiki.v1.AnswerReq
+	(*AnswerResp)(nil),                    // 90: codewiki.v1.AnswerResp
 }
 var file_codewiki_v1_codewiki_proto_depIdxs = []int32{
 	0,  // 0: codewiki.v1.AnalyzeReq.repoType:type_name -> codewiki.v1.RepoType
-	7,  // 1: codewiki.v1.CallChainResp.callRelations:type_name -> codewiki.v1.CallRelationship
-	0,  // 2: codewiki.v1.Repo.repoType:type_name -> codewiki.v1.RepoType
-	1,  // 3: codewiki.v1.Repo.language:type_name -> codewiki.v1.Language
-	0,  // 4: codewiki.v1.CreateRepoReq.repoType:type_name -> codewiki.v1.RepoType
-	1,  // 5: codewiki.v1.CreateRepoReq.language:type_name -> codewiki.v1.Language
-	8,  // 6: codewiki.v1.ListReposResp.repos:type_name -> codewiki.v1.Repo
-	8,  // 7: codewiki.v1.GetRepoResp.repo:type_name -> codewiki.v1.Repo
-	20, // 8: codewiki.v1.GetRepoTreeResp.packages:type_name -> codewiki.v1.PackageNode
-	21, // 9: codewiki.v1.GetRepoTreeResp.files:type_name -> codewiki.v1.FileNode
-	1,  // 10: codewiki.v1.ViewFileResp.language:type_name -> codewiki.v1.Language
-	24, // 11: codewiki.v1.ViewFileResp.functions:type_name -> codewiki.v1.Function
-	24, // 12: codewiki.v1.Entity.functions:type_name -> codewiki.v1.Function
-	25, // 13: codewiki.v1.GetImplementResp.entities:type_name -> codewiki.v1.Entity
-	5,  // 14: codewiki.v1.CodeWikiService.CallChain:input_type -> codewiki.v1.CallChainReq
-	9,  // 15: codewiki.v1.CodeWikiService.CreateRepo:input_type -> codewiki.v1.CreateRepoReq
-	11, // 16: codewiki.v1.CodeWikiService.ListRepos:input_type -> codewiki.v1.ListReposReq
-	13, // 17: codewiki.v1.CodeWikiService.GetRepo:input_type -> codewiki.v1.GetRepoReq
-	15, // 18: codewiki.v1.CodeWikiService.DeleteRepo:input_type -> codewiki.v1.DeleteRepoReq
-	17, // 19: codewiki.v1.CodeWikiService.AnalyzeRepo:input_type -> codewiki.v1.AnalyzeRepoReq
-	18, // 20: codewiki.v1.CodeWikiService.GetRepoTree:input_type -> codewiki.v1.GetRepoTreeReq
-	22, // 21: codewiki.v1.CodeWikiService.ViewFileContent:input_type -> codewiki.v1.ViewFileReq
-	26, // 22: codewiki.v1.CodeWikiService.GetImplement:input_type -> codewiki.v1.GetImplementReq
-	28, // 23: codewiki.v1.CodeWikiService.Answer:input_type -> codewiki.v1.AnswerReq
-	6,  // 24: codewiki.v1.CodeWikiService.CallChain:output_type -> codewiki.v1.CallChainResp
-	10, // 25: codewiki.v1.CodeWikiService.CreateRepo:output_type -> codewiki.v1.CreateRepoResp
-	12, // 26: codewiki.v1.CodeWikiService.ListRepos:output_type -> codewiki.v1.ListReposResp
-	14, // 27: codewiki.v1.CodeWikiService.GetRepo:output_type -> codewiki.v1.GetRepoResp
-	16, // 28: codewiki.v1.CodeWikiService.DeleteRepo:output_type -> codewiki.v1.DeleteRepoResp
-	4,  // 29: codewiki.v1.CodeWikiService.AnalyzeRepo:output_type -> codewiki.v1.AnalyzeResp
-	19, // 30: codewiki.v1.CodeWikiService.GetRepoTree:output_type -> codewiki.v1.GetRepoTreeResp
-	23, // 31: codewiki.v1.CodeWikiService.ViewFileContent:output_type -> codewiki.v1.ViewFileResp
-	27, // 32: codewiki.v1.CodeWikiService.GetImplement:output_type -> codewiki.v1.GetImplementResp
-	29, // 33: codewiki.v1.CodeWikiService.Answer:output_type -> codewiki.v1.AnswerResp
-	24, // [24:34] is the sub-list for method output_type
-	14, // [14:24] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 1: codewiki.v1.AnalyzeResp.summary:type_name -> codewiki.v1.AnalyzeSummary
+	5,  // 2: codewiki.v1.CallChainReq.direction:type_name -> codewiki.v1.CallDirection
+	24, // 3: codewiki.v1.CallChainResp.callRelations:type_name -> codewiki.v1.CallRelationship
+	19, // 4: codewiki.v1.CallChainResp.frontier:type_name -> codewiki.v1.CallFrontier
+	6,  // 5: codewiki.v1.CallersChainReq.stopAt:type_name -> codewiki.v1.EntrypointKind
+	23, // 6: codewiki.v1.CallPathResp.paths:type_name -> codewiki.v1.FunctionPath
+	24, // 7: codewiki.v1.FunctionPath.hops:type_name -> codewiki.v1.CallRelationship
+	25, // 8: codewiki.v1.CallRelationship.callSite:type_name -> codewiki.v1.SourceSpan
+	0,  // 9: codewiki.v1.Repo.repoType:type_name -> codewiki.v1.RepoType
+	1,  // 10: codewiki.v1.Repo.language:type_name -> codewiki.v1.Language
+	2,  // 11: codewiki.v1.Repo.callResolver:type_name -> codewiki.v1.CallResolver
+	76, // 12: codewiki.v1.Repo.rules:type_name -> codewiki.v1.ArchitectureRule
+	0,  // 13: codewiki.v1.CreateRepoReq.repoType:type_name -> codewiki.v1.RepoType
+	1,  // 14: codewiki.v1.CreateRepoReq.language:type_name -> codewiki.v1.Language
+	2,  // 15: codewiki.v1.CreateRepoReq.callResolver:type_name -> codewiki.v1.CallResolver
+	76, // 16: codewiki.v1.CreateRepoReq.rules:type_name -> codewiki.v1.ArchitectureRule
+	26, // 17: codewiki.v1.ListReposResp.repos:type_name -> codewiki.v1.Repo
+	26, // 18: codewiki.v1.GetRepoResp.repo:type_name -> codewiki.v1.Repo
+	3,  // 19: codewiki.v1.AnalysisJob.status:type_name -> codewiki.v1.JobStatus
+	4,  // 20: codewiki.v1.AnalysisJob.phase:type_name -> codewiki.v1.AnalysisPhase
+	16, // 21: codewiki.v1.AnalysisJob.summary:type_name -> codewiki.v1.AnalyzeSummary
+	37, // 22: codewiki.v1.ListSnapshotsResp.snapshots:type_name -> codewiki.v1.Snapshot
+	42, // 23: codewiki.v1.DiffGraphsResp.packages:type_name -> codewiki.v1.NodeDiff
+	42, // 24: codewiki.v1.DiffGraphsResp.entities:type_name -> codewiki.v1.NodeDiff
+	42, // 25: codewiki.v1.DiffGraphsResp.functions:type_name -> codewiki.v1.NodeDiff
+	44, // 26: codewiki.v1.DiffGraphsResp.relations:type_name -> codewiki.v1.RelationDiff
+	43, // 27: codewiki.v1.NodeDiff.added:type_name -> codewiki.v1.DiffNode
+	43, // 28: codewiki.v1.NodeDiff.removed:type_name -> codewiki.v1.DiffNode
+	43, // 29: codewiki.v1.NodeDiff.changed:type_name -> codewiki.v1.DiffNode
+	45, // 30: codewiki.v1.RelationDiff.added:type_name -> codewiki.v1.DiffRelation
+	45, // 31: codewiki.v1.RelationDiff.removed:type_name -> codewiki.v1.DiffRelation
+	48, // 32: codewiki.v1.CheckAPICompatibilityResp.changes:type_name -> codewiki.v1.APIChange
+	8,  // 33: codewiki.v1.CheckAPICompatibilityResp.bump:type_name -> codewiki.v1.SemverBump
+	7,  // 34: codewiki.v1.APIChange.kind:type_name -> codewiki.v1.APIChangeKind
+	36, // 35: codewiki.v1.GetAnalysisJobResp.job:type_name -> codewiki.v1.AnalysisJob
+	36, // 36: codewiki.v1.ListAnalysisJobsResp.jobs:type_name -> codewiki.v1.AnalysisJob
+	10, // 37: codewiki.v1.SearchSymbolsReq.mode:type_name -> codewiki.v1.SymbolMatchMode
+	9,  // 38: codewiki.v1.SearchSymbolsReq.kinds:type_name -> codewiki.v1.SymbolKind
+	57, // 39: codewiki.v1.SearchSymbolsResp.symbols:type_name -> codewiki.v1.Symbol
+	9,  // 40: codewiki.v1.Symbol.kind:type_name -> codewiki.v1.SymbolKind
+	25, // 41: codewiki.v1.Symbol.span:type_name -> codewiki.v1.SourceSpan
+	60, // 42: codewiki.v1.GetFunctionTestsResp.tests:type_name -> codewiki.v1.FunctionTest
+	57, // 43: codewiki.v1.FunctionTest.symbol:type_name -> codewiki.v1.Symbol
+	25, // 44: codewiki.v1.ResolveSymbolAtResp.span:type_name -> codewiki.v1.SourceSpan
+	57, // 45: codewiki.v1.ResolveSymbolAtResp.definition:type_name -> codewiki.v1.Symbol
+	57, // 46: codewiki.v1.ResolveSymbolAtResp.candidates:type_name -> codewiki.v1.Symbol
+	57, // 47: codewiki.v1.FindReferencesResp.definition:type_name -> codewiki.v1.Symbol
+	65, // 48: codewiki.v1.FindReferencesResp.references:type_name -> codewiki.v1.Reference
+	25, // 49: codewiki.v1.Reference.span:type_name -> codewiki.v1.SourceSpan
+	11, // 50: codewiki.v1.Reference.kind:type_name -> codewiki.v1.ReferenceKind
+	68, // 51: codewiki.v1.AnalyzeImpactResp.symbols:type_name -> codewiki.v1.ImpactedSymbol
+	69, // 52: codewiki.v1.AnalyzeImpactResp.packages:type_name -> codewiki.v1.ImpactedPackage
+	68, // 53: codewiki.v1.AnalyzeImpactResp.exported:type_name -> codewiki.v1.ImpactedSymbol
+	68, // 54: codewiki.v1.AnalyzeImpactResp.entrypoints:type_name -> codewiki.v1.ImpactedSymbol
+	68, // 55: codewiki.v1.AnalyzeImpactResp.tests:type_name -> codewiki.v1.ImpactedSymbol
+	57, // 56: codewiki.v1.ImpactedSymbol.symbol:type_name -> codewiki.v1.Symbol
+	81, // 57: codewiki.v1.GetRepoTreeResp.packages:type_name -> codewiki.v1.PackageNode
+	82, // 58: codewiki.v1.GetRepoTreeResp.files:type_name -> codewiki.v1.FileNode
+	81, // 59: codewiki.v1.GetPackageDependenciesResp.packages:type_name -> codewiki.v1.PackageNode
+	74, // 60: codewiki.v1.GetPackageDependenciesResp.dependencies:type_name -> codewiki.v1.PackageDependency
+	75, // 61: codewiki.v1.GetPackageDependenciesResp.cycles:type_name -> codewiki.v1.PackageCycle
+	12, // 62: codewiki.v1.ArchitectureRule.kind:type_name -> codewiki.v1.ArchitectureRuleKind
+	76, // 63: codewiki.v1.ArchitectureRules.rules:type_name -> codewiki.v1.ArchitectureRule
+	12, // 64: codewiki.v1.ArchitectureViolation.kind:type_name -> codewiki.v1.ArchitectureRuleKind
+	78, // 65: codewiki.v1.GetArchitectureViolationsResp.violations:type_name -> codewiki.v1.ArchitectureViolation
+	1,  // 66: codewiki.v1.ViewFileResp.language:type_name -> codewiki.v1.Language
+	85, // 67: codewiki.v1.ViewFileResp.functions:type_name -> codewiki.v1.Function
+	25, // 68: codewiki.v1.Function.span:type_name -> codewiki.v1.SourceSpan
+	85, // 69: codewiki.v1.Entity.functions:type_name -> codewiki.v1.Function
+	86, // 70: codewiki.v1.GetImplementResp.entities:type_name -> codewiki.v1.Entity
+	17, // 71: codewiki.v1.CodeWikiService.CallChain:input_type -> codewiki.v1.CallChainReq
+	20, // 72: codewiki.v1.CodeWikiService.CallersChain:input_type -> codewiki.v1.CallersChainReq
+	21, // 73: codewiki.v1.CodeWikiService.CallPath:input_type -> codewiki.v1.CallPathReq
+	27, // 74: codewiki.v1.CodeWikiService.CreateRepo:input_type -> codewiki.v1.CreateRepoReq
+	29, // 75: codewiki.v1.CodeWikiService.ListRepos:input_type -> codewiki.v1.ListReposReq
+	31, // 76: codewiki.v1.CodeWikiService.GetRepo:input_type -> codewiki.v1.GetRepoReq
+	33, // 77: codewiki.v1.CodeWikiService.DeleteRepo:input_type -> codewiki.v1.DeleteRepoReq
+	35, // 78: codewiki.v1.CodeWikiService.AnalyzeRepo:input_type -> codewiki.v1.AnalyzeRepoReq
+	49, // 79: codewiki.v1.CodeWikiService.GetAnalysisJob:input_type -> codewiki.v1.GetAnalysisJobReq
+	51, // 80: codewiki.v1.CodeWikiService.ListAnalysisJobs:input_type -> codewiki.v1.ListAnalysisJobsReq
+	53, // 81: codewiki.v1.CodeWikiService.CancelAnalysisJob:input_type -> codewiki.v1.CancelAnalysisJobReq
+	38, // 82: codewiki.v1.CodeWikiService.ListSnapshots:input_type -> codewiki.v1.ListSnapshotsReq
+	40, // 83: codewiki.v1.CodeWikiService.DiffGraphs:input_type -> codewiki.v1.DiffGraphsReq
+	46, // 84: codewiki.v1.CodeWikiService.CheckAPICompatibility:input_type -> codewiki.v1.CheckAPICompatibilityReq
+	55, // 85: codewiki.v1.CodeWikiService.SearchSymbols:input_type -> codewiki.v1.SearchSymbolsReq
+	61, // 86: codewiki.v1.CodeWikiService.ResolveSymbolAt:input_type -> codewiki.v1.ResolveSymbolAtReq
+	63, // 87: codewiki.v1.CodeWikiService.FindReferences:input_type -> codewiki.v1.FindReferencesReq
+	66, // 88: codewiki.v1.CodeWikiService.AnalyzeImpact:input_type -> codewiki.v1.AnalyzeImpactReq
+	58, // 89: codewiki.v1.CodeWikiService.GetFunctionTests:input_type -> codewiki.v1.GetFunctionTestsReq
+	72, // 90: codewiki.v1.CodeWikiService.GetPackageDependencies:input_type -> codewiki.v1.GetPackageDependenciesReq
+	79, // 91: codewiki.v1.CodeWikiService.GetArchitectureViolations:input_type -> codewiki.v1.GetArchitectureViolationsReq
+	70, // 92: codewiki.v1.CodeWikiService.GetRepoTree:input_type -> codewiki.v1.GetRepoTreeReq
+	83, // 93: codewiki.v1.CodeWikiService.ViewFileContent:input_type -> codewiki.v1.ViewFileReq
+	87, // 94: codewiki.v1.CodeWikiService.GetImplement:input_type -> codewiki.v1.GetImplementReq
+	89, // 95: codewiki.v1.CodeWikiService.Answer:input_type -> codewiki.v1.AnswerReq
+	18, // 96: codewiki.v1.CodeWikiService.CallChain:output_type -> codewiki.v1.CallChainResp
+	18, // 97: codewiki.v1.CodeWikiService.CallersChain:output_type -> codewiki.v1.CallChainResp
+	22, // 98: codewiki.v1.CodeWikiService.CallPath:output_type -> codewiki.v1.CallPathResp
+	28, // 99: codewiki.v1.CodeWikiService.CreateRepo:output_type -> codewiki.v1.CreateRepoResp
+	30, // 100: codewiki.v1.CodeWikiService.ListRepos:output_type -> codewiki.v1.ListReposResp
+	32, // 101: codewiki.v1.CodeWikiService.GetRepo:output_type -> codewiki.v1.GetRepoResp
+	34, // 102: codewiki.v1.CodeWikiService.DeleteRepo:output_type -> codewiki.v1.DeleteRepoResp
+	15, // 103: codewiki.v1.CodeWikiService.AnalyzeRepo:output_type -> codewiki.v1.AnalyzeResp
+	50, // 104: codewiki.v1.CodeWikiService.GetAnalysisJob:output_type -> codewiki.v1.GetAnalysisJobResp
+	52, // 105: codewiki.v1.CodeWikiService.ListAnalysisJobs:output_type -> codewiki.v1.ListAnalysisJobsResp
+	54, // 106: codewiki.v1.CodeWikiService.CancelAnalysisJob:output_type -> codewiki.v1.CancelAnalysisJobResp
+	39, // 107: codewiki.v1.CodeWikiService.ListSnapshots:output_type -> codewiki.v1.ListSnapshotsResp
+	41, // 108: codewiki.v1.CodeWikiService.DiffGraphs:output_type -> codewiki.v1.DiffGraphsResp
+	47, // 109: codewiki.v1.CodeWikiService.CheckAPICompatibility:output_type -> codewiki.v1.CheckAPICompatibilityResp
+	56, // 110: codewiki.v1.CodeWikiService.SearchSymbols:output_type -> codewiki.v1.SearchSymbolsResp
+	62, // 111: codewiki.v1.CodeWikiService.ResolveSymbolAt:output_type -> codewiki.v1.ResolveSymbolAtResp
+	64, // 112: codewiki.v1.CodeWikiService.FindReferences:output_type -> codewiki.v1.FindReferencesResp
+	67, // 113: codewiki.v1.CodeWikiService.AnalyzeImpact:output_type -> codewiki.v1.AnalyzeImpactResp
+	59, // 114: codewiki.v1.CodeWikiService.GetFunctionTests:output_type -> codewiki.v1.GetFunctionTestsResp
+	73, // 115: codewiki.v1.CodeWikiService.GetPackageDependencies:output_type -> codewiki.v1.GetPackageDependenciesResp
+	80, // 116: codewiki.v1.CodeWikiService.GetArchitectureViolations:output_type -> codewiki.v1.GetArchitectureViolationsResp
+	71, // 117: codewiki.v1.CodeWikiService.GetRepoTree:output_type -> codewiki.v1.GetRepoTreeResp
+	84, // 118: codewiki.v1.CodeWikiService.ViewFileContent:output_type -> codewiki.v1.ViewFileResp
+	88, // 119: codewiki.v1.CodeWikiService.GetImplement:output_type -> codewiki.v1.GetImplementResp
+	90, // 120: codewiki.v1.CodeWikiService.Answer:output_type -> codewiki.v1.AnswerResp
+	96, // [96:121] is the sub-list for method output_type
+	71, // [71:96] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_codewiki_v1_codewiki_proto_init() }
@@ -2029,8 +6762,8 @@ func file_codewiki_v1_codewiki_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_codewiki_v1_codewiki_proto_rawDesc), len(file_codewiki_v1_codewiki_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   27,
+			NumEnums:      14,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
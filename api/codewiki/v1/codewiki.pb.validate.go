@@ -179,6 +179,37 @@ func (m *AnalyzeResp) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalyzeRespValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalyzeRespValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalyzeRespValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for JobId
+
 	if len(errors) > 0 {
 		return AnalyzeRespMultiError(errors)
 	}
@@ -256,6 +287,108 @@ var _ interface {
 	ErrorName() string
 } = AnalyzeRespValidationError{}
 
+// Validate checks the field values on AnalyzeSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalyzeSummaryMultiError,
+// or nil if none found.
+func (m *AnalyzeSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Analyzed
+
+	if len(errors) > 0 {
+		return AnalyzeSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeSummaryMultiError is an error wrapping multiple validation errors
+// returned by AnalyzeSummary.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzeSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeSummaryMultiError) AllErrors() []error { return m }
+
+// AnalyzeSummaryValidationError is the validation error returned by
+// AnalyzeSummary.Validate if the designated constraints aren't met.
+type AnalyzeSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeSummaryValidationError) ErrorName() string { return "AnalyzeSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalyzeSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeSummaryValidationError{}
+
 // Validate checks the field values on CallChainReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -280,6 +413,38 @@ func (m *CallChainReq) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for FollowDispatch
+
+	// no validation rules for Snapshot
+
+	if val := m.GetMaxDepth(); val < 0 || val > 50 {
+		err := CallChainReqValidationError{
+			field:  "MaxDepth",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxNodes(); val < 0 || val > 5000 {
+		err := CallChainReqValidationError{
+			field:  "MaxNodes",
+			reason: "value must be inside range [0, 5000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Direction
+
+	// no validation rules for Cursor
+
+	// no validation rules for ExcludeTests
+
 	if len(errors) > 0 {
 		return CallChainReqMultiError(errors)
 	}
@@ -417,6 +582,42 @@ func (m *CallChainResp) validate(all bool) error {
 
 	}
 
+	// no validation rules for Truncated
+
+	for idx, item := range m.GetFrontier() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CallChainRespValidationError{
+						field:  fmt.Sprintf("Frontier[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CallChainRespValidationError{
+						field:  fmt.Sprintf("Frontier[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CallChainRespValidationError{
+					field:  fmt.Sprintf("Frontier[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CallChainRespMultiError(errors)
 	}
@@ -495,62 +696,47 @@ var _ interface {
 	ErrorName() string
 } = CallChainRespValidationError{}
 
-// Validate checks the field values on CallRelationship with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CallRelationship) Validate() error {
+// Validate checks the field values on CallFrontier with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CallFrontier) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CallRelationship with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CallRelationshipMultiError, or nil if none found.
-func (m *CallRelationship) ValidateAll() error {
+// ValidateAll checks the field values on CallFrontier with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CallFrontierMultiError, or
+// nil if none found.
+func (m *CallFrontier) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CallRelationship) validate(all bool) error {
+func (m *CallFrontier) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CallerId
-
-	// no validation rules for CallerName
-
-	// no validation rules for CalleeId
-
-	// no validation rules for CalleeName
-
-	// no validation rules for CalleeFileId
-
-	// no validation rules for CallerFileId
-
-	// no validation rules for CalleeScope
-
-	// no validation rules for CallerScope
+	// no validation rules for Id
 
-	// no validation rules for CalleeEntityId
+	// no validation rules for Depth
 
-	// no validation rules for CallerEntityId
+	// no validation rules for Cursor
 
 	if len(errors) > 0 {
-		return CallRelationshipMultiError(errors)
+		return CallFrontierMultiError(errors)
 	}
 
 	return nil
 }
 
-// CallRelationshipMultiError is an error wrapping multiple validation errors
-// returned by CallRelationship.ValidateAll() if the designated constraints
-// aren't met.
-type CallRelationshipMultiError []error
+// CallFrontierMultiError is an error wrapping multiple validation errors
+// returned by CallFrontier.ValidateAll() if the designated constraints aren't met.
+type CallFrontierMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CallRelationshipMultiError) Error() string {
+func (m CallFrontierMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -559,11 +745,11 @@ func (m CallRelationshipMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CallRelationshipMultiError) AllErrors() []error { return m }
+func (m CallFrontierMultiError) AllErrors() []error { return m }
 
-// CallRelationshipValidationError is the validation error returned by
-// CallRelationship.Validate if the designated constraints aren't met.
-type CallRelationshipValidationError struct {
+// CallFrontierValidationError is the validation error returned by
+// CallFrontier.Validate if the designated constraints aren't met.
+type CallFrontierValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -571,22 +757,22 @@ type CallRelationshipValidationError struct {
 }
 
 // Field function returns field value.
-func (e CallRelationshipValidationError) Field() string { return e.field }
+func (e CallFrontierValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CallRelationshipValidationError) Reason() string { return e.reason }
+func (e CallFrontierValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CallRelationshipValidationError) Cause() error { return e.cause }
+func (e CallFrontierValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CallRelationshipValidationError) Key() bool { return e.key }
+func (e CallFrontierValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CallRelationshipValidationError) ErrorName() string { return "CallRelationshipValidationError" }
+func (e CallFrontierValidationError) ErrorName() string { return "CallFrontierValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CallRelationshipValidationError) Error() string {
+func (e CallFrontierValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -598,14 +784,14 @@ func (e CallRelationshipValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCallRelationship.%s: %s%s",
+		"invalid %sCallFrontier.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CallRelationshipValidationError{}
+var _ error = CallFrontierValidationError{}
 
 var _ interface {
 	Field() string
@@ -613,58 +799,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CallRelationshipValidationError{}
+} = CallFrontierValidationError{}
 
-// Validate checks the field values on Repo with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Repo) Validate() error {
+// Validate checks the field values on CallersChainReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CallersChainReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Repo with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RepoMultiError, or nil if none found.
-func (m *Repo) ValidateAll() error {
+// ValidateAll checks the field values on CallersChainReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CallersChainReqMultiError, or nil if none found.
+func (m *CallersChainReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Repo) validate(all bool) error {
+func (m *CallersChainReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for RepoType
-
-	// no validation rules for Path
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CallersChainReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Target
+	if val := m.GetMaxDepth(); val < 0 || val > 20 {
+		err := CallersChainReqValidationError{
+			field:  "MaxDepth",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Token
+	// no validation rules for FollowDispatch
 
-	// no validation rules for Description
+	// no validation rules for Snapshot
 
-	// no validation rules for Language
+	// no validation rules for ExcludeTests
 
 	if len(errors) > 0 {
-		return RepoMultiError(errors)
+		return CallersChainReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// RepoMultiError is an error wrapping multiple validation errors returned by
-// Repo.ValidateAll() if the designated constraints aren't met.
-type RepoMultiError []error
+// CallersChainReqMultiError is an error wrapping multiple validation errors
+// returned by CallersChainReq.ValidateAll() if the designated constraints
+// aren't met.
+type CallersChainReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RepoMultiError) Error() string {
+func (m CallersChainReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -673,11 +873,11 @@ func (m RepoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoMultiError) AllErrors() []error { return m }
+func (m CallersChainReqMultiError) AllErrors() []error { return m }
 
-// RepoValidationError is the validation error returned by Repo.Validate if the
-// designated constraints aren't met.
-type RepoValidationError struct {
+// CallersChainReqValidationError is the validation error returned by
+// CallersChainReq.Validate if the designated constraints aren't met.
+type CallersChainReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -685,22 +885,22 @@ type RepoValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoValidationError) Field() string { return e.field }
+func (e CallersChainReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoValidationError) Reason() string { return e.reason }
+func (e CallersChainReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoValidationError) Cause() error { return e.cause }
+func (e CallersChainReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoValidationError) Key() bool { return e.key }
+func (e CallersChainReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoValidationError) ErrorName() string { return "RepoValidationError" }
+func (e CallersChainReqValidationError) ErrorName() string { return "CallersChainReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RepoValidationError) Error() string {
+func (e CallersChainReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -712,14 +912,14 @@ func (e RepoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepo.%s: %s%s",
+		"invalid %sCallersChainReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoValidationError{}
+var _ error = CallersChainReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -727,34 +927,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoValidationError{}
+} = CallersChainReqValidationError{}
 
-// Validate checks the field values on CreateRepoReq with the rules defined in
+// Validate checks the field values on CallPathReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateRepoReq) Validate() error {
+func (m *CallPathReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRepoReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateRepoReqMultiError, or
+// ValidateAll checks the field values on CallPathReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CallPathReqMultiError, or
 // nil if none found.
-func (m *CreateRepoReq) ValidateAll() error {
+func (m *CallPathReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRepoReq) validate(all bool) error {
+func (m *CallPathReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
-		err := CreateRepoReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 128 runes, inclusive",
+	if utf8.RuneCountInString(m.GetSource()) < 1 {
+		err := CallPathReqValidationError{
+			field:  "Source",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -762,14 +962,10 @@ func (m *CreateRepoReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for RepoType
-
-	// no validation rules for Path
-
-	if l := utf8.RuneCountInString(m.GetTarget()); l < 1 || l > 512 {
-		err := CreateRepoReqValidationError{
+	if utf8.RuneCountInString(m.GetTarget()) < 1 {
+		err := CallPathReqValidationError{
 			field:  "Target",
-			reason: "value length must be between 1 and 512 runes, inclusive",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -777,26 +973,45 @@ func (m *CreateRepoReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Token
+	if val := m.GetK(); val < 0 || val > 10 {
+		err := CallPathReqValidationError{
+			field:  "K",
+			reason: "value must be inside range [0, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Description
+	if val := m.GetMaxDepth(); val < 0 || val > 30 {
+		err := CallPathReqValidationError{
+			field:  "MaxDepth",
+			reason: "value must be inside range [0, 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Language
+	// no validation rules for Snapshot
+
+	// no validation rules for ExcludeTests
 
 	if len(errors) > 0 {
-		return CreateRepoReqMultiError(errors)
+		return CallPathReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRepoReqMultiError is an error wrapping multiple validation errors
-// returned by CreateRepoReq.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRepoReqMultiError []error
+// CallPathReqMultiError is an error wrapping multiple validation errors
+// returned by CallPathReq.ValidateAll() if the designated constraints aren't met.
+type CallPathReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRepoReqMultiError) Error() string {
+func (m CallPathReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -805,11 +1020,11 @@ func (m CreateRepoReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRepoReqMultiError) AllErrors() []error { return m }
+func (m CallPathReqMultiError) AllErrors() []error { return m }
 
-// CreateRepoReqValidationError is the validation error returned by
-// CreateRepoReq.Validate if the designated constraints aren't met.
-type CreateRepoReqValidationError struct {
+// CallPathReqValidationError is the validation error returned by
+// CallPathReq.Validate if the designated constraints aren't met.
+type CallPathReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -817,22 +1032,22 @@ type CreateRepoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRepoReqValidationError) Field() string { return e.field }
+func (e CallPathReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRepoReqValidationError) Reason() string { return e.reason }
+func (e CallPathReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRepoReqValidationError) Cause() error { return e.cause }
+func (e CallPathReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRepoReqValidationError) Key() bool { return e.key }
+func (e CallPathReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRepoReqValidationError) ErrorName() string { return "CreateRepoReqValidationError" }
+func (e CallPathReqValidationError) ErrorName() string { return "CallPathReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRepoReqValidationError) Error() string {
+func (e CallPathReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -844,14 +1059,14 @@ func (e CreateRepoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRepoReq.%s: %s%s",
+		"invalid %sCallPathReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRepoReqValidationError{}
+var _ error = CallPathReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -859,46 +1074,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRepoReqValidationError{}
+} = CallPathReqValidationError{}
 
-// Validate checks the field values on CreateRepoResp with the rules defined in
+// Validate checks the field values on CallPathResp with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateRepoResp) Validate() error {
+func (m *CallPathResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRepoResp with the rules defined
+// ValidateAll checks the field values on CallPathResp with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateRepoRespMultiError,
-// or nil if none found.
-func (m *CreateRepoResp) ValidateAll() error {
+// result is a list of violation errors wrapped in CallPathRespMultiError, or
+// nil if none found.
+func (m *CallPathResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRepoResp) validate(all bool) error {
+func (m *CallPathResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CallPathRespValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CallPathRespValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CallPathRespValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
 
 	if len(errors) > 0 {
-		return CreateRepoRespMultiError(errors)
+		return CallPathRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRepoRespMultiError is an error wrapping multiple validation errors
-// returned by CreateRepoResp.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRepoRespMultiError []error
+// CallPathRespMultiError is an error wrapping multiple validation errors
+// returned by CallPathResp.ValidateAll() if the designated constraints aren't met.
+type CallPathRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRepoRespMultiError) Error() string {
+func (m CallPathRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -907,11 +1155,11 @@ func (m CreateRepoRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRepoRespMultiError) AllErrors() []error { return m }
+func (m CallPathRespMultiError) AllErrors() []error { return m }
 
-// CreateRepoRespValidationError is the validation error returned by
-// CreateRepoResp.Validate if the designated constraints aren't met.
-type CreateRepoRespValidationError struct {
+// CallPathRespValidationError is the validation error returned by
+// CallPathResp.Validate if the designated constraints aren't met.
+type CallPathRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -919,22 +1167,22 @@ type CreateRepoRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRepoRespValidationError) Field() string { return e.field }
+func (e CallPathRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRepoRespValidationError) Reason() string { return e.reason }
+func (e CallPathRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRepoRespValidationError) Cause() error { return e.cause }
+func (e CallPathRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRepoRespValidationError) Key() bool { return e.key }
+func (e CallPathRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRepoRespValidationError) ErrorName() string { return "CreateRepoRespValidationError" }
+func (e CallPathRespValidationError) ErrorName() string { return "CallPathRespValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateRepoRespValidationError) Error() string {
+func (e CallPathRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -946,14 +1194,14 @@ func (e CreateRepoRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRepoResp.%s: %s%s",
+		"invalid %sCallPathResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRepoRespValidationError{}
+var _ error = CallPathRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -961,43 +1209,6646 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRepoRespValidationError{}
+} = CallPathRespValidationError{}
 
-// Validate checks the field values on ListReposReq with the rules defined in
+// Validate checks the field values on FunctionPath with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ListReposReq) Validate() error {
+func (m *FunctionPath) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FunctionPath with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunctionPathMultiError, or
+// nil if none found.
+func (m *FunctionPath) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FunctionPath) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHops() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunctionPathValidationError{
+						field:  fmt.Sprintf("Hops[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunctionPathValidationError{
+						field:  fmt.Sprintf("Hops[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunctionPathValidationError{
+					field:  fmt.Sprintf("Hops[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FunctionPathMultiError(errors)
+	}
+
+	return nil
+}
+
+// FunctionPathMultiError is an error wrapping multiple validation errors
+// returned by FunctionPath.ValidateAll() if the designated constraints aren't met.
+type FunctionPathMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionPathMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionPathMultiError) AllErrors() []error { return m }
+
+// FunctionPathValidationError is the validation error returned by
+// FunctionPath.Validate if the designated constraints aren't met.
+type FunctionPathValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionPathValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionPathValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionPathValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionPathValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionPathValidationError) ErrorName() string { return "FunctionPathValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionPathValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunctionPath.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionPathValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionPathValidationError{}
+
+// Validate checks the field values on CallRelationship with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CallRelationship) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CallRelationship with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CallRelationshipMultiError, or nil if none found.
+func (m *CallRelationship) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CallRelationship) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CallerId
+
+	// no validation rules for CallerName
+
+	// no validation rules for CalleeId
+
+	// no validation rules for CalleeName
+
+	// no validation rules for CalleeFileId
+
+	// no validation rules for CallerFileId
+
+	// no validation rules for CalleeScope
+
+	// no validation rules for CallerScope
+
+	// no validation rules for CalleeEntityId
+
+	// no validation rules for CallerEntityId
+
+	// no validation rules for Relation
+
+	if all {
+		switch v := interface{}(m.GetCallSite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CallRelationshipValidationError{
+					field:  "CallSite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CallRelationshipValidationError{
+					field:  "CallSite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCallSite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CallRelationshipValidationError{
+				field:  "CallSite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CallRelationshipMultiError(errors)
+	}
+
+	return nil
+}
+
+// CallRelationshipMultiError is an error wrapping multiple validation errors
+// returned by CallRelationship.ValidateAll() if the designated constraints
+// aren't met.
+type CallRelationshipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CallRelationshipMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CallRelationshipMultiError) AllErrors() []error { return m }
+
+// CallRelationshipValidationError is the validation error returned by
+// CallRelationship.Validate if the designated constraints aren't met.
+type CallRelationshipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CallRelationshipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CallRelationshipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CallRelationshipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CallRelationshipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CallRelationshipValidationError) ErrorName() string { return "CallRelationshipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CallRelationshipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCallRelationship.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CallRelationshipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CallRelationshipValidationError{}
+
+// Validate checks the field values on SourceSpan with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourceSpan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceSpan with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourceSpanMultiError, or
+// nil if none found.
+func (m *SourceSpan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceSpan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartLine
+
+	// no validation rules for StartColumn
+
+	// no validation rules for EndLine
+
+	// no validation rules for EndColumn
+
+	if len(errors) > 0 {
+		return SourceSpanMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceSpanMultiError is an error wrapping multiple validation errors
+// returned by SourceSpan.ValidateAll() if the designated constraints aren't met.
+type SourceSpanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceSpanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceSpanMultiError) AllErrors() []error { return m }
+
+// SourceSpanValidationError is the validation error returned by
+// SourceSpan.Validate if the designated constraints aren't met.
+type SourceSpanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceSpanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceSpanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceSpanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceSpanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceSpanValidationError) ErrorName() string { return "SourceSpanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SourceSpanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceSpan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceSpanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceSpanValidationError{}
+
+// Validate checks the field values on Repo with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Repo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Repo with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RepoMultiError, or nil if none found.
+func (m *Repo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Repo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for RepoType
+
+	// no validation rules for Path
+
+	// no validation rules for Target
+
+	// no validation rules for Token
+
+	// no validation rules for Description
+
+	// no validation rules for Language
+
+	// no validation rules for CallResolver
+
+	// no validation rules for Ref
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RepoValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RepoValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RepoValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RepoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoMultiError is an error wrapping multiple validation errors returned by
+// Repo.ValidateAll() if the designated constraints aren't met.
+type RepoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoMultiError) AllErrors() []error { return m }
+
+// RepoValidationError is the validation error returned by Repo.Validate if the
+// designated constraints aren't met.
+type RepoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoValidationError) ErrorName() string { return "RepoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoValidationError{}
+
+// Validate checks the field values on CreateRepoReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateRepoReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRepoReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateRepoReqMultiError, or
+// nil if none found.
+func (m *CreateRepoReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRepoReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := CreateRepoReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RepoType
+
+	// no validation rules for Path
+
+	if l := utf8.RuneCountInString(m.GetTarget()); l < 1 || l > 512 {
+		err := CreateRepoReqValidationError{
+			field:  "Target",
+			reason: "value length must be between 1 and 512 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Token
+
+	// no validation rules for Description
+
+	// no validation rules for Language
+
+	// no validation rules for CallResolver
+
+	// no validation rules for Ref
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateRepoReqValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateRepoReqValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateRepoReqValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateRepoReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRepoReqMultiError is an error wrapping multiple validation errors
+// returned by CreateRepoReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRepoReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRepoReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRepoReqMultiError) AllErrors() []error { return m }
+
+// CreateRepoReqValidationError is the validation error returned by
+// CreateRepoReq.Validate if the designated constraints aren't met.
+type CreateRepoReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRepoReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRepoReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRepoReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRepoReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRepoReqValidationError) ErrorName() string { return "CreateRepoReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRepoReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRepoReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRepoReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRepoReqValidationError{}
+
+// Validate checks the field values on CreateRepoResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateRepoResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRepoResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateRepoRespMultiError,
+// or nil if none found.
+func (m *CreateRepoResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRepoResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateRepoRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRepoRespMultiError is an error wrapping multiple validation errors
+// returned by CreateRepoResp.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRepoRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRepoRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRepoRespMultiError) AllErrors() []error { return m }
+
+// CreateRepoRespValidationError is the validation error returned by
+// CreateRepoResp.Validate if the designated constraints aren't met.
+type CreateRepoRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRepoRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRepoRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRepoRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRepoRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRepoRespValidationError) ErrorName() string { return "CreateRepoRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRepoRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRepoResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRepoRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRepoRespValidationError{}
+
+// Validate checks the field values on ListReposReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListReposReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReposReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListReposReqMultiError, or
+// nil if none found.
+func (m *ListReposReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReposReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListReposReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReposReqMultiError is an error wrapping multiple validation errors
+// returned by ListReposReq.ValidateAll() if the designated constraints aren't met.
+type ListReposReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReposReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReposReqMultiError) AllErrors() []error { return m }
+
+// ListReposReqValidationError is the validation error returned by
+// ListReposReq.Validate if the designated constraints aren't met.
+type ListReposReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReposReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReposReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReposReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReposReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReposReqValidationError) ErrorName() string { return "ListReposReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListReposReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReposReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReposReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReposReqValidationError{}
+
+// Validate checks the field values on ListReposResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListReposResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReposResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListReposRespMultiError, or
+// nil if none found.
+func (m *ListReposResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReposResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRepos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReposRespValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReposRespValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReposRespValidationError{
+					field:  fmt.Sprintf("Repos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReposRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReposRespMultiError is an error wrapping multiple validation errors
+// returned by ListReposResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListReposRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReposRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReposRespMultiError) AllErrors() []error { return m }
+
+// ListReposRespValidationError is the validation error returned by
+// ListReposResp.Validate if the designated constraints aren't met.
+type ListReposRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReposRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReposRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReposRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReposRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReposRespValidationError) ErrorName() string { return "ListReposRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListReposRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReposResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReposRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReposRespValidationError{}
+
+// Validate checks the field values on GetRepoReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRepoReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRepoReqMultiError, or
+// nil if none found.
+func (m *GetRepoReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetRepoReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoReqMultiError is an error wrapping multiple validation errors
+// returned by GetRepoReq.ValidateAll() if the designated constraints aren't met.
+type GetRepoReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoReqMultiError) AllErrors() []error { return m }
+
+// GetRepoReqValidationError is the validation error returned by
+// GetRepoReq.Validate if the designated constraints aren't met.
+type GetRepoReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoReqValidationError) ErrorName() string { return "GetRepoReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoReqValidationError{}
+
+// Validate checks the field values on GetRepoResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRepoResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRepoRespMultiError, or
+// nil if none found.
+func (m *GetRepoResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRepo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoRespValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoRespValidationError{
+					field:  "Repo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoRespValidationError{
+				field:  "Repo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRepoRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoRespMultiError is an error wrapping multiple validation errors
+// returned by GetRepoResp.ValidateAll() if the designated constraints aren't met.
+type GetRepoRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoRespMultiError) AllErrors() []error { return m }
+
+// GetRepoRespValidationError is the validation error returned by
+// GetRepoResp.Validate if the designated constraints aren't met.
+type GetRepoRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoRespValidationError) ErrorName() string { return "GetRepoRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoRespValidationError{}
+
+// Validate checks the field values on DeleteRepoReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRepoReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRepoReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRepoReqMultiError, or
+// nil if none found.
+func (m *DeleteRepoReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRepoReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRepoReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRepoReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteRepoReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRepoReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRepoReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRepoReqMultiError) AllErrors() []error { return m }
+
+// DeleteRepoReqValidationError is the validation error returned by
+// DeleteRepoReq.Validate if the designated constraints aren't met.
+type DeleteRepoReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRepoReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRepoReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRepoReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRepoReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRepoReqValidationError) ErrorName() string { return "DeleteRepoReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRepoReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRepoReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRepoReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRepoReqValidationError{}
+
+// Validate checks the field values on DeleteRepoResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRepoResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRepoResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRepoRespMultiError,
+// or nil if none found.
+func (m *DeleteRepoResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRepoResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteRepoRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRepoRespMultiError is an error wrapping multiple validation errors
+// returned by DeleteRepoResp.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRepoRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRepoRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRepoRespMultiError) AllErrors() []error { return m }
+
+// DeleteRepoRespValidationError is the validation error returned by
+// DeleteRepoResp.Validate if the designated constraints aren't met.
+type DeleteRepoRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRepoRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRepoRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRepoRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRepoRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRepoRespValidationError) ErrorName() string { return "DeleteRepoRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRepoRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRepoResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRepoRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRepoRespValidationError{}
+
+// Validate checks the field values on AnalyzeRepoReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeRepoReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeRepoReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalyzeRepoReqMultiError,
+// or nil if none found.
+func (m *AnalyzeRepoReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeRepoReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Incremental
+
+	if len(errors) > 0 {
+		return AnalyzeRepoReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeRepoReqMultiError is an error wrapping multiple validation errors
+// returned by AnalyzeRepoReq.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzeRepoReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeRepoReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeRepoReqMultiError) AllErrors() []error { return m }
+
+// AnalyzeRepoReqValidationError is the validation error returned by
+// AnalyzeRepoReq.Validate if the designated constraints aren't met.
+type AnalyzeRepoReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeRepoReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeRepoReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeRepoReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeRepoReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeRepoReqValidationError) ErrorName() string { return "AnalyzeRepoReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalyzeRepoReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeRepoReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeRepoReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeRepoReqValidationError{}
+
+// Validate checks the field values on AnalysisJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnalysisJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalysisJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnalysisJobMultiError, or
+// nil if none found.
+func (m *AnalysisJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalysisJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RepoId
+
+	// no validation rules for Status
+
+	// no validation rules for Phase
+
+	// no validation rules for Incremental
+
+	// no validation rules for TotalFiles
+
+	// no validation rules for ProcessedFiles
+
+	// no validation rules for Error
+
+	// no validation rules for DurationMs
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for StartedAt
+
+	// no validation rules for FinishedAt
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalysisJobValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalysisJobValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalysisJobValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Commit
+
+	// no validation rules for Snapshot
+
+	if len(errors) > 0 {
+		return AnalysisJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalysisJobMultiError is an error wrapping multiple validation errors
+// returned by AnalysisJob.ValidateAll() if the designated constraints aren't met.
+type AnalysisJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalysisJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalysisJobMultiError) AllErrors() []error { return m }
+
+// AnalysisJobValidationError is the validation error returned by
+// AnalysisJob.Validate if the designated constraints aren't met.
+type AnalysisJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalysisJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalysisJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalysisJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalysisJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalysisJobValidationError) ErrorName() string { return "AnalysisJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalysisJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalysisJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalysisJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalysisJobValidationError{}
+
+// Validate checks the field values on Snapshot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Snapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Snapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SnapshotMultiError, or nil
+// if none found.
+func (m *Snapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Snapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RepoId
+
+	// no validation rules for Commit
+
+	// no validation rules for Ref
+
+	// no validation rules for JobId
+
+	// no validation rules for AnalyzedAt
+
+	if len(errors) > 0 {
+		return SnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// SnapshotMultiError is an error wrapping multiple validation errors returned
+// by Snapshot.ValidateAll() if the designated constraints aren't met.
+type SnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnapshotMultiError) AllErrors() []error { return m }
+
+// SnapshotValidationError is the validation error returned by
+// Snapshot.Validate if the designated constraints aren't met.
+type SnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnapshotValidationError) ErrorName() string { return "SnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnapshotValidationError{}
+
+// Validate checks the field values on ListSnapshotsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSnapshotsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSnapshotsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSnapshotsReqMultiError, or nil if none found.
+func (m *ListSnapshotsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSnapshotsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListSnapshotsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSnapshotsReqMultiError is an error wrapping multiple validation errors
+// returned by ListSnapshotsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListSnapshotsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSnapshotsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSnapshotsReqMultiError) AllErrors() []error { return m }
+
+// ListSnapshotsReqValidationError is the validation error returned by
+// ListSnapshotsReq.Validate if the designated constraints aren't met.
+type ListSnapshotsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSnapshotsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSnapshotsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSnapshotsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSnapshotsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSnapshotsReqValidationError) ErrorName() string { return "ListSnapshotsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListSnapshotsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSnapshotsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSnapshotsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSnapshotsReqValidationError{}
+
+// Validate checks the field values on ListSnapshotsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSnapshotsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSnapshotsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSnapshotsRespMultiError, or nil if none found.
+func (m *ListSnapshotsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSnapshotsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSnapshotsRespValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSnapshotsRespValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSnapshotsRespValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSnapshotsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSnapshotsRespMultiError is an error wrapping multiple validation errors
+// returned by ListSnapshotsResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListSnapshotsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSnapshotsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSnapshotsRespMultiError) AllErrors() []error { return m }
+
+// ListSnapshotsRespValidationError is the validation error returned by
+// ListSnapshotsResp.Validate if the designated constraints aren't met.
+type ListSnapshotsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSnapshotsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSnapshotsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSnapshotsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSnapshotsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSnapshotsRespValidationError) ErrorName() string {
+	return "ListSnapshotsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSnapshotsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSnapshotsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSnapshotsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSnapshotsRespValidationError{}
+
+// Validate checks the field values on DiffGraphsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffGraphsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffGraphsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffGraphsReqMultiError, or
+// nil if none found.
+func (m *DiffGraphsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffGraphsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DiffGraphsReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBase()) < 1 {
+		err := DiffGraphsReqValidationError{
+			field:  "Base",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Head
+
+	// no validation rules for ExportedOnly
+
+	if len(errors) > 0 {
+		return DiffGraphsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffGraphsReqMultiError is an error wrapping multiple validation errors
+// returned by DiffGraphsReq.ValidateAll() if the designated constraints
+// aren't met.
+type DiffGraphsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffGraphsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffGraphsReqMultiError) AllErrors() []error { return m }
+
+// DiffGraphsReqValidationError is the validation error returned by
+// DiffGraphsReq.Validate if the designated constraints aren't met.
+type DiffGraphsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffGraphsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffGraphsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffGraphsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffGraphsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffGraphsReqValidationError) ErrorName() string { return "DiffGraphsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffGraphsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffGraphsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffGraphsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffGraphsReqValidationError{}
+
+// Validate checks the field values on DiffGraphsResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffGraphsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffGraphsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffGraphsRespMultiError,
+// or nil if none found.
+func (m *DiffGraphsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffGraphsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Base
+
+	// no validation rules for Head
+
+	if all {
+		switch v := interface{}(m.GetPackages()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Packages",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Packages",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackages()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffGraphsRespValidationError{
+				field:  "Packages",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntities()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Entities",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Entities",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntities()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffGraphsRespValidationError{
+				field:  "Entities",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFunctions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Functions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Functions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFunctions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffGraphsRespValidationError{
+				field:  "Functions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRelations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Relations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffGraphsRespValidationError{
+					field:  "Relations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffGraphsRespValidationError{
+				field:  "Relations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiffGraphsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffGraphsRespMultiError is an error wrapping multiple validation errors
+// returned by DiffGraphsResp.ValidateAll() if the designated constraints
+// aren't met.
+type DiffGraphsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffGraphsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffGraphsRespMultiError) AllErrors() []error { return m }
+
+// DiffGraphsRespValidationError is the validation error returned by
+// DiffGraphsResp.Validate if the designated constraints aren't met.
+type DiffGraphsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffGraphsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffGraphsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffGraphsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffGraphsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffGraphsRespValidationError) ErrorName() string { return "DiffGraphsRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffGraphsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffGraphsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffGraphsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffGraphsRespValidationError{}
+
+// Validate checks the field values on NodeDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NodeDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NodeDiffMultiError, or nil
+// if none found.
+func (m *NodeDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAdded() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeDiffValidationError{
+					field:  fmt.Sprintf("Added[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemoved() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeDiffValidationError{
+					field:  fmt.Sprintf("Removed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanged() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Changed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NodeDiffValidationError{
+						field:  fmt.Sprintf("Changed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeDiffValidationError{
+					field:  fmt.Sprintf("Changed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NodeDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeDiffMultiError is an error wrapping multiple validation errors returned
+// by NodeDiff.ValidateAll() if the designated constraints aren't met.
+type NodeDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeDiffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeDiffMultiError) AllErrors() []error { return m }
+
+// NodeDiffValidationError is the validation error returned by
+// NodeDiff.Validate if the designated constraints aren't met.
+type NodeDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeDiffValidationError) ErrorName() string { return "NodeDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeDiffValidationError{}
+
+// Validate checks the field values on DiffNode with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffNodeMultiError, or nil
+// if none found.
+func (m *DiffNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return DiffNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffNodeMultiError is an error wrapping multiple validation errors returned
+// by DiffNode.ValidateAll() if the designated constraints aren't met.
+type DiffNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffNodeMultiError) AllErrors() []error { return m }
+
+// DiffNodeValidationError is the validation error returned by
+// DiffNode.Validate if the designated constraints aren't met.
+type DiffNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffNodeValidationError) ErrorName() string { return "DiffNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffNodeValidationError{}
+
+// Validate checks the field values on RelationDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationDiffMultiError, or
+// nil if none found.
+func (m *RelationDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAdded() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationDiffValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationDiffValidationError{
+						field:  fmt.Sprintf("Added[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationDiffValidationError{
+					field:  fmt.Sprintf("Added[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemoved() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationDiffValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationDiffValidationError{
+						field:  fmt.Sprintf("Removed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationDiffValidationError{
+					field:  fmt.Sprintf("Removed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationDiffMultiError is an error wrapping multiple validation errors
+// returned by RelationDiff.ValidateAll() if the designated constraints aren't met.
+type RelationDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationDiffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationDiffMultiError) AllErrors() []error { return m }
+
+// RelationDiffValidationError is the validation error returned by
+// RelationDiff.Validate if the designated constraints aren't met.
+type RelationDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationDiffValidationError) ErrorName() string { return "RelationDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationDiffValidationError{}
+
+// Validate checks the field values on DiffRelation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRelation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffRelationMultiError, or
+// nil if none found.
+func (m *DiffRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for SourceId
+
+	// no validation rules for TargetId
+
+	if len(errors) > 0 {
+		return DiffRelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRelationMultiError is an error wrapping multiple validation errors
+// returned by DiffRelation.ValidateAll() if the designated constraints aren't met.
+type DiffRelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRelationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRelationMultiError) AllErrors() []error { return m }
+
+// DiffRelationValidationError is the validation error returned by
+// DiffRelation.Validate if the designated constraints aren't met.
+type DiffRelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRelationValidationError) ErrorName() string { return "DiffRelationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffRelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRelationValidationError{}
+
+// Validate checks the field values on CheckAPICompatibilityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAPICompatibilityReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAPICompatibilityReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAPICompatibilityReqMultiError, or nil if none found.
+func (m *CheckAPICompatibilityReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAPICompatibilityReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CheckAPICompatibilityReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBase()) < 1 {
+		err := CheckAPICompatibilityReqValidationError{
+			field:  "Base",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Head
+
+	if len(errors) > 0 {
+		return CheckAPICompatibilityReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAPICompatibilityReqMultiError is an error wrapping multiple validation
+// errors returned by CheckAPICompatibilityReq.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAPICompatibilityReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAPICompatibilityReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAPICompatibilityReqMultiError) AllErrors() []error { return m }
+
+// CheckAPICompatibilityReqValidationError is the validation error returned by
+// CheckAPICompatibilityReq.Validate if the designated constraints aren't met.
+type CheckAPICompatibilityReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAPICompatibilityReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAPICompatibilityReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAPICompatibilityReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAPICompatibilityReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAPICompatibilityReqValidationError) ErrorName() string {
+	return "CheckAPICompatibilityReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAPICompatibilityReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAPICompatibilityReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAPICompatibilityReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAPICompatibilityReqValidationError{}
+
+// Validate checks the field values on CheckAPICompatibilityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAPICompatibilityResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAPICompatibilityResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAPICompatibilityRespMultiError, or nil if none found.
+func (m *CheckAPICompatibilityResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAPICompatibilityResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Base
+
+	// no validation rules for Head
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckAPICompatibilityRespValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckAPICompatibilityRespValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckAPICompatibilityRespValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Breaking
+
+	// no validation rules for Bump
+
+	if len(errors) > 0 {
+		return CheckAPICompatibilityRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAPICompatibilityRespMultiError is an error wrapping multiple validation
+// errors returned by CheckAPICompatibilityResp.ValidateAll() if the
+// designated constraints aren't met.
+type CheckAPICompatibilityRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAPICompatibilityRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAPICompatibilityRespMultiError) AllErrors() []error { return m }
+
+// CheckAPICompatibilityRespValidationError is the validation error returned by
+// CheckAPICompatibilityResp.Validate if the designated constraints aren't met.
+type CheckAPICompatibilityRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAPICompatibilityRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAPICompatibilityRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAPICompatibilityRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAPICompatibilityRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAPICompatibilityRespValidationError) ErrorName() string {
+	return "CheckAPICompatibilityRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAPICompatibilityRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAPICompatibilityResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAPICompatibilityRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAPICompatibilityRespValidationError{}
+
+// Validate checks the field values on APIChange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIChangeMultiError, or nil
+// if none found.
+func (m *APIChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Package
+
+	// no validation rules for Symbol
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	// no validation rules for Breaking
+
+	if len(errors) > 0 {
+		return APIChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIChangeMultiError is an error wrapping multiple validation errors returned
+// by APIChange.ValidateAll() if the designated constraints aren't met.
+type APIChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIChangeMultiError) AllErrors() []error { return m }
+
+// APIChangeValidationError is the validation error returned by
+// APIChange.Validate if the designated constraints aren't met.
+type APIChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIChangeValidationError) ErrorName() string { return "APIChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIChangeValidationError{}
+
+// Validate checks the field values on GetAnalysisJobReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalysisJobReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalysisJobReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalysisJobReqMultiError, or nil if none found.
+func (m *GetAnalysisJobReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalysisJobReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetAnalysisJobReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalysisJobReqMultiError is an error wrapping multiple validation errors
+// returned by GetAnalysisJobReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetAnalysisJobReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalysisJobReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalysisJobReqMultiError) AllErrors() []error { return m }
+
+// GetAnalysisJobReqValidationError is the validation error returned by
+// GetAnalysisJobReq.Validate if the designated constraints aren't met.
+type GetAnalysisJobReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalysisJobReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalysisJobReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalysisJobReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalysisJobReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalysisJobReqValidationError) ErrorName() string {
+	return "GetAnalysisJobReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalysisJobReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalysisJobReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalysisJobReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalysisJobReqValidationError{}
+
+// Validate checks the field values on GetAnalysisJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnalysisJobResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnalysisJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnalysisJobRespMultiError, or nil if none found.
+func (m *GetAnalysisJobResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnalysisJobResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnalysisJobRespValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnalysisJobRespValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnalysisJobRespValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAnalysisJobRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnalysisJobRespMultiError is an error wrapping multiple validation errors
+// returned by GetAnalysisJobResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetAnalysisJobRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnalysisJobRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnalysisJobRespMultiError) AllErrors() []error { return m }
+
+// GetAnalysisJobRespValidationError is the validation error returned by
+// GetAnalysisJobResp.Validate if the designated constraints aren't met.
+type GetAnalysisJobRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnalysisJobRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnalysisJobRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnalysisJobRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnalysisJobRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnalysisJobRespValidationError) ErrorName() string {
+	return "GetAnalysisJobRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnalysisJobRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnalysisJobResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnalysisJobRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnalysisJobRespValidationError{}
+
+// Validate checks the field values on ListAnalysisJobsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAnalysisJobsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAnalysisJobsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAnalysisJobsReqMultiError, or nil if none found.
+func (m *ListAnalysisJobsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAnalysisJobsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RepoId
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListAnalysisJobsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAnalysisJobsReqMultiError is an error wrapping multiple validation
+// errors returned by ListAnalysisJobsReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListAnalysisJobsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAnalysisJobsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAnalysisJobsReqMultiError) AllErrors() []error { return m }
+
+// ListAnalysisJobsReqValidationError is the validation error returned by
+// ListAnalysisJobsReq.Validate if the designated constraints aren't met.
+type ListAnalysisJobsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAnalysisJobsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAnalysisJobsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAnalysisJobsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAnalysisJobsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAnalysisJobsReqValidationError) ErrorName() string {
+	return "ListAnalysisJobsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAnalysisJobsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAnalysisJobsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAnalysisJobsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAnalysisJobsReqValidationError{}
+
+// Validate checks the field values on ListAnalysisJobsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAnalysisJobsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAnalysisJobsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAnalysisJobsRespMultiError, or nil if none found.
+func (m *ListAnalysisJobsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAnalysisJobsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAnalysisJobsRespValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAnalysisJobsRespValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAnalysisJobsRespValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAnalysisJobsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAnalysisJobsRespMultiError is an error wrapping multiple validation
+// errors returned by ListAnalysisJobsResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListAnalysisJobsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAnalysisJobsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAnalysisJobsRespMultiError) AllErrors() []error { return m }
+
+// ListAnalysisJobsRespValidationError is the validation error returned by
+// ListAnalysisJobsResp.Validate if the designated constraints aren't met.
+type ListAnalysisJobsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAnalysisJobsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAnalysisJobsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAnalysisJobsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAnalysisJobsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAnalysisJobsRespValidationError) ErrorName() string {
+	return "ListAnalysisJobsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAnalysisJobsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAnalysisJobsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAnalysisJobsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAnalysisJobsRespValidationError{}
+
+// Validate checks the field values on CancelAnalysisJobReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAnalysisJobReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAnalysisJobReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelAnalysisJobReqMultiError, or nil if none found.
+func (m *CancelAnalysisJobReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAnalysisJobReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelAnalysisJobReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelAnalysisJobReqMultiError is an error wrapping multiple validation
+// errors returned by CancelAnalysisJobReq.ValidateAll() if the designated
+// constraints aren't met.
+type CancelAnalysisJobReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAnalysisJobReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAnalysisJobReqMultiError) AllErrors() []error { return m }
+
+// CancelAnalysisJobReqValidationError is the validation error returned by
+// CancelAnalysisJobReq.Validate if the designated constraints aren't met.
+type CancelAnalysisJobReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAnalysisJobReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAnalysisJobReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAnalysisJobReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAnalysisJobReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAnalysisJobReqValidationError) ErrorName() string {
+	return "CancelAnalysisJobReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAnalysisJobReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAnalysisJobReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAnalysisJobReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAnalysisJobReqValidationError{}
+
+// Validate checks the field values on CancelAnalysisJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAnalysisJobResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAnalysisJobResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelAnalysisJobRespMultiError, or nil if none found.
+func (m *CancelAnalysisJobResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAnalysisJobResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelAnalysisJobRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelAnalysisJobRespMultiError is an error wrapping multiple validation
+// errors returned by CancelAnalysisJobResp.ValidateAll() if the designated
+// constraints aren't met.
+type CancelAnalysisJobRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAnalysisJobRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAnalysisJobRespMultiError) AllErrors() []error { return m }
+
+// CancelAnalysisJobRespValidationError is the validation error returned by
+// CancelAnalysisJobResp.Validate if the designated constraints aren't met.
+type CancelAnalysisJobRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAnalysisJobRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAnalysisJobRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAnalysisJobRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAnalysisJobRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAnalysisJobRespValidationError) ErrorName() string {
+	return "CancelAnalysisJobRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAnalysisJobRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAnalysisJobResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAnalysisJobRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAnalysisJobRespValidationError{}
+
+// Validate checks the field values on SearchSymbolsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchSymbolsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSymbolsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSymbolsReqMultiError, or nil if none found.
+func (m *SearchSymbolsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSymbolsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchSymbolsReqValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Mode
+
+	// no validation rules for Package
+
+	// no validation rules for ExportedOnly
+
+	// no validation rules for Snapshot
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchSymbolsReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for ExcludeTests
+
+	if len(errors) > 0 {
+		return SearchSymbolsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSymbolsReqMultiError is an error wrapping multiple validation errors
+// returned by SearchSymbolsReq.ValidateAll() if the designated constraints
+// aren't met.
+type SearchSymbolsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSymbolsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSymbolsReqMultiError) AllErrors() []error { return m }
+
+// SearchSymbolsReqValidationError is the validation error returned by
+// SearchSymbolsReq.Validate if the designated constraints aren't met.
+type SearchSymbolsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSymbolsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSymbolsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSymbolsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSymbolsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSymbolsReqValidationError) ErrorName() string { return "SearchSymbolsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchSymbolsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSymbolsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSymbolsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSymbolsReqValidationError{}
+
+// Validate checks the field values on SearchSymbolsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchSymbolsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSymbolsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSymbolsRespMultiError, or nil if none found.
+func (m *SearchSymbolsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSymbolsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSymbols() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchSymbolsRespValidationError{
+						field:  fmt.Sprintf("Symbols[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchSymbolsRespValidationError{
+						field:  fmt.Sprintf("Symbols[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchSymbolsRespValidationError{
+					field:  fmt.Sprintf("Symbols[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return SearchSymbolsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSymbolsRespMultiError is an error wrapping multiple validation errors
+// returned by SearchSymbolsResp.ValidateAll() if the designated constraints
+// aren't met.
+type SearchSymbolsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSymbolsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSymbolsRespMultiError) AllErrors() []error { return m }
+
+// SearchSymbolsRespValidationError is the validation error returned by
+// SearchSymbolsResp.Validate if the designated constraints aren't met.
+type SearchSymbolsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSymbolsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSymbolsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSymbolsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSymbolsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSymbolsRespValidationError) ErrorName() string {
+	return "SearchSymbolsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchSymbolsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSymbolsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSymbolsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSymbolsRespValidationError{}
+
+// Validate checks the field values on Symbol with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Symbol) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Symbol with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SymbolMultiError, or nil if none found.
+func (m *Symbol) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Symbol) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Kind
+
+	// no validation rules for ParentId
+
+	// no validation rules for Exported
+
+	// no validation rules for FanIn
+
+	// no validation rules for Score
+
+	// no validation rules for FileId
+
+	if all {
+		switch v := interface{}(m.GetSpan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SymbolValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SymbolValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SymbolValidationError{
+				field:  "Span",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Entrypoint
+
+	// no validation rules for Test
+
+	if len(errors) > 0 {
+		return SymbolMultiError(errors)
+	}
+
+	return nil
+}
+
+// SymbolMultiError is an error wrapping multiple validation errors returned by
+// Symbol.ValidateAll() if the designated constraints aren't met.
+type SymbolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SymbolMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SymbolMultiError) AllErrors() []error { return m }
+
+// SymbolValidationError is the validation error returned by Symbol.Validate if
+// the designated constraints aren't met.
+type SymbolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SymbolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SymbolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SymbolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SymbolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SymbolValidationError) ErrorName() string { return "SymbolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SymbolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSymbol.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SymbolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SymbolValidationError{}
+
+// Validate checks the field values on GetFunctionTestsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFunctionTestsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFunctionTestsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFunctionTestsReqMultiError, or nil if none found.
+func (m *GetFunctionTestsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFunctionTestsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetFunctionTestsReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Snapshot
+
+	if len(errors) > 0 {
+		return GetFunctionTestsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFunctionTestsReqMultiError is an error wrapping multiple validation
+// errors returned by GetFunctionTestsReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetFunctionTestsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFunctionTestsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFunctionTestsReqMultiError) AllErrors() []error { return m }
+
+// GetFunctionTestsReqValidationError is the validation error returned by
+// GetFunctionTestsReq.Validate if the designated constraints aren't met.
+type GetFunctionTestsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFunctionTestsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFunctionTestsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFunctionTestsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFunctionTestsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFunctionTestsReqValidationError) ErrorName() string {
+	return "GetFunctionTestsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFunctionTestsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFunctionTestsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFunctionTestsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFunctionTestsReqValidationError{}
+
+// Validate checks the field values on GetFunctionTestsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFunctionTestsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFunctionTestsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFunctionTestsRespMultiError, or nil if none found.
+func (m *GetFunctionTestsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFunctionTestsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Snapshot
+
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFunctionTestsRespValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFunctionTestsRespValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFunctionTestsRespValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFunctionTestsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFunctionTestsRespMultiError is an error wrapping multiple validation
+// errors returned by GetFunctionTestsResp.ValidateAll() if the designated
+// constraints aren't met.
+type GetFunctionTestsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFunctionTestsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFunctionTestsRespMultiError) AllErrors() []error { return m }
+
+// GetFunctionTestsRespValidationError is the validation error returned by
+// GetFunctionTestsResp.Validate if the designated constraints aren't met.
+type GetFunctionTestsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFunctionTestsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFunctionTestsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFunctionTestsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFunctionTestsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFunctionTestsRespValidationError) ErrorName() string {
+	return "GetFunctionTestsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFunctionTestsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFunctionTestsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFunctionTestsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFunctionTestsRespValidationError{}
+
+// Validate checks the field values on FunctionTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FunctionTest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FunctionTest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunctionTestMultiError, or
+// nil if none found.
+func (m *FunctionTest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FunctionTest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSymbol()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionTestValidationError{
+					field:  "Symbol",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionTestValidationError{
+					field:  "Symbol",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSymbol()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionTestValidationError{
+				field:  "Symbol",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Depth
+
+	if len(errors) > 0 {
+		return FunctionTestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FunctionTestMultiError is an error wrapping multiple validation errors
+// returned by FunctionTest.ValidateAll() if the designated constraints aren't met.
+type FunctionTestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionTestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionTestMultiError) AllErrors() []error { return m }
+
+// FunctionTestValidationError is the validation error returned by
+// FunctionTest.Validate if the designated constraints aren't met.
+type FunctionTestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionTestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionTestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionTestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionTestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionTestValidationError) ErrorName() string { return "FunctionTestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionTestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunctionTest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionTestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionTestValidationError{}
+
+// Validate checks the field values on ResolveSymbolAtReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveSymbolAtReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveSymbolAtReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveSymbolAtReqMultiError, or nil if none found.
+func (m *ResolveSymbolAtReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveSymbolAtReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := ResolveSymbolAtReqValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFileId()) < 1 {
+		err := ResolveSymbolAtReqValidationError{
+			field:  "FileId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLine() < 1 {
+		err := ResolveSymbolAtReqValidationError{
+			field:  "Line",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetColumn() < 1 {
+		err := ResolveSymbolAtReqValidationError{
+			field:  "Column",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Snapshot
+
+	if len(errors) > 0 {
+		return ResolveSymbolAtReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveSymbolAtReqMultiError is an error wrapping multiple validation errors
+// returned by ResolveSymbolAtReq.ValidateAll() if the designated constraints
+// aren't met.
+type ResolveSymbolAtReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveSymbolAtReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveSymbolAtReqMultiError) AllErrors() []error { return m }
+
+// ResolveSymbolAtReqValidationError is the validation error returned by
+// ResolveSymbolAtReq.Validate if the designated constraints aren't met.
+type ResolveSymbolAtReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveSymbolAtReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveSymbolAtReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveSymbolAtReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveSymbolAtReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveSymbolAtReqValidationError) ErrorName() string {
+	return "ResolveSymbolAtReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveSymbolAtReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveSymbolAtReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveSymbolAtReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveSymbolAtReqValidationError{}
+
+// Validate checks the field values on ResolveSymbolAtResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveSymbolAtResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveSymbolAtResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveSymbolAtRespMultiError, or nil if none found.
+func (m *ResolveSymbolAtResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveSymbolAtResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetSpan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveSymbolAtRespValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveSymbolAtRespValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveSymbolAtRespValidationError{
+				field:  "Span",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveSymbolAtRespValidationError{
+					field:  "Definition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveSymbolAtRespValidationError{
+					field:  "Definition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveSymbolAtRespValidationError{
+				field:  "Definition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCandidates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResolveSymbolAtRespValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResolveSymbolAtRespValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResolveSymbolAtRespValidationError{
+					field:  fmt.Sprintf("Candidates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResolveSymbolAtRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveSymbolAtRespMultiError is an error wrapping multiple validation
+// errors returned by ResolveSymbolAtResp.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveSymbolAtRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveSymbolAtRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveSymbolAtRespMultiError) AllErrors() []error { return m }
+
+// ResolveSymbolAtRespValidationError is the validation error returned by
+// ResolveSymbolAtResp.Validate if the designated constraints aren't met.
+type ResolveSymbolAtRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveSymbolAtRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveSymbolAtRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveSymbolAtRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveSymbolAtRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveSymbolAtRespValidationError) ErrorName() string {
+	return "ResolveSymbolAtRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveSymbolAtRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveSymbolAtResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveSymbolAtRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveSymbolAtRespValidationError{}
+
+// Validate checks the field values on FindReferencesReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FindReferencesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindReferencesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindReferencesReqMultiError, or nil if none found.
+func (m *FindReferencesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindReferencesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := FindReferencesReqValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := FindReferencesReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Snapshot
+
+	// no validation rules for IncludeDeclaration
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := FindReferencesReqValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindReferencesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindReferencesReqMultiError is an error wrapping multiple validation errors
+// returned by FindReferencesReq.ValidateAll() if the designated constraints
+// aren't met.
+type FindReferencesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindReferencesReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindReferencesReqMultiError) AllErrors() []error { return m }
+
+// FindReferencesReqValidationError is the validation error returned by
+// FindReferencesReq.Validate if the designated constraints aren't met.
+type FindReferencesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindReferencesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindReferencesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindReferencesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindReferencesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindReferencesReqValidationError) ErrorName() string {
+	return "FindReferencesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindReferencesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindReferencesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindReferencesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindReferencesReqValidationError{}
+
+// Validate checks the field values on FindReferencesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindReferencesResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindReferencesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindReferencesRespMultiError, or nil if none found.
+func (m *FindReferencesResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindReferencesResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FindReferencesRespValidationError{
+					field:  "Definition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FindReferencesRespValidationError{
+					field:  "Definition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindReferencesRespValidationError{
+				field:  "Definition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FindReferencesRespValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FindReferencesRespValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindReferencesRespValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return FindReferencesRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindReferencesRespMultiError is an error wrapping multiple validation errors
+// returned by FindReferencesResp.ValidateAll() if the designated constraints
+// aren't met.
+type FindReferencesRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindReferencesRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindReferencesRespMultiError) AllErrors() []error { return m }
+
+// FindReferencesRespValidationError is the validation error returned by
+// FindReferencesResp.Validate if the designated constraints aren't met.
+type FindReferencesRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindReferencesRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindReferencesRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindReferencesRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindReferencesRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindReferencesRespValidationError) ErrorName() string {
+	return "FindReferencesRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindReferencesRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindReferencesResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindReferencesRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindReferencesRespValidationError{}
+
+// Validate checks the field values on Reference with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reference with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReferenceMultiError, or nil
+// if none found.
+func (m *Reference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	if all {
+		switch v := interface{}(m.GetSpan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReferenceValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReferenceValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReferenceValidationError{
+				field:  "Span",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for EnclosingId
+
+	if len(errors) > 0 {
+		return ReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReferenceMultiError is an error wrapping multiple validation errors returned
+// by Reference.ValidateAll() if the designated constraints aren't met.
+type ReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReferenceMultiError) AllErrors() []error { return m }
+
+// ReferenceValidationError is the validation error returned by
+// Reference.Validate if the designated constraints aren't met.
+type ReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReferenceValidationError) ErrorName() string { return "ReferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReferenceValidationError{}
+
+// Validate checks the field values on AnalyzeImpactReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeImpactReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeImpactReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeImpactReqMultiError, or nil if none found.
+func (m *AnalyzeImpactReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeImpactReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRepoId()) < 1 {
+		err := AnalyzeImpactReqValidationError{
+			field:  "RepoId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Snapshot
+
+	// no validation rules for Diff
+
+	if val := m.GetMaxDepth(); val < 0 || val > 20 {
+		err := AnalyzeImpactReqValidationError{
+			field:  "MaxDepth",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxNodes(); val < 0 || val > 10000 {
+		err := AnalyzeImpactReqValidationError{
+			field:  "MaxNodes",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AnalyzeImpactReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeImpactReqMultiError is an error wrapping multiple validation errors
+// returned by AnalyzeImpactReq.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzeImpactReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeImpactReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeImpactReqMultiError) AllErrors() []error { return m }
+
+// AnalyzeImpactReqValidationError is the validation error returned by
+// AnalyzeImpactReq.Validate if the designated constraints aren't met.
+type AnalyzeImpactReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeImpactReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeImpactReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeImpactReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeImpactReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeImpactReqValidationError) ErrorName() string { return "AnalyzeImpactReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnalyzeImpactReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeImpactReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeImpactReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeImpactReqValidationError{}
+
+// Validate checks the field values on AnalyzeImpactResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeImpactResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeImpactResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeImpactRespMultiError, or nil if none found.
+func (m *AnalyzeImpactResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeImpactResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Snapshot
+
+	for idx, item := range m.GetSymbols() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Symbols[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Symbols[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeImpactRespValidationError{
+					field:  fmt.Sprintf("Symbols[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeImpactRespValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExported() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Exported[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Exported[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeImpactRespValidationError{
+					field:  fmt.Sprintf("Exported[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEntrypoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Entrypoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Entrypoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeImpactRespValidationError{
+					field:  fmt.Sprintf("Entrypoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeImpactRespValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeImpactRespValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return AnalyzeImpactRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeImpactRespMultiError is an error wrapping multiple validation errors
+// returned by AnalyzeImpactResp.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzeImpactRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeImpactRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeImpactRespMultiError) AllErrors() []error { return m }
+
+// AnalyzeImpactRespValidationError is the validation error returned by
+// AnalyzeImpactResp.Validate if the designated constraints aren't met.
+type AnalyzeImpactRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeImpactRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeImpactRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeImpactRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeImpactRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeImpactRespValidationError) ErrorName() string {
+	return "AnalyzeImpactRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzeImpactRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeImpactResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeImpactRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeImpactRespValidationError{}
+
+// Validate checks the field values on ImpactedSymbol with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImpactedSymbol) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpactedSymbol with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImpactedSymbolMultiError,
+// or nil if none found.
+func (m *ImpactedSymbol) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpactedSymbol) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSymbol()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImpactedSymbolValidationError{
+					field:  "Symbol",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImpactedSymbolValidationError{
+					field:  "Symbol",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSymbol()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImpactedSymbolValidationError{
+				field:  "Symbol",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Distance
+
+	// no validation rules for Via
+
+	// no validation rules for Relation
+
+	// no validation rules for Test
+
+	if len(errors) > 0 {
+		return ImpactedSymbolMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpactedSymbolMultiError is an error wrapping multiple validation errors
+// returned by ImpactedSymbol.ValidateAll() if the designated constraints
+// aren't met.
+type ImpactedSymbolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpactedSymbolMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpactedSymbolMultiError) AllErrors() []error { return m }
+
+// ImpactedSymbolValidationError is the validation error returned by
+// ImpactedSymbol.Validate if the designated constraints aren't met.
+type ImpactedSymbolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpactedSymbolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpactedSymbolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpactedSymbolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpactedSymbolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpactedSymbolValidationError) ErrorName() string { return "ImpactedSymbolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImpactedSymbolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpactedSymbol.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpactedSymbolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpactedSymbolValidationError{}
+
+// Validate checks the field values on ImpactedPackage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImpactedPackage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpactedPackage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImpactedPackageMultiError, or nil if none found.
+func (m *ImpactedPackage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpactedPackage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Distance
+
+	// no validation rules for Symbols
+
+	if len(errors) > 0 {
+		return ImpactedPackageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpactedPackageMultiError is an error wrapping multiple validation errors
+// returned by ImpactedPackage.ValidateAll() if the designated constraints
+// aren't met.
+type ImpactedPackageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpactedPackageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpactedPackageMultiError) AllErrors() []error { return m }
+
+// ImpactedPackageValidationError is the validation error returned by
+// ImpactedPackage.Validate if the designated constraints aren't met.
+type ImpactedPackageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpactedPackageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpactedPackageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpactedPackageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpactedPackageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpactedPackageValidationError) ErrorName() string { return "ImpactedPackageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImpactedPackageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpactedPackage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpactedPackageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpactedPackageValidationError{}
+
+// Validate checks the field values on GetRepoTreeReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRepoTreeReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoTreeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRepoTreeReqMultiError,
+// or nil if none found.
+func (m *GetRepoTreeReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoTreeReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Snapshot
+
+	if len(errors) > 0 {
+		return GetRepoTreeReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoTreeReqMultiError is an error wrapping multiple validation errors
+// returned by GetRepoTreeReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoTreeReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoTreeReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoTreeReqMultiError) AllErrors() []error { return m }
+
+// GetRepoTreeReqValidationError is the validation error returned by
+// GetRepoTreeReq.Validate if the designated constraints aren't met.
+type GetRepoTreeReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoTreeReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoTreeReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoTreeReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoTreeReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoTreeReqValidationError) ErrorName() string { return "GetRepoTreeReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoTreeReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoTreeReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoTreeReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoTreeReqValidationError{}
+
+// Validate checks the field values on GetRepoTreeResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRepoTreeResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoTreeResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoTreeRespMultiError, or nil if none found.
+func (m *GetRepoTreeResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoTreeResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRepoTreeRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRepoTreeRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRepoTreeRespValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRepoTreeRespValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRepoTreeRespValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRepoTreeRespValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRepoTreeRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoTreeRespMultiError is an error wrapping multiple validation errors
+// returned by GetRepoTreeResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoTreeRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoTreeRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoTreeRespMultiError) AllErrors() []error { return m }
+
+// GetRepoTreeRespValidationError is the validation error returned by
+// GetRepoTreeResp.Validate if the designated constraints aren't met.
+type GetRepoTreeRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoTreeRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoTreeRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoTreeRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoTreeRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoTreeRespValidationError) ErrorName() string { return "GetRepoTreeRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoTreeRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoTreeResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoTreeRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoTreeRespValidationError{}
+
+// Validate checks the field values on GetPackageDependenciesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPackageDependenciesReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReposReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListReposReqMultiError, or
-// nil if none found.
-func (m *ListReposReq) ValidateAll() error {
+// ValidateAll checks the field values on GetPackageDependenciesReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPackageDependenciesReqMultiError, or nil if none found.
+func (m *GetPackageDependenciesReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReposReq) validate(all bool) error {
+func (m *GetPackageDependenciesReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Package
+
+	// no validation rules for Snapshot
+
 	if len(errors) > 0 {
-		return ListReposReqMultiError(errors)
+		return GetPackageDependenciesReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReposReqMultiError is an error wrapping multiple validation errors
-// returned by ListReposReq.ValidateAll() if the designated constraints aren't met.
-type ListReposReqMultiError []error
+// GetPackageDependenciesReqMultiError is an error wrapping multiple validation
+// errors returned by GetPackageDependenciesReq.ValidateAll() if the
+// designated constraints aren't met.
+type GetPackageDependenciesReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReposReqMultiError) Error() string {
+func (m GetPackageDependenciesReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1006,11 +7857,11 @@ func (m ListReposReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReposReqMultiError) AllErrors() []error { return m }
+func (m GetPackageDependenciesReqMultiError) AllErrors() []error { return m }
 
-// ListReposReqValidationError is the validation error returned by
-// ListReposReq.Validate if the designated constraints aren't met.
-type ListReposReqValidationError struct {
+// GetPackageDependenciesReqValidationError is the validation error returned by
+// GetPackageDependenciesReq.Validate if the designated constraints aren't met.
+type GetPackageDependenciesReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1018,22 +7869,24 @@ type ListReposReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReposReqValidationError) Field() string { return e.field }
+func (e GetPackageDependenciesReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReposReqValidationError) Reason() string { return e.reason }
+func (e GetPackageDependenciesReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReposReqValidationError) Cause() error { return e.cause }
+func (e GetPackageDependenciesReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReposReqValidationError) Key() bool { return e.key }
+func (e GetPackageDependenciesReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReposReqValidationError) ErrorName() string { return "ListReposReqValidationError" }
+func (e GetPackageDependenciesReqValidationError) ErrorName() string {
+	return "GetPackageDependenciesReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListReposReqValidationError) Error() string {
+func (e GetPackageDependenciesReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1045,14 +7898,14 @@ func (e ListReposReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReposReq.%s: %s%s",
+		"invalid %sGetPackageDependenciesReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReposReqValidationError{}
+var _ error = GetPackageDependenciesReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1060,47 +7913,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReposReqValidationError{}
+} = GetPackageDependenciesReqValidationError{}
 
-// Validate checks the field values on ListReposResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ListReposResp) Validate() error {
+// Validate checks the field values on GetPackageDependenciesResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPackageDependenciesResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReposResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListReposRespMultiError, or
-// nil if none found.
-func (m *ListReposResp) ValidateAll() error {
+// ValidateAll checks the field values on GetPackageDependenciesResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPackageDependenciesRespMultiError, or nil if none found.
+func (m *GetPackageDependenciesResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReposResp) validate(all bool) error {
+func (m *GetPackageDependenciesResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRepos() {
+	for idx, item := range m.GetPackages() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListReposRespValidationError{
-						field:  fmt.Sprintf("Repos[%v]", idx),
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListReposRespValidationError{
-						field:  fmt.Sprintf("Repos[%v]", idx),
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1108,8 +7961,76 @@ func (m *ListReposResp) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListReposRespValidationError{
-					field:  fmt.Sprintf("Repos[%v]", idx),
+				return GetPackageDependenciesRespValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDependencies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Dependencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Dependencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPackageDependenciesRespValidationError{
+					field:  fmt.Sprintf("Dependencies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCycles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPackageDependenciesRespValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPackageDependenciesRespValidationError{
+					field:  fmt.Sprintf("Cycles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1119,19 +8040,19 @@ func (m *ListReposResp) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListReposRespMultiError(errors)
+		return GetPackageDependenciesRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReposRespMultiError is an error wrapping multiple validation errors
-// returned by ListReposResp.ValidateAll() if the designated constraints
-// aren't met.
-type ListReposRespMultiError []error
+// GetPackageDependenciesRespMultiError is an error wrapping multiple
+// validation errors returned by GetPackageDependenciesResp.ValidateAll() if
+// the designated constraints aren't met.
+type GetPackageDependenciesRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReposRespMultiError) Error() string {
+func (m GetPackageDependenciesRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1140,11 +8061,11 @@ func (m ListReposRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReposRespMultiError) AllErrors() []error { return m }
+func (m GetPackageDependenciesRespMultiError) AllErrors() []error { return m }
 
-// ListReposRespValidationError is the validation error returned by
-// ListReposResp.Validate if the designated constraints aren't met.
-type ListReposRespValidationError struct {
+// GetPackageDependenciesRespValidationError is the validation error returned
+// by GetPackageDependenciesResp.Validate if the designated constraints aren't met.
+type GetPackageDependenciesRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1152,22 +8073,24 @@ type ListReposRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReposRespValidationError) Field() string { return e.field }
+func (e GetPackageDependenciesRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReposRespValidationError) Reason() string { return e.reason }
+func (e GetPackageDependenciesRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReposRespValidationError) Cause() error { return e.cause }
+func (e GetPackageDependenciesRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReposRespValidationError) Key() bool { return e.key }
+func (e GetPackageDependenciesRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReposRespValidationError) ErrorName() string { return "ListReposRespValidationError" }
+func (e GetPackageDependenciesRespValidationError) ErrorName() string {
+	return "GetPackageDependenciesRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListReposRespValidationError) Error() string {
+func (e GetPackageDependenciesRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1179,14 +8102,14 @@ func (e ListReposRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReposResp.%s: %s%s",
+		"invalid %sGetPackageDependenciesResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReposRespValidationError{}
+var _ error = GetPackageDependenciesRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1194,45 +8117,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReposRespValidationError{}
+} = GetPackageDependenciesRespValidationError{}
 
-// Validate checks the field values on GetRepoReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetRepoReq) Validate() error {
+// Validate checks the field values on PackageDependency with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PackageDependency) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetRepoReqMultiError, or
-// nil if none found.
-func (m *GetRepoReq) ValidateAll() error {
+// ValidateAll checks the field values on PackageDependency with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PackageDependencyMultiError, or nil if none found.
+func (m *PackageDependency) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoReq) validate(all bool) error {
+func (m *PackageDependency) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Source
+
+	// no validation rules for Target
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return GetRepoReqMultiError(errors)
+		return PackageDependencyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoReqMultiError is an error wrapping multiple validation errors
-// returned by GetRepoReq.ValidateAll() if the designated constraints aren't met.
-type GetRepoReqMultiError []error
+// PackageDependencyMultiError is an error wrapping multiple validation errors
+// returned by PackageDependency.ValidateAll() if the designated constraints
+// aren't met.
+type PackageDependencyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoReqMultiError) Error() string {
+func (m PackageDependencyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1241,11 +8169,11 @@ func (m GetRepoReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoReqMultiError) AllErrors() []error { return m }
+func (m PackageDependencyMultiError) AllErrors() []error { return m }
 
-// GetRepoReqValidationError is the validation error returned by
-// GetRepoReq.Validate if the designated constraints aren't met.
-type GetRepoReqValidationError struct {
+// PackageDependencyValidationError is the validation error returned by
+// PackageDependency.Validate if the designated constraints aren't met.
+type PackageDependencyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1253,22 +8181,24 @@ type GetRepoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoReqValidationError) Field() string { return e.field }
+func (e PackageDependencyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoReqValidationError) Reason() string { return e.reason }
+func (e PackageDependencyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoReqValidationError) Cause() error { return e.cause }
+func (e PackageDependencyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoReqValidationError) Key() bool { return e.key }
+func (e PackageDependencyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoReqValidationError) ErrorName() string { return "GetRepoReqValidationError" }
+func (e PackageDependencyValidationError) ErrorName() string {
+	return "PackageDependencyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRepoReqValidationError) Error() string {
+func (e PackageDependencyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1280,14 +8210,14 @@ func (e GetRepoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoReq.%s: %s%s",
+		"invalid %sPackageDependency.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoReqValidationError{}
+var _ error = PackageDependencyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1295,72 +8225,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoReqValidationError{}
+} = PackageDependencyValidationError{}
 
-// Validate checks the field values on GetRepoResp with the rules defined in
+// Validate checks the field values on PackageCycle with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetRepoResp) Validate() error {
+func (m *PackageCycle) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetRepoRespMultiError, or
+// ValidateAll checks the field values on PackageCycle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackageCycleMultiError, or
 // nil if none found.
-func (m *GetRepoResp) ValidateAll() error {
+func (m *PackageCycle) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoResp) validate(all bool) error {
+func (m *PackageCycle) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRepo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRepoRespValidationError{
-					field:  "Repo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRepoRespValidationError{
-					field:  "Repo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRepo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRepoRespValidationError{
-				field:  "Repo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetRepoRespMultiError(errors)
+		return PackageCycleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoRespMultiError is an error wrapping multiple validation errors
-// returned by GetRepoResp.ValidateAll() if the designated constraints aren't met.
-type GetRepoRespMultiError []error
+// PackageCycleMultiError is an error wrapping multiple validation errors
+// returned by PackageCycle.ValidateAll() if the designated constraints aren't met.
+type PackageCycleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoRespMultiError) Error() string {
+func (m PackageCycleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1369,11 +8270,11 @@ func (m GetRepoRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoRespMultiError) AllErrors() []error { return m }
+func (m PackageCycleMultiError) AllErrors() []error { return m }
 
-// GetRepoRespValidationError is the validation error returned by
-// GetRepoResp.Validate if the designated constraints aren't met.
-type GetRepoRespValidationError struct {
+// PackageCycleValidationError is the validation error returned by
+// PackageCycle.Validate if the designated constraints aren't met.
+type PackageCycleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1381,22 +8282,22 @@ type GetRepoRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoRespValidationError) Field() string { return e.field }
+func (e PackageCycleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoRespValidationError) Reason() string { return e.reason }
+func (e PackageCycleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoRespValidationError) Cause() error { return e.cause }
+func (e PackageCycleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoRespValidationError) Key() bool { return e.key }
+func (e PackageCycleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoRespValidationError) ErrorName() string { return "GetRepoRespValidationError" }
+func (e PackageCycleValidationError) ErrorName() string { return "PackageCycleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRepoRespValidationError) Error() string {
+func (e PackageCycleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1408,14 +8309,14 @@ func (e GetRepoRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoResp.%s: %s%s",
+		"invalid %sPackageCycle.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoRespValidationError{}
+var _ error = PackageCycleValidationError{}
 
 var _ interface {
 	Field() string
@@ -1423,46 +8324,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoRespValidationError{}
+} = PackageCycleValidationError{}
 
-// Validate checks the field values on DeleteRepoReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteRepoReq) Validate() error {
+// Validate checks the field values on ArchitectureRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArchitectureRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRepoReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRepoReqMultiError, or
-// nil if none found.
-func (m *DeleteRepoReq) ValidateAll() error {
+// ValidateAll checks the field values on ArchitectureRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchitectureRuleMultiError, or nil if none found.
+func (m *ArchitectureRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRepoReq) validate(all bool) error {
+func (m *ArchitectureRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := ArchitectureRuleValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Kind
+
+	if l := utf8.RuneCountInString(m.GetFrom()); l < 1 || l > 256 {
+		err := ArchitectureRuleValidationError{
+			field:  "From",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTo()); l < 1 || l > 256 {
+		err := ArchitectureRuleValidationError{
+			field:  "To",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Id
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return DeleteRepoReqMultiError(errors)
+		return ArchitectureRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRepoReqMultiError is an error wrapping multiple validation errors
-// returned by DeleteRepoReq.ValidateAll() if the designated constraints
+// ArchitectureRuleMultiError is an error wrapping multiple validation errors
+// returned by ArchitectureRule.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteRepoReqMultiError []error
+type ArchitectureRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRepoReqMultiError) Error() string {
+func (m ArchitectureRuleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1471,11 +8407,11 @@ func (m DeleteRepoReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRepoReqMultiError) AllErrors() []error { return m }
+func (m ArchitectureRuleMultiError) AllErrors() []error { return m }
 
-// DeleteRepoReqValidationError is the validation error returned by
-// DeleteRepoReq.Validate if the designated constraints aren't met.
-type DeleteRepoReqValidationError struct {
+// ArchitectureRuleValidationError is the validation error returned by
+// ArchitectureRule.Validate if the designated constraints aren't met.
+type ArchitectureRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1483,22 +8419,22 @@ type DeleteRepoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRepoReqValidationError) Field() string { return e.field }
+func (e ArchitectureRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRepoReqValidationError) Reason() string { return e.reason }
+func (e ArchitectureRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRepoReqValidationError) Cause() error { return e.cause }
+func (e ArchitectureRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRepoReqValidationError) Key() bool { return e.key }
+func (e ArchitectureRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRepoReqValidationError) ErrorName() string { return "DeleteRepoReqValidationError" }
+func (e ArchitectureRuleValidationError) ErrorName() string { return "ArchitectureRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteRepoReqValidationError) Error() string {
+func (e ArchitectureRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1510,14 +8446,14 @@ func (e DeleteRepoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRepoReq.%s: %s%s",
+		"invalid %sArchitectureRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRepoReqValidationError{}
+var _ error = ArchitectureRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -1525,44 +8461,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRepoReqValidationError{}
+} = ArchitectureRuleValidationError{}
 
-// Validate checks the field values on DeleteRepoResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteRepoResp) Validate() error {
+// Validate checks the field values on ArchitectureRules with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArchitectureRules) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRepoResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRepoRespMultiError,
-// or nil if none found.
-func (m *DeleteRepoResp) ValidateAll() error {
+// ValidateAll checks the field values on ArchitectureRules with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchitectureRulesMultiError, or nil if none found.
+func (m *ArchitectureRules) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRepoResp) validate(all bool) error {
+func (m *ArchitectureRules) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ArchitectureRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ArchitectureRulesValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ArchitectureRulesValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteRepoRespMultiError(errors)
+		return ArchitectureRulesMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRepoRespMultiError is an error wrapping multiple validation errors
-// returned by DeleteRepoResp.ValidateAll() if the designated constraints
+// ArchitectureRulesMultiError is an error wrapping multiple validation errors
+// returned by ArchitectureRules.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteRepoRespMultiError []error
+type ArchitectureRulesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRepoRespMultiError) Error() string {
+func (m ArchitectureRulesMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1571,11 +8541,11 @@ func (m DeleteRepoRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRepoRespMultiError) AllErrors() []error { return m }
+func (m ArchitectureRulesMultiError) AllErrors() []error { return m }
 
-// DeleteRepoRespValidationError is the validation error returned by
-// DeleteRepoResp.Validate if the designated constraints aren't met.
-type DeleteRepoRespValidationError struct {
+// ArchitectureRulesValidationError is the validation error returned by
+// ArchitectureRules.Validate if the designated constraints aren't met.
+type ArchitectureRulesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1583,22 +8553,24 @@ type DeleteRepoRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRepoRespValidationError) Field() string { return e.field }
+func (e ArchitectureRulesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRepoRespValidationError) Reason() string { return e.reason }
+func (e ArchitectureRulesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRepoRespValidationError) Cause() error { return e.cause }
+func (e ArchitectureRulesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRepoRespValidationError) Key() bool { return e.key }
+func (e ArchitectureRulesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRepoRespValidationError) ErrorName() string { return "DeleteRepoRespValidationError" }
+func (e ArchitectureRulesValidationError) ErrorName() string {
+	return "ArchitectureRulesValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteRepoRespValidationError) Error() string {
+func (e ArchitectureRulesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1610,14 +8582,14 @@ func (e DeleteRepoRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRepoResp.%s: %s%s",
+		"invalid %sArchitectureRules.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRepoRespValidationError{}
+var _ error = ArchitectureRulesValidationError{}
 
 var _ interface {
 	Field() string
@@ -1625,46 +8597,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRepoRespValidationError{}
+} = ArchitectureRulesValidationError{}
 
-// Validate checks the field values on AnalyzeRepoReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AnalyzeRepoReq) Validate() error {
+// Validate checks the field values on ArchitectureViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchitectureViolation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzeRepoReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AnalyzeRepoReqMultiError,
-// or nil if none found.
-func (m *AnalyzeRepoReq) ValidateAll() error {
+// ValidateAll checks the field values on ArchitectureViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchitectureViolationMultiError, or nil if none found.
+func (m *ArchitectureViolation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzeRepoReq) validate(all bool) error {
+func (m *ArchitectureViolation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Rule
+
+	// no validation rules for Kind
+
+	// no validation rules for Source
+
+	// no validation rules for Target
+
+	// no validation rules for SourcePath
+
+	// no validation rules for TargetPath
 
 	if len(errors) > 0 {
-		return AnalyzeRepoReqMultiError(errors)
+		return ArchitectureViolationMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzeRepoReqMultiError is an error wrapping multiple validation errors
-// returned by AnalyzeRepoReq.ValidateAll() if the designated constraints
-// aren't met.
-type AnalyzeRepoReqMultiError []error
+// ArchitectureViolationMultiError is an error wrapping multiple validation
+// errors returned by ArchitectureViolation.ValidateAll() if the designated
+// constraints aren't met.
+type ArchitectureViolationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzeRepoReqMultiError) Error() string {
+func (m ArchitectureViolationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1673,11 +8655,11 @@ func (m AnalyzeRepoReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzeRepoReqMultiError) AllErrors() []error { return m }
+func (m ArchitectureViolationMultiError) AllErrors() []error { return m }
 
-// AnalyzeRepoReqValidationError is the validation error returned by
-// AnalyzeRepoReq.Validate if the designated constraints aren't met.
-type AnalyzeRepoReqValidationError struct {
+// ArchitectureViolationValidationError is the validation error returned by
+// ArchitectureViolation.Validate if the designated constraints aren't met.
+type ArchitectureViolationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1685,22 +8667,24 @@ type AnalyzeRepoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzeRepoReqValidationError) Field() string { return e.field }
+func (e ArchitectureViolationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzeRepoReqValidationError) Reason() string { return e.reason }
+func (e ArchitectureViolationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzeRepoReqValidationError) Cause() error { return e.cause }
+func (e ArchitectureViolationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzeRepoReqValidationError) Key() bool { return e.key }
+func (e ArchitectureViolationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzeRepoReqValidationError) ErrorName() string { return "AnalyzeRepoReqValidationError" }
+func (e ArchitectureViolationValidationError) ErrorName() string {
+	return "ArchitectureViolationValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AnalyzeRepoReqValidationError) Error() string {
+func (e ArchitectureViolationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1712,14 +8696,14 @@ func (e AnalyzeRepoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzeRepoReq.%s: %s%s",
+		"invalid %sArchitectureViolation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzeRepoReqValidationError{}
+var _ error = ArchitectureViolationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1727,24 +8711,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzeRepoReqValidationError{}
+} = ArchitectureViolationValidationError{}
 
-// Validate checks the field values on GetRepoTreeReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetRepoTreeReq) Validate() error {
+// Validate checks the field values on GetArchitectureViolationsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArchitectureViolationsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoTreeReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetRepoTreeReqMultiError,
-// or nil if none found.
-func (m *GetRepoTreeReq) ValidateAll() error {
+// ValidateAll checks the field values on GetArchitectureViolationsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArchitectureViolationsReqMultiError, or nil if none found.
+func (m *GetArchitectureViolationsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoTreeReq) validate(all bool) error {
+func (m *GetArchitectureViolationsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1753,20 +8737,22 @@ func (m *GetRepoTreeReq) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Snapshot
+
 	if len(errors) > 0 {
-		return GetRepoTreeReqMultiError(errors)
+		return GetArchitectureViolationsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoTreeReqMultiError is an error wrapping multiple validation errors
-// returned by GetRepoTreeReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoTreeReqMultiError []error
+// GetArchitectureViolationsReqMultiError is an error wrapping multiple
+// validation errors returned by GetArchitectureViolationsReq.ValidateAll() if
+// the designated constraints aren't met.
+type GetArchitectureViolationsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoTreeReqMultiError) Error() string {
+func (m GetArchitectureViolationsReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1775,11 +8761,12 @@ func (m GetRepoTreeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoTreeReqMultiError) AllErrors() []error { return m }
+func (m GetArchitectureViolationsReqMultiError) AllErrors() []error { return m }
 
-// GetRepoTreeReqValidationError is the validation error returned by
-// GetRepoTreeReq.Validate if the designated constraints aren't met.
-type GetRepoTreeReqValidationError struct {
+// GetArchitectureViolationsReqValidationError is the validation error returned
+// by GetArchitectureViolationsReq.Validate if the designated constraints
+// aren't met.
+type GetArchitectureViolationsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1787,22 +8774,24 @@ type GetRepoTreeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoTreeReqValidationError) Field() string { return e.field }
+func (e GetArchitectureViolationsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoTreeReqValidationError) Reason() string { return e.reason }
+func (e GetArchitectureViolationsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoTreeReqValidationError) Cause() error { return e.cause }
+func (e GetArchitectureViolationsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoTreeReqValidationError) Key() bool { return e.key }
+func (e GetArchitectureViolationsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoTreeReqValidationError) ErrorName() string { return "GetRepoTreeReqValidationError" }
+func (e GetArchitectureViolationsReqValidationError) ErrorName() string {
+	return "GetArchitectureViolationsReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRepoTreeReqValidationError) Error() string {
+func (e GetArchitectureViolationsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1814,14 +8803,14 @@ func (e GetRepoTreeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoTreeReq.%s: %s%s",
+		"invalid %sGetArchitectureViolationsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoTreeReqValidationError{}
+var _ error = GetArchitectureViolationsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1829,81 +8818,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoTreeReqValidationError{}
+} = GetArchitectureViolationsReqValidationError{}
 
-// Validate checks the field values on GetRepoTreeResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoTreeResp) Validate() error {
+// Validate checks the field values on GetArchitectureViolationsResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArchitectureViolationsResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoTreeResp with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRepoTreeRespMultiError, or nil if none found.
-func (m *GetRepoTreeResp) ValidateAll() error {
+// ValidateAll checks the field values on GetArchitectureViolationsResp with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetArchitectureViolationsRespMultiError, or nil if none found.
+func (m *GetArchitectureViolationsResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoTreeResp) validate(all bool) error {
+func (m *GetArchitectureViolationsResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPackages() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetRepoTreeRespValidationError{
-						field:  fmt.Sprintf("Packages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetRepoTreeRespValidationError{
-						field:  fmt.Sprintf("Packages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetRepoTreeRespValidationError{
-					field:  fmt.Sprintf("Packages[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Snapshot
 
-	for idx, item := range m.GetFiles() {
+	for idx, item := range m.GetViolations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetRepoTreeRespValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, GetArchitectureViolationsRespValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetRepoTreeRespValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, GetArchitectureViolationsRespValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1911,8 +8868,8 @@ func (m *GetRepoTreeResp) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetRepoTreeRespValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
+				return GetArchitectureViolationsRespValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1922,19 +8879,19 @@ func (m *GetRepoTreeResp) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetRepoTreeRespMultiError(errors)
+		return GetArchitectureViolationsRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoTreeRespMultiError is an error wrapping multiple validation errors
-// returned by GetRepoTreeResp.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoTreeRespMultiError []error
+// GetArchitectureViolationsRespMultiError is an error wrapping multiple
+// validation errors returned by GetArchitectureViolationsResp.ValidateAll()
+// if the designated constraints aren't met.
+type GetArchitectureViolationsRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoTreeRespMultiError) Error() string {
+func (m GetArchitectureViolationsRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1943,11 +8900,12 @@ func (m GetRepoTreeRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoTreeRespMultiError) AllErrors() []error { return m }
+func (m GetArchitectureViolationsRespMultiError) AllErrors() []error { return m }
 
-// GetRepoTreeRespValidationError is the validation error returned by
-// GetRepoTreeResp.Validate if the designated constraints aren't met.
-type GetRepoTreeRespValidationError struct {
+// GetArchitectureViolationsRespValidationError is the validation error
+// returned by GetArchitectureViolationsResp.Validate if the designated
+// constraints aren't met.
+type GetArchitectureViolationsRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1955,22 +8913,24 @@ type GetRepoTreeRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoTreeRespValidationError) Field() string { return e.field }
+func (e GetArchitectureViolationsRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoTreeRespValidationError) Reason() string { return e.reason }
+func (e GetArchitectureViolationsRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoTreeRespValidationError) Cause() error { return e.cause }
+func (e GetArchitectureViolationsRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoTreeRespValidationError) Key() bool { return e.key }
+func (e GetArchitectureViolationsRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoTreeRespValidationError) ErrorName() string { return "GetRepoTreeRespValidationError" }
+func (e GetArchitectureViolationsRespValidationError) ErrorName() string {
+	return "GetArchitectureViolationsRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRepoTreeRespValidationError) Error() string {
+func (e GetArchitectureViolationsRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1982,14 +8942,14 @@ func (e GetRepoTreeRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoTreeResp.%s: %s%s",
+		"invalid %sGetArchitectureViolationsResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoTreeRespValidationError{}
+var _ error = GetArchitectureViolationsRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1997,7 +8957,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoTreeRespValidationError{}
+} = GetArchitectureViolationsRespValidationError{}
 
 // Validate checks the field values on PackageNode with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -2235,6 +9195,8 @@ func (m *ViewFileReq) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Snapshot
+
 	if len(errors) > 0 {
 		return ViewFileReqMultiError(errors)
 	}
@@ -2479,6 +9441,35 @@ func (m *Function) validate(all bool) error {
 
 	// no validation rules for Receiver
 
+	if all {
+		switch v := interface{}(m.GetSpan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Span",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "Span",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return FunctionMultiError(errors)
 	}
@@ -2718,6 +9709,8 @@ func (m *GetImplementReq) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Snapshot
+
 	if len(errors) > 0 {
 		return GetImplementReqMultiError(errors)
 	}
@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CodeWikiService_CallChain_FullMethodName       = "/codewiki.v1.CodeWikiService/CallChain"
-	CodeWikiService_CreateRepo_FullMethodName      = "/codewiki.v1.CodeWikiService/CreateRepo"
-	CodeWikiService_ListRepos_FullMethodName       = "/codewiki.v1.CodeWikiService/ListRepos"
-	CodeWikiService_GetRepo_FullMethodName         = "/codewiki.v1.CodeWikiService/GetRepo"
-	CodeWikiService_DeleteRepo_FullMethodName      = "/codewiki.v1.CodeWikiService/DeleteRepo"
-	CodeWikiService_AnalyzeRepo_FullMethodName     = "/codewiki.v1.CodeWikiService/AnalyzeRepo"
-	CodeWikiService_GetRepoTree_FullMethodName     = "/codewiki.v1.CodeWikiService/GetRepoTree"
-	CodeWikiService_ViewFileContent_FullMethodName = "/codewiki.v1.CodeWikiService/ViewFileContent"
-	CodeWikiService_GetImplement_FullMethodName    = "/codewiki.v1.CodeWikiService/GetImplement"
-	CodeWikiService_Answer_FullMethodName          = "/codewiki.v1.CodeWikiService/Answer"
+	CodeWikiService_CallChain_FullMethodName                 = "/codewiki.v1.CodeWikiService/CallChain"
+	CodeWikiService_CallersChain_FullMethodName              = "/codewiki.v1.CodeWikiService/CallersChain"
+	CodeWikiService_CallPath_FullMethodName                  = "/codewiki.v1.CodeWikiService/CallPath"
+	CodeWikiService_CreateRepo_FullMethodName                = "/codewiki.v1.CodeWikiService/CreateRepo"
+	CodeWikiService_ListRepos_FullMethodName                 = "/codewiki.v1.CodeWikiService/ListRepos"
+	CodeWikiService_GetRepo_FullMethodName                   = "/codewiki.v1.CodeWikiService/GetRepo"
+	CodeWikiService_DeleteRepo_FullMethodName                = "/codewiki.v1.CodeWikiService/DeleteRepo"
+	CodeWikiService_AnalyzeRepo_FullMethodName               = "/codewiki.v1.CodeWikiService/AnalyzeRepo"
+	CodeWikiService_GetAnalysisJob_FullMethodName            = "/codewiki.v1.CodeWikiService/GetAnalysisJob"
+	CodeWikiService_ListAnalysisJobs_FullMethodName          = "/codewiki.v1.CodeWikiService/ListAnalysisJobs"
+	CodeWikiService_CancelAnalysisJob_FullMethodName         = "/codewiki.v1.CodeWikiService/CancelAnalysisJob"
+	CodeWikiService_ListSnapshots_FullMethodName             = "/codewiki.v1.CodeWikiService/ListSnapshots"
+	CodeWikiService_DiffGraphs_FullMethodName                = "/codewiki.v1.CodeWikiService/DiffGraphs"
+	CodeWikiService_CheckAPICompatibility_FullMethodName     = "/codewiki.v1.CodeWikiService/CheckAPICompatibility"
+	CodeWikiService_SearchSymbols_FullMethodName             = "/codewiki.v1.CodeWikiService/SearchSymbols"
+	CodeWikiService_ResolveSymbolAt_FullMethodName           = "/codewiki.v1.CodeWikiService/ResolveSymbolAt"
+	CodeWikiService_FindReferences_FullMethodName            = "/codewiki.v1.CodeWikiService/FindReferences"
+	CodeWikiService_AnalyzeImpact_FullMethodName             = "/codewiki.v1.CodeWikiService/AnalyzeImpact"
+	CodeWikiService_GetFunctionTests_FullMethodName          = "/codewiki.v1.CodeWikiService/GetFunctionTests"
+	CodeWikiService_GetPackageDependencies_FullMethodName    = "/codewiki.v1.CodeWikiService/GetPackageDependencies"
+	CodeWikiService_GetArchitectureViolations_FullMethodName = "/codewiki.v1.CodeWikiService/GetArchitectureViolations"
+	CodeWikiService_GetRepoTree_FullMethodName               = "/codewiki.v1.CodeWikiService/GetRepoTree"
+	CodeWikiService_ViewFileContent_FullMethodName           = "/codewiki.v1.CodeWikiService/ViewFileContent"
+	CodeWikiService_GetImplement_FullMethodName              = "/codewiki.v1.CodeWikiService/GetImplement"
+	CodeWikiService_Answer_FullMethodName                    = "/codewiki.v1.CodeWikiService/Answer"
 )
 
 // CodeWikiServiceClient is the client API for CodeWikiService service.
@@ -37,13 +52,29 @@ const (
 type CodeWikiServiceClient interface {
 	CallChain(ctx context.Context, in *CallChainReq, opts ...grpc.CallOption) (*CallChainResp, error)
 	// Repo management
+	CallersChain(ctx context.Context, in *CallersChainReq, opts ...grpc.CallOption) (*CallChainResp, error)
+	CallPath(ctx context.Context, in *CallPathReq, opts ...grpc.CallOption) (*CallPathResp, error)
 	CreateRepo(ctx context.Context, in *CreateRepoReq, opts ...grpc.CallOption) (*CreateRepoResp, error)
 	ListRepos(ctx context.Context, in *ListReposReq, opts ...grpc.CallOption) (*ListReposResp, error)
 	GetRepo(ctx context.Context, in *GetRepoReq, opts ...grpc.CallOption) (*GetRepoResp, error)
 	DeleteRepo(ctx context.Context, in *DeleteRepoReq, opts ...grpc.CallOption) (*DeleteRepoResp, error)
 	// Analyze by repository id
 	AnalyzeRepo(ctx context.Context, in *AnalyzeRepoReq, opts ...grpc.CallOption) (*AnalyzeResp, error)
+	// Analysis jobs
+	GetAnalysisJob(ctx context.Context, in *GetAnalysisJobReq, opts ...grpc.CallOption) (*GetAnalysisJobResp, error)
+	ListAnalysisJobs(ctx context.Context, in *ListAnalysisJobsReq, opts ...grpc.CallOption) (*ListAnalysisJobsResp, error)
+	CancelAnalysisJob(ctx context.Context, in *CancelAnalysisJobReq, opts ...grpc.CallOption) (*CancelAnalysisJobResp, error)
 	// Repo tree display
+	ListSnapshots(ctx context.Context, in *ListSnapshotsReq, opts ...grpc.CallOption) (*ListSnapshotsResp, error)
+	DiffGraphs(ctx context.Context, in *DiffGraphsReq, opts ...grpc.CallOption) (*DiffGraphsResp, error)
+	CheckAPICompatibility(ctx context.Context, in *CheckAPICompatibilityReq, opts ...grpc.CallOption) (*CheckAPICompatibilityResp, error)
+	SearchSymbols(ctx context.Context, in *SearchSymbolsReq, opts ...grpc.CallOption) (*SearchSymbolsResp, error)
+	ResolveSymbolAt(ctx context.Context, in *ResolveSymbolAtReq, opts ...grpc.CallOption) (*ResolveSymbolAtResp, error)
+	FindReferences(ctx context.Context, in *FindReferencesReq, opts ...grpc.CallOption) (*FindReferencesResp, error)
+	AnalyzeImpact(ctx context.Context, in *AnalyzeImpactReq, opts ...grpc.CallOption) (*AnalyzeImpactResp, error)
+	GetFunctionTests(ctx context.Context, in *GetFunctionTestsReq, opts ...grpc.CallOption) (*GetFunctionTestsResp, error)
+	GetPackageDependencies(ctx context.Context, in *GetPackageDependenciesReq, opts ...grpc.CallOption) (*GetPackageDependenciesResp, error)
+	GetArchitectureViolations(ctx context.Context, in *GetArchitectureViolationsReq, opts ...grpc.CallOption) (*GetArchitectureViolationsResp, error)
 	GetRepoTree(ctx context.Context, in *GetRepoTreeReq, opts ...grpc.CallOption) (*GetRepoTreeResp, error)
 	// File  view  content
 	ViewFileContent(ctx context.Context, in *ViewFileReq, opts ...grpc.CallOption) (*ViewFileResp, error)
@@ -70,6 +101,26 @@ func (c *codeWikiServiceClient) CallChain(ctx context.Context, in *CallChainReq,
 	return out, nil
 }
 
+func (c *codeWikiServiceClient) CallersChain(ctx context.Context, in *CallersChainReq, opts ...grpc.CallOption) (*CallChainResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallChainResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_CallersChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) CallPath(ctx context.Context, in *CallPathReq, opts ...grpc.CallOption) (*CallPathResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallPathResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_CallPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *codeWikiServiceClient) CreateRepo(ctx context.Context, in *CreateRepoReq, opts ...grpc.CallOption) (*CreateRepoResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRepoResp)
@@ -120,6 +171,136 @@ func (c *codeWikiServiceClient) AnalyzeRepo(ctx context.Context, in *AnalyzeRepo
 	return out, nil
 }
 
+func (c *codeWikiServiceClient) GetAnalysisJob(ctx context.Context, in *GetAnalysisJobReq, opts ...grpc.CallOption) (*GetAnalysisJobResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnalysisJobResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_GetAnalysisJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) ListAnalysisJobs(ctx context.Context, in *ListAnalysisJobsReq, opts ...grpc.CallOption) (*ListAnalysisJobsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnalysisJobsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_ListAnalysisJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) CancelAnalysisJob(ctx context.Context, in *CancelAnalysisJobReq, opts ...grpc.CallOption) (*CancelAnalysisJobResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAnalysisJobResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_CancelAnalysisJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsReq, opts ...grpc.CallOption) (*ListSnapshotsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) DiffGraphs(ctx context.Context, in *DiffGraphsReq, opts ...grpc.CallOption) (*DiffGraphsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffGraphsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_DiffGraphs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) CheckAPICompatibility(ctx context.Context, in *CheckAPICompatibilityReq, opts ...grpc.CallOption) (*CheckAPICompatibilityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAPICompatibilityResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_CheckAPICompatibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) SearchSymbols(ctx context.Context, in *SearchSymbolsReq, opts ...grpc.CallOption) (*SearchSymbolsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSymbolsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_SearchSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) ResolveSymbolAt(ctx context.Context, in *ResolveSymbolAtReq, opts ...grpc.CallOption) (*ResolveSymbolAtResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveSymbolAtResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_ResolveSymbolAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) FindReferences(ctx context.Context, in *FindReferencesReq, opts ...grpc.CallOption) (*FindReferencesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindReferencesResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_FindReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) AnalyzeImpact(ctx context.Context, in *AnalyzeImpactReq, opts ...grpc.CallOption) (*AnalyzeImpactResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeImpactResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_AnalyzeImpact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) GetFunctionTests(ctx context.Context, in *GetFunctionTestsReq, opts ...grpc.CallOption) (*GetFunctionTestsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFunctionTestsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_GetFunctionTests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) GetPackageDependencies(ctx context.Context, in *GetPackageDependenciesReq, opts ...grpc.CallOption) (*GetPackageDependenciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPackageDependenciesResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_GetPackageDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeWikiServiceClient) GetArchitectureViolations(ctx context.Context, in *GetArchitectureViolationsReq, opts ...grpc.CallOption) (*GetArchitectureViolationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArchitectureViolationsResp)
+	err := c.cc.Invoke(ctx, CodeWikiService_GetArchitectureViolations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *codeWikiServiceClient) GetRepoTree(ctx context.Context, in *GetRepoTreeReq, opts ...grpc.CallOption) (*GetRepoTreeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRepoTreeResp)
@@ -175,13 +356,29 @@ type CodeWikiService_AnswerClient = grpc.ServerStreamingClient[AnswerResp]
 type CodeWikiServiceServer interface {
 	CallChain(context.Context, *CallChainReq) (*CallChainResp, error)
 	// Repo management
+	CallersChain(context.Context, *CallersChainReq) (*CallChainResp, error)
+	CallPath(context.Context, *CallPathReq) (*CallPathResp, error)
 	CreateRepo(context.Context, *CreateRepoReq) (*CreateRepoResp, error)
 	ListRepos(context.Context, *ListReposReq) (*ListReposResp, error)
 	GetRepo(context.Context, *GetRepoReq) (*GetRepoResp, error)
 	DeleteRepo(context.Context, *DeleteRepoReq) (*DeleteRepoResp, error)
 	// Analyze by repository id
 	AnalyzeRepo(context.Context, *AnalyzeRepoReq) (*AnalyzeResp, error)
+	// Analysis jobs
+	GetAnalysisJob(context.Context, *GetAnalysisJobReq) (*GetAnalysisJobResp, error)
+	ListAnalysisJobs(context.Context, *ListAnalysisJobsReq) (*ListAnalysisJobsResp, error)
+	CancelAnalysisJob(context.Context, *CancelAnalysisJobReq) (*CancelAnalysisJobResp, error)
 	// Repo tree display
+	ListSnapshots(context.Context, *ListSnapshotsReq) (*ListSnapshotsResp, error)
+	DiffGraphs(context.Context, *DiffGraphsReq) (*DiffGraphsResp, error)
+	CheckAPICompatibility(context.Context, *CheckAPICompatibilityReq) (*CheckAPICompatibilityResp, error)
+	SearchSymbols(context.Context, *SearchSymbolsReq) (*SearchSymbolsResp, error)
+	ResolveSymbolAt(context.Context, *ResolveSymbolAtReq) (*ResolveSymbolAtResp, error)
+	FindReferences(context.Context, *FindReferencesReq) (*FindReferencesResp, error)
+	AnalyzeImpact(context.Context, *AnalyzeImpactReq) (*AnalyzeImpactResp, error)
+	GetFunctionTests(context.Context, *GetFunctionTestsReq) (*GetFunctionTestsResp, error)
+	GetPackageDependencies(context.Context, *GetPackageDependenciesReq) (*GetPackageDependenciesResp, error)
+	GetArchitectureViolations(context.Context, *GetArchitectureViolationsReq) (*GetArchitectureViolationsResp, error)
 	GetRepoTree(context.Context, *GetRepoTreeReq) (*GetRepoTreeResp, error)
 	// File  view  content
 	ViewFileContent(context.Context, *ViewFileReq) (*ViewFileResp, error)
@@ -201,6 +398,12 @@ type UnimplementedCodeWikiServiceServer struct{}
 func (UnimplementedCodeWikiServiceServer) CallChain(context.Context, *CallChainReq) (*CallChainResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallChain not implemented")
 }
+func (UnimplementedCodeWikiServiceServer) CallersChain(context.Context, *CallersChainReq) (*CallChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallersChain not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) CallPath(context.Context, *CallPathReq) (*CallPathResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallPath not implemented")
+}
 func (UnimplementedCodeWikiServiceServer) CreateRepo(context.Context, *CreateRepoReq) (*CreateRepoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
 }
@@ -216,6 +419,45 @@ func (UnimplementedCodeWikiServiceServer) DeleteRepo(context.Context, *DeleteRep
 func (UnimplementedCodeWikiServiceServer) AnalyzeRepo(context.Context, *AnalyzeRepoReq) (*AnalyzeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeRepo not implemented")
 }
+func (UnimplementedCodeWikiServiceServer) GetAnalysisJob(context.Context, *GetAnalysisJobReq) (*GetAnalysisJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisJob not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) ListAnalysisJobs(context.Context, *ListAnalysisJobsReq) (*ListAnalysisJobsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnalysisJobs not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) CancelAnalysisJob(context.Context, *CancelAnalysisJobReq) (*CancelAnalysisJobResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAnalysisJob not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) ListSnapshots(context.Context, *ListSnapshotsReq) (*ListSnapshotsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) DiffGraphs(context.Context, *DiffGraphsReq) (*DiffGraphsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffGraphs not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) CheckAPICompatibility(context.Context, *CheckAPICompatibilityReq) (*CheckAPICompatibilityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAPICompatibility not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) SearchSymbols(context.Context, *SearchSymbolsReq) (*SearchSymbolsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSymbols not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) ResolveSymbolAt(context.Context, *ResolveSymbolAtReq) (*ResolveSymbolAtResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSymbolAt not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) FindReferences(context.Context, *FindReferencesReq) (*FindReferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindReferences not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) AnalyzeImpact(context.Context, *AnalyzeImpactReq) (*AnalyzeImpactResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeImpact not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) GetFunctionTests(context.Context, *GetFunctionTestsReq) (*GetFunctionTestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunctionTests not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) GetPackageDependencies(context.Context, *GetPackageDependenciesReq) (*GetPackageDependenciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageDependencies not implemented")
+}
+func (UnimplementedCodeWikiServiceServer) GetArchitectureViolations(context.Context, *GetArchitectureViolationsReq) (*GetArchitectureViolationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchitectureViolations not implemented")
+}
 func (UnimplementedCodeWikiServiceServer) GetRepoTree(context.Context, *GetRepoTreeReq) (*GetRepoTreeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepoTree not implemented")
 }
@@ -267,6 +509,42 @@ func _CodeWikiService_CallChain_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodeWikiService_CallersChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallersChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).CallersChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_CallersChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).CallersChain(ctx, req.(*CallersChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_CallPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallPathReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).CallPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_CallPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).CallPath(ctx, req.(*CallPathReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CodeWikiService_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRepoReq)
 	if err := dec(in); err != nil {
@@ -357,6 +635,240 @@ func _CodeWikiService_AnalyzeRepo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodeWikiService_GetAnalysisJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalysisJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).GetAnalysisJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_GetAnalysisJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).GetAnalysisJob(ctx, req.(*GetAnalysisJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_ListAnalysisJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnalysisJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).ListAnalysisJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_ListAnalysisJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).ListAnalysisJobs(ctx, req.(*ListAnalysisJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_CancelAnalysisJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAnalysisJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).CancelAnalysisJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_CancelAnalysisJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).CancelAnalysisJob(ctx, req.(*CancelAnalysisJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_DiffGraphs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffGraphsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).DiffGraphs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_DiffGraphs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).DiffGraphs(ctx, req.(*DiffGraphsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_CheckAPICompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAPICompatibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).CheckAPICompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_CheckAPICompatibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).CheckAPICompatibility(ctx, req.(*CheckAPICompatibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_SearchSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSymbolsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).SearchSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_SearchSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).SearchSymbols(ctx, req.(*SearchSymbolsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_ResolveSymbolAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSymbolAtReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).ResolveSymbolAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_ResolveSymbolAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).ResolveSymbolAt(ctx, req.(*ResolveSymbolAtReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_FindReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).FindReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_FindReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).FindReferences(ctx, req.(*FindReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_AnalyzeImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeImpactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).AnalyzeImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_AnalyzeImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).AnalyzeImpact(ctx, req.(*AnalyzeImpactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_GetFunctionTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctionTestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).GetFunctionTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_GetFunctionTests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).GetFunctionTests(ctx, req.(*GetFunctionTestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_GetPackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackageDependenciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).GetPackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_GetPackageDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).GetPackageDependencies(ctx, req.(*GetPackageDependenciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodeWikiService_GetArchitectureViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchitectureViolationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeWikiServiceServer).GetArchitectureViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodeWikiService_GetArchitectureViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeWikiServiceServer).GetArchitectureViolations(ctx, req.(*GetArchitectureViolationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CodeWikiService_GetRepoTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRepoTreeReq)
 	if err := dec(in); err != nil {
@@ -433,6 +945,14 @@ var CodeWikiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CallChain",
 			Handler:    _CodeWikiService_CallChain_Handler,
 		},
+		{
+			MethodName: "CallersChain",
+			Handler:    _CodeWikiService_CallersChain_Handler,
+		},
+		{
+			MethodName: "CallPath",
+			Handler:    _CodeWikiService_CallPath_Handler,
+		},
 		{
 			MethodName: "CreateRepo",
 			Handler:    _CodeWikiService_CreateRepo_Handler,
@@ -453,6 +973,58 @@ var CodeWikiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeRepo",
 			Handler:    _CodeWikiService_AnalyzeRepo_Handler,
 		},
+		{
+			MethodName: "GetAnalysisJob",
+			Handler:    _CodeWikiService_GetAnalysisJob_Handler,
+		},
+		{
+			MethodName: "ListAnalysisJobs",
+			Handler:    _CodeWikiService_ListAnalysisJobs_Handler,
+		},
+		{
+			MethodName: "CancelAnalysisJob",
+			Handler:    _CodeWikiService_CancelAnalysisJob_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _CodeWikiService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DiffGraphs",
+			Handler:    _CodeWikiService_DiffGraphs_Handler,
+		},
+		{
+			MethodName: "CheckAPICompatibility",
+			Handler:    _CodeWikiService_CheckAPICompatibility_Handler,
+		},
+		{
+			MethodName: "SearchSymbols",
+			Handler:    _CodeWikiService_SearchSymbols_Handler,
+		},
+		{
+			MethodName: "ResolveSymbolAt",
+			Handler:    _CodeWikiService_ResolveSymbolAt_Handler,
+		},
+		{
+			MethodName: "FindReferences",
+			Handler:    _CodeWikiService_FindReferences_Handler,
+		},
+		{
+			MethodName: "AnalyzeImpact",
+			Handler:    _CodeWikiService_AnalyzeImpact_Handler,
+		},
+		{
+			MethodName: "GetFunctionTests",
+			Handler:    _CodeWikiService_GetFunctionTests_Handler,
+		},
+		{
+			MethodName: "GetPackageDependencies",
+			Handler:    _CodeWikiService_GetPackageDependencies_Handler,
+		},
+		{
+			MethodName: "GetArchitectureViolations",
+			Handler:    _CodeWikiService_GetArchitectureViolations_Handler,
+		},
 		{
 			MethodName: "GetRepoTree",
 			Handler:    _CodeWikiService_GetRepoTree_Handler,
@@ -19,29 +19,60 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationCodeWikiServiceAnalyzeImpact = "/codewiki.v1.CodeWikiService/AnalyzeImpact"
 const OperationCodeWikiServiceAnalyzeRepo = "/codewiki.v1.CodeWikiService/AnalyzeRepo"
 const OperationCodeWikiServiceCallChain = "/codewiki.v1.CodeWikiService/CallChain"
+const OperationCodeWikiServiceCallPath = "/codewiki.v1.CodeWikiService/CallPath"
+const OperationCodeWikiServiceCallersChain = "/codewiki.v1.CodeWikiService/CallersChain"
+const OperationCodeWikiServiceCancelAnalysisJob = "/codewiki.v1.CodeWikiService/CancelAnalysisJob"
+const OperationCodeWikiServiceCheckAPICompatibility = "/codewiki.v1.CodeWikiService/CheckAPICompatibility"
 const OperationCodeWikiServiceCreateRepo = "/codewiki.v1.CodeWikiService/CreateRepo"
 const OperationCodeWikiServiceDeleteRepo = "/codewiki.v1.CodeWikiService/DeleteRepo"
+const OperationCodeWikiServiceDiffGraphs = "/codewiki.v1.CodeWikiService/DiffGraphs"
+const OperationCodeWikiServiceFindReferences = "/codewiki.v1.CodeWikiService/FindReferences"
+const OperationCodeWikiServiceGetAnalysisJob = "/codewiki.v1.CodeWikiService/GetAnalysisJob"
+const OperationCodeWikiServiceGetArchitectureViolations = "/codewiki.v1.CodeWikiService/GetArchitectureViolations"
+const OperationCodeWikiServiceGetFunctionTests = "/codewiki.v1.CodeWikiService/GetFunctionTests"
 const OperationCodeWikiServiceGetImplement = "/codewiki.v1.CodeWikiService/GetImplement"
+const OperationCodeWikiServiceGetPackageDependencies = "/codewiki.v1.CodeWikiService/GetPackageDependencies"
 const OperationCodeWikiServiceGetRepo = "/codewiki.v1.CodeWikiService/GetRepo"
 const OperationCodeWikiServiceGetRepoTree = "/codewiki.v1.CodeWikiService/GetRepoTree"
+const OperationCodeWikiServiceListAnalysisJobs = "/codewiki.v1.CodeWikiService/ListAnalysisJobs"
 const OperationCodeWikiServiceListRepos = "/codewiki.v1.CodeWikiService/ListRepos"
+const OperationCodeWikiServiceListSnapshots = "/codewiki.v1.CodeWikiService/ListSnapshots"
+const OperationCodeWikiServiceResolveSymbolAt = "/codewiki.v1.CodeWikiService/ResolveSymbolAt"
+const OperationCodeWikiServiceSearchSymbols = "/codewiki.v1.CodeWikiService/SearchSymbols"
 const OperationCodeWikiServiceViewFileContent = "/codewiki.v1.CodeWikiService/ViewFileContent"
 
 type CodeWikiServiceHTTPServer interface {
+	AnalyzeImpact(context.Context, *AnalyzeImpactReq) (*AnalyzeImpactResp, error)
 	// AnalyzeRepo Analyze by repository id
 	AnalyzeRepo(context.Context, *AnalyzeRepoReq) (*AnalyzeResp, error)
 	CallChain(context.Context, *CallChainReq) (*CallChainResp, error)
-	// CreateRepo Repo management
+	CallPath(context.Context, *CallPathReq) (*CallPathResp, error)
+	// CallersChain Repo management
+	CallersChain(context.Context, *CallersChainReq) (*CallChainResp, error)
+	CancelAnalysisJob(context.Context, *CancelAnalysisJobReq) (*CancelAnalysisJobResp, error)
+	CheckAPICompatibility(context.Context, *CheckAPICompatibilityReq) (*CheckAPICompatibilityResp, error)
 	CreateRepo(context.Context, *CreateRepoReq) (*CreateRepoResp, error)
 	DeleteRepo(context.Context, *DeleteRepoReq) (*DeleteRepoResp, error)
+	DiffGraphs(context.Context, *DiffGraphsReq) (*DiffGraphsResp, error)
+	FindReferences(context.Context, *FindReferencesReq) (*FindReferencesResp, error)
+	// GetAnalysisJob Analysis jobs
+	GetAnalysisJob(context.Context, *GetAnalysisJobReq) (*GetAnalysisJobResp, error)
+	GetArchitectureViolations(context.Context, *GetArchitectureViolationsReq) (*GetArchitectureViolationsResp, error)
+	GetFunctionTests(context.Context, *GetFunctionTestsReq) (*GetFunctionTestsResp, error)
 	// GetImplement interface  implement
 	GetImplement(context.Context, *GetImplementReq) (*GetImplementResp, error)
+	GetPackageDependencies(context.Context, *GetPackageDependenciesReq) (*GetPackageDependenciesResp, error)
 	GetRepo(context.Context, *GetRepoReq) (*GetRepoResp, error)
-	// GetRepoTree Repo tree display
 	GetRepoTree(context.Context, *GetRepoTreeReq) (*GetRepoTreeResp, error)
+	ListAnalysisJobs(context.Context, *ListAnalysisJobsReq) (*ListAnalysisJobsResp, error)
 	ListRepos(context.Context, *ListReposReq) (*ListReposResp, error)
+	// ListSnapshots Repo tree display
+	ListSnapshots(context.Context, *ListSnapshotsReq) (*ListSnapshotsResp, error)
+	ResolveSymbolAt(context.Context, *ResolveSymbolAtReq) (*ResolveSymbolAtResp, error)
+	SearchSymbols(context.Context, *SearchSymbolsReq) (*SearchSymbolsResp, error)
 	// ViewFileContent File  view  content
 	ViewFileContent(context.Context, *ViewFileReq) (*ViewFileResp, error)
 }
@@ -49,11 +80,26 @@ type CodeWikiServiceHTTPServer interface {
 func RegisterCodeWikiServiceHTTPServer(s *http.Server, srv CodeWikiServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/v1/api/functions/{id}/calls", _CodeWikiService_CallChain0_HTTP_Handler(srv))
+	r.GET("/v1/api/functions/{id}/callers", _CodeWikiService_CallersChain0_HTTP_Handler(srv))
+	r.GET("/v1/api/functions/{source}/paths", _CodeWikiService_CallPath0_HTTP_Handler(srv))
 	r.POST("/v1/api/repos", _CodeWikiService_CreateRepo0_HTTP_Handler(srv))
 	r.GET("/v1/api/repos", _CodeWikiService_ListRepos0_HTTP_Handler(srv))
 	r.GET("/v1/api/repos/{id}", _CodeWikiService_GetRepo0_HTTP_Handler(srv))
 	r.DELETE("/v1/api/repos/{id}", _CodeWikiService_DeleteRepo0_HTTP_Handler(srv))
 	r.POST("/v1/api/repos/{id}/analyze", _CodeWikiService_AnalyzeRepo0_HTTP_Handler(srv))
+	r.GET("/v1/api/jobs/{id}", _CodeWikiService_GetAnalysisJob0_HTTP_Handler(srv))
+	r.GET("/v1/api/jobs", _CodeWikiService_ListAnalysisJobs0_HTTP_Handler(srv))
+	r.POST("/v1/api/jobs/{id}/cancel", _CodeWikiService_CancelAnalysisJob0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/snapshots", _CodeWikiService_ListSnapshots0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/diff", _CodeWikiService_DiffGraphs0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/api-compat", _CodeWikiService_CheckAPICompatibility0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/symbols", _CodeWikiService_SearchSymbols0_HTTP_Handler(srv))
+	r.GET("/v1/api/{repoId}/file/{fileId}/symbol", _CodeWikiService_ResolveSymbolAt0_HTTP_Handler(srv))
+	r.GET("/v1/api/{repoId}/symbols/{id}/references", _CodeWikiService_FindReferences0_HTTP_Handler(srv))
+	r.POST("/v1/api/repos/{repoId}/impact", _CodeWikiService_AnalyzeImpact0_HTTP_Handler(srv))
+	r.GET("/v1/api/functions/{id}/tests", _CodeWikiService_GetFunctionTests0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/dependencies", _CodeWikiService_GetPackageDependencies0_HTTP_Handler(srv))
+	r.GET("/v1/api/repos/{id}/violations", _CodeWikiService_GetArchitectureViolations0_HTTP_Handler(srv))
 	r.GET("/v1/api/repos/{id}/tree", _CodeWikiService_GetRepoTree0_HTTP_Handler(srv))
 	r.GET("/v1/api/{repoId}/file/{id}/view", _CodeWikiService_ViewFileContent0_HTTP_Handler(srv))
 	r.GET("/v1/api/entity/{id}/implements", _CodeWikiService_GetImplement0_HTTP_Handler(srv))
@@ -81,6 +127,50 @@ func _CodeWikiService_CallChain0_HTTP_Handler(srv CodeWikiServiceHTTPServer) fun
 	}
 }
 
+func _CodeWikiService_CallersChain0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CallersChainReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceCallersChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CallersChain(ctx, req.(*CallersChainReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CallChainResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_CallPath0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CallPathReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceCallPath)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CallPath(ctx, req.(*CallPathReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CallPathResp)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CodeWikiService_CreateRepo0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateRepoReq
@@ -191,6 +281,295 @@ func _CodeWikiService_AnalyzeRepo0_HTTP_Handler(srv CodeWikiServiceHTTPServer) f
 	}
 }
 
+func _CodeWikiService_GetAnalysisJob0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAnalysisJobReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceGetAnalysisJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAnalysisJob(ctx, req.(*GetAnalysisJobReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAnalysisJobResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_ListAnalysisJobs0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAnalysisJobsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceListAnalysisJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAnalysisJobs(ctx, req.(*ListAnalysisJobsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAnalysisJobsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_CancelAnalysisJob0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelAnalysisJobReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceCancelAnalysisJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelAnalysisJob(ctx, req.(*CancelAnalysisJobReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelAnalysisJobResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_ListSnapshots0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSnapshotsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceListSnapshots)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSnapshots(ctx, req.(*ListSnapshotsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSnapshotsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_DiffGraphs0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffGraphsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceDiffGraphs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffGraphs(ctx, req.(*DiffGraphsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffGraphsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_CheckAPICompatibility0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAPICompatibilityReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceCheckAPICompatibility)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAPICompatibility(ctx, req.(*CheckAPICompatibilityReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAPICompatibilityResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_SearchSymbols0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchSymbolsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceSearchSymbols)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchSymbols(ctx, req.(*SearchSymbolsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchSymbolsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_ResolveSymbolAt0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveSymbolAtReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceResolveSymbolAt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveSymbolAt(ctx, req.(*ResolveSymbolAtReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveSymbolAtResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_FindReferences0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FindReferencesReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceFindReferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FindReferences(ctx, req.(*FindReferencesReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FindReferencesResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_AnalyzeImpact0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AnalyzeImpactReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceAnalyzeImpact)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AnalyzeImpact(ctx, req.(*AnalyzeImpactReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AnalyzeImpactResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_GetFunctionTests0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFunctionTestsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceGetFunctionTests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFunctionTests(ctx, req.(*GetFunctionTestsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFunctionTestsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_GetPackageDependencies0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPackageDependenciesReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceGetPackageDependencies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPackageDependencies(ctx, req.(*GetPackageDependenciesReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPackageDependenciesResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CodeWikiService_GetArchitectureViolations0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetArchitectureViolationsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCodeWikiServiceGetArchitectureViolations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArchitectureViolations(ctx, req.(*GetArchitectureViolationsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetArchitectureViolationsResp)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CodeWikiService_GetRepoTree0_HTTP_Handler(srv CodeWikiServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetRepoTreeReq
@@ -258,14 +637,29 @@ func _CodeWikiService_GetImplement0_HTTP_Handler(srv CodeWikiServiceHTTPServer)
 }
 
 type CodeWikiServiceHTTPClient interface {
+	AnalyzeImpact(ctx context.Context, req *AnalyzeImpactReq, opts ...http.CallOption) (rsp *AnalyzeImpactResp, err error)
 	AnalyzeRepo(ctx context.Context, req *AnalyzeRepoReq, opts ...http.CallOption) (rsp *AnalyzeResp, err error)
 	CallChain(ctx context.Context, req *CallChainReq, opts ...http.CallOption) (rsp *CallChainResp, err error)
+	CallPath(ctx context.Context, req *CallPathReq, opts ...http.CallOption) (rsp *CallPathResp, err error)
+	CallersChain(ctx context.Context, req *CallersChainReq, opts ...http.CallOption) (rsp *CallChainResp, err error)
+	CancelAnalysisJob(ctx context.Context, req *CancelAnalysisJobReq, opts ...http.CallOption) (rsp *CancelAnalysisJobResp, err error)
+	CheckAPICompatibility(ctx context.Context, req *CheckAPICompatibilityReq, opts ...http.CallOption) (rsp *CheckAPICompatibilityResp, err error)
 	CreateRepo(ctx context.Context, req *CreateRepoReq, opts ...http.CallOption) (rsp *CreateRepoResp, err error)
 	DeleteRepo(ctx context.Context, req *DeleteRepoReq, opts ...http.CallOption) (rsp *DeleteRepoResp, err error)
+	DiffGraphs(ctx context.Context, req *DiffGraphsReq, opts ...http.CallOption) (rsp *DiffGraphsResp, err error)
+	FindReferences(ctx context.Context, req *FindReferencesReq, opts ...http.CallOption) (rsp *FindReferencesResp, err error)
+	GetAnalysisJob(ctx context.Context, req *GetAnalysisJobReq, opts ...http.CallOption) (rsp *GetAnalysisJobResp, err error)
+	GetArchitectureViolations(ctx context.Context, req *GetArchitectureViolationsReq, opts ...http.CallOption) (rsp *GetArchitectureViolationsResp, err error)
+	GetFunctionTests(ctx context.Context, req *GetFunctionTestsReq, opts ...http.CallOption) (rsp *GetFunctionTestsResp, err error)
 	GetImplement(ctx context.Context, req *GetImplementReq, opts ...http.CallOption) (rsp *GetImplementResp, err error)
+	GetPackageDependencies(ctx context.Context, req *GetPackageDependenciesReq, opts ...http.CallOption) (rsp *GetPackageDependenciesResp, err error)
 	GetRepo(ctx context.Context, req *GetRepoReq, opts ...http.CallOption) (rsp *GetRepoResp, err error)
 	GetRepoTree(ctx context.Context, req *GetRepoTreeReq, opts ...http.CallOption) (rsp *GetRepoTreeResp, err error)
+	ListAnalysisJobs(ctx context.Context, req *ListAnalysisJobsReq, opts ...http.CallOption) (rsp *ListAnalysisJobsResp, err error)
 	ListRepos(ctx context.Context, req *ListReposReq, opts ...http.CallOption) (rsp *ListReposResp, err error)
+	ListSnapshots(ctx context.Context, req *ListSnapshotsReq, opts ...http.CallOption) (rsp *ListSnapshotsResp, err error)
+	ResolveSymbolAt(ctx context.Context, req *ResolveSymbolAtReq, opts ...http.CallOption) (rsp *ResolveSymbolAtResp, err error)
+	SearchSymbols(ctx context.Context, req *SearchSymbolsReq, opts ...http.CallOption) (rsp *SearchSymbolsResp, err error)
 	ViewFileContent(ctx context.Context, req *ViewFileReq, opts ...http.CallOption) (rsp *ViewFileResp, err error)
 }
 
@@ -277,6 +671,19 @@ func NewCodeWikiServiceHTTPClient(client *http.Client) CodeWikiServiceHTTPClient
 	return &CodeWikiServiceHTTPClientImpl{client}
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) AnalyzeImpact(ctx context.Context, in *AnalyzeImpactReq, opts ...http.CallOption) (*AnalyzeImpactResp, error) {
+	var out AnalyzeImpactResp
+	pattern := "/v1/api/repos/{repoId}/impact"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceAnalyzeImpact))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) AnalyzeRepo(ctx context.Context, in *AnalyzeRepoReq, opts ...http.CallOption) (*AnalyzeResp, error) {
 	var out AnalyzeResp
 	pattern := "/v1/api/repos/{id}/analyze"
@@ -303,6 +710,58 @@ func (c *CodeWikiServiceHTTPClientImpl) CallChain(ctx context.Context, in *CallC
 	return &out, nil
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) CallPath(ctx context.Context, in *CallPathReq, opts ...http.CallOption) (*CallPathResp, error) {
+	var out CallPathResp
+	pattern := "/v1/api/functions/{source}/paths"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceCallPath))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) CallersChain(ctx context.Context, in *CallersChainReq, opts ...http.CallOption) (*CallChainResp, error) {
+	var out CallChainResp
+	pattern := "/v1/api/functions/{id}/callers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceCallersChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) CancelAnalysisJob(ctx context.Context, in *CancelAnalysisJobReq, opts ...http.CallOption) (*CancelAnalysisJobResp, error) {
+	var out CancelAnalysisJobResp
+	pattern := "/v1/api/jobs/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceCancelAnalysisJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) CheckAPICompatibility(ctx context.Context, in *CheckAPICompatibilityReq, opts ...http.CallOption) (*CheckAPICompatibilityResp, error) {
+	var out CheckAPICompatibilityResp
+	pattern := "/v1/api/repos/{id}/api-compat"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceCheckAPICompatibility))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) CreateRepo(ctx context.Context, in *CreateRepoReq, opts ...http.CallOption) (*CreateRepoResp, error) {
 	var out CreateRepoResp
 	pattern := "/v1/api/repos"
@@ -329,6 +788,71 @@ func (c *CodeWikiServiceHTTPClientImpl) DeleteRepo(ctx context.Context, in *Dele
 	return &out, nil
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) DiffGraphs(ctx context.Context, in *DiffGraphsReq, opts ...http.CallOption) (*DiffGraphsResp, error) {
+	var out DiffGraphsResp
+	pattern := "/v1/api/repos/{id}/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceDiffGraphs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) FindReferences(ctx context.Context, in *FindReferencesReq, opts ...http.CallOption) (*FindReferencesResp, error) {
+	var out FindReferencesResp
+	pattern := "/v1/api/{repoId}/symbols/{id}/references"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceFindReferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) GetAnalysisJob(ctx context.Context, in *GetAnalysisJobReq, opts ...http.CallOption) (*GetAnalysisJobResp, error) {
+	var out GetAnalysisJobResp
+	pattern := "/v1/api/jobs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceGetAnalysisJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) GetArchitectureViolations(ctx context.Context, in *GetArchitectureViolationsReq, opts ...http.CallOption) (*GetArchitectureViolationsResp, error) {
+	var out GetArchitectureViolationsResp
+	pattern := "/v1/api/repos/{id}/violations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceGetArchitectureViolations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) GetFunctionTests(ctx context.Context, in *GetFunctionTestsReq, opts ...http.CallOption) (*GetFunctionTestsResp, error) {
+	var out GetFunctionTestsResp
+	pattern := "/v1/api/functions/{id}/tests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceGetFunctionTests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) GetImplement(ctx context.Context, in *GetImplementReq, opts ...http.CallOption) (*GetImplementResp, error) {
 	var out GetImplementResp
 	pattern := "/v1/api/entity/{id}/implements"
@@ -342,6 +866,19 @@ func (c *CodeWikiServiceHTTPClientImpl) GetImplement(ctx context.Context, in *Ge
 	return &out, nil
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) GetPackageDependencies(ctx context.Context, in *GetPackageDependenciesReq, opts ...http.CallOption) (*GetPackageDependenciesResp, error) {
+	var out GetPackageDependenciesResp
+	pattern := "/v1/api/repos/{id}/dependencies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceGetPackageDependencies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) GetRepo(ctx context.Context, in *GetRepoReq, opts ...http.CallOption) (*GetRepoResp, error) {
 	var out GetRepoResp
 	pattern := "/v1/api/repos/{id}"
@@ -368,6 +905,19 @@ func (c *CodeWikiServiceHTTPClientImpl) GetRepoTree(ctx context.Context, in *Get
 	return &out, nil
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) ListAnalysisJobs(ctx context.Context, in *ListAnalysisJobsReq, opts ...http.CallOption) (*ListAnalysisJobsResp, error) {
+	var out ListAnalysisJobsResp
+	pattern := "/v1/api/jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceListAnalysisJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) ListRepos(ctx context.Context, in *ListReposReq, opts ...http.CallOption) (*ListReposResp, error) {
 	var out ListReposResp
 	pattern := "/v1/api/repos"
@@ -381,6 +931,45 @@ func (c *CodeWikiServiceHTTPClientImpl) ListRepos(ctx context.Context, in *ListR
 	return &out, nil
 }
 
+func (c *CodeWikiServiceHTTPClientImpl) ListSnapshots(ctx context.Context, in *ListSnapshotsReq, opts ...http.CallOption) (*ListSnapshotsResp, error) {
+	var out ListSnapshotsResp
+	pattern := "/v1/api/repos/{id}/snapshots"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceListSnapshots))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) ResolveSymbolAt(ctx context.Context, in *ResolveSymbolAtReq, opts ...http.CallOption) (*ResolveSymbolAtResp, error) {
+	var out ResolveSymbolAtResp
+	pattern := "/v1/api/{repoId}/file/{fileId}/symbol"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceResolveSymbolAt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CodeWikiServiceHTTPClientImpl) SearchSymbols(ctx context.Context, in *SearchSymbolsReq, opts ...http.CallOption) (*SearchSymbolsResp, error) {
+	var out SearchSymbolsResp
+	pattern := "/v1/api/repos/{id}/symbols"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCodeWikiServiceSearchSymbols))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CodeWikiServiceHTTPClientImpl) ViewFileContent(ctx context.Context, in *ViewFileReq, opts ...http.CallOption) (*ViewFileResp, error) {
 	var out ViewFileResp
 	pattern := "/v1/api/{repoId}/file/{id}/view"
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"codewiki/internal/biz"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// runAPICompat 比较 git 仓库两个版本的导出 API，有不兼容变更时返回 1，出错时返回 2
+//
+//	codewiki api-compat -base v1.2.0 [-head HEAD] [-json] [dir]
+func runAPICompat(args []string) int {
+	fs := flag.NewFlagSet("api-compat", flag.ContinueOnError)
+	base := fs.String("base", "", "base revision: branch, tag or commit (required)")
+	head := fs.String("head", "", "head revision, empty for the working tree")
+	asJSON := fs.Bool("json", false, "print the result as json")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *base == "" || fs.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "usage: codewiki api-compat -base <rev> [-head <rev>] [-json] [dir]")
+		return 2
+	}
+	dir := "."
+	if fs.NArg() == 1 {
+		dir = fs.Arg(0)
+	}
+	resp, err := biz.CompareAPI(context.Background(), dir, nil, *base, *head)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if *asJSON {
+		fmt.Println(protojson.MarshalOptions{Multiline: true}.Format(resp))
+	} else {
+		for _, c := range resp.Changes {
+			level := "compatible"
+			if c.Breaking {
+				level = "BREAKING"
+			}
+			fmt.Printf("%-10s %-22s %s.%s", level, c.Kind, c.Package, c.Symbol)
+			switch {
+			case c.Before != "" && c.After != "":
+				fmt.Printf("  %s -> %s", c.Before, c.After)
+			case c.Before+c.After != "":
+				fmt.Printf("  %s", c.Before+c.After)
+			}
+			fmt.Println()
+		}
+		fmt.Printf("suggested bump: %s\n", strings.ToLower(strings.TrimPrefix(resp.Bump.String(), "Bump")))
+	}
+	if resp.Breaking {
+		return 1
+	}
+	return 0
+}
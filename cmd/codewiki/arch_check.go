@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/biz"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// archRulesFile 未指定 -rules 时读取的规则文件，相对于检查的目录
+const archRulesFile = ".codewiki-rules.json"
+
+// runArchCheck 按架构规则检查目录中的源码，有违规时返回 1，出错时返回 2
+//
+//	codewiki arch-check [-rules rules.json] [-lang golang] [-json] [dir]
+func runArchCheck(args []string) int {
+	fs := flag.NewFlagSet("arch-check", flag.ContinueOnError)
+	rulesFile := fs.String("rules", "", "rules file, ArchitectureRules json (default <dir>/"+archRulesFile+")")
+	lang := fs.String("lang", "golang", "source language: golang, java or python")
+	asJSON := fs.Bool("json", false, "print the result as json")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	language, ok := parseLanguage(*lang)
+	if !ok || fs.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "usage: codewiki arch-check [-rules <file>] [-lang golang|java|python] [-json] [dir]")
+		return 2
+	}
+	dir := "."
+	if fs.NArg() == 1 {
+		dir = fs.Arg(0)
+	}
+	if *rulesFile == "" {
+		*rulesFile = filepath.Join(dir, archRulesFile)
+	}
+	data, err := os.ReadFile(*rulesFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	rules := &v1.ArchitectureRules{}
+	if err = protojson.Unmarshal(data, rules); err != nil {
+		fmt.Fprintf(os.Stderr, "parse %s failure: %v\n", *rulesFile, err)
+		return 2
+	}
+	violations, err := biz.CheckArchitectureDir(context.Background(), dir, language, rules.Rules)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if *asJSON {
+		resp := &v1.GetArchitectureViolationsResp{Violations: violations}
+		fmt.Println(protojson.MarshalOptions{Multiline: true}.Format(resp))
+	} else {
+		for _, v := range violations {
+			fmt.Printf("%-24s %-20s %s -> %s\n", v.Rule, strings.TrimPrefix(v.Kind.String(), "Rule"), v.SourcePath, v.TargetPath)
+		}
+		fmt.Printf("%d violations\n", len(violations))
+	}
+	if len(violations) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// parseLanguage 按名称查找语言，不区分大小写
+func parseLanguage(name string) (v1.Language, bool) {
+	for n, value := range v1.Language_value {
+		if strings.EqualFold(n, name) {
+			return v1.Language(value), true
+		}
+	}
+	return 0, false
+}
@@ -48,6 +48,13 @@ func newApp(logger log.Logger, hs *http.Server, gs *kgrpc.Server) *kratos.App {
 }
 
 func main() {
+	// 子命令不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "api-compat" {
+		os.Exit(runAPICompat(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "arch-check" {
+		os.Exit(runArchCheck(os.Args[2:]))
+	}
 	flag.Parse()
 	logger := log.With(log.NewStdLogger(os.Stdout),
 		"ts", log.DefaultTimestamp,
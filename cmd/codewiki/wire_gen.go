@@ -31,21 +31,32 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		return nil, nil, err
 	}
 	driverWithContext := data.NewDriverWithContext(dataData)
+	graphStore, err := repo.NewGraphStore(confData, driverWithContext)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	db, err := data.NewGormDB(confData, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	projectRepo, err := repo.NewCompositeRepo(driverWithContext, db)
+	projectRepo, err := repo.NewCompositeRepo(graphStore, db)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
 	config := biz.NewConfig(confData)
 	llmLLM := llm.NewLLM(config)
-	indexerRepo := repo.NewMilvus(confData)
+	indexerRepo, err := repo.NewIndexerRepo(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	indexer := biz.NewIndexer(llmLLM, indexerRepo)
-	codeWiki := biz.NewCodeWiki(projectRepo, indexer)
+	workspace := biz.NewWorkspace(confData)
+	snapshotRetention := biz.NewSnapshotRetention(confData)
+	codeWiki := biz.NewCodeWiki(projectRepo, indexer, workspace, snapshotRetention)
 	qaEngine := biz.NewQAEngine(llmLLM, indexer, projectRepo)
 	codeWikiService := service.NewCodeWikiService(codeWiki, qaEngine)
 	httpServer := server.NewHTTPServer(confServer, codeWikiService, logger)
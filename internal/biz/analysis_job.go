@@ -0,0 +1,260 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// analysisJobs 本实例上运行中的分析任务，结束后只保存在数据库中
+type analysisJobs struct {
+	lock    sync.Mutex
+	running map[string]*runningJob
+}
+
+type runningJob struct {
+	job    *v1.AnalysisJob
+	cancel context.CancelFunc
+}
+
+func newAnalysisJobs() *analysisJobs {
+	return &analysisJobs{running: make(map[string]*runningJob)}
+}
+
+// add 添加任务，同一个仓库同时只能有一个运行中的任务
+func (jobs *analysisJobs) add(job *v1.AnalysisJob, cancel context.CancelFunc) error {
+	jobs.lock.Lock()
+	defer jobs.lock.Unlock()
+	for _, r := range jobs.running {
+		if r.job.RepoId == job.RepoId {
+			return v1.ErrorBizDuplicateOp("repo %s is being analyzed by job %s", job.RepoId, r.job.Id)
+		}
+	}
+	jobs.running[job.Id] = &runningJob{job: job, cancel: cancel}
+	return nil
+}
+
+func (jobs *analysisJobs) remove(id string) {
+	jobs.lock.Lock()
+	defer jobs.lock.Unlock()
+	delete(jobs.running, id)
+}
+
+// update 修改任务并返回修改后的快照
+func (jobs *analysisJobs) update(id string, fn func(job *v1.AnalysisJob)) *v1.AnalysisJob {
+	jobs.lock.Lock()
+	defer jobs.lock.Unlock()
+	r, ok := jobs.running[id]
+	if !ok {
+		return nil
+	}
+	fn(r.job)
+	return snapshotJob(r.job)
+}
+
+// get 运行中任务的快照
+func (jobs *analysisJobs) get(id string) *v1.AnalysisJob {
+	return jobs.update(id, func(job *v1.AnalysisJob) {})
+}
+
+func (jobs *analysisJobs) cancel(id string) bool {
+	jobs.lock.Lock()
+	defer jobs.lock.Unlock()
+	r, ok := jobs.running[id]
+	if ok {
+		r.cancel()
+	}
+	return ok
+}
+
+func snapshotJob(job *v1.AnalysisJob) *v1.AnalysisJob {
+	job = proto.Clone(job).(*v1.AnalysisJob)
+	if job.StartedAt > 0 && job.FinishedAt == 0 {
+		job.DurationMs = time.Now().UnixMilli() - job.StartedAt
+	}
+	return job
+}
+
+// AnalyzeRepo 提交分析任务，分析在后台执行，通过 GetAnalysisJob 查询进度
+func (c *CodeWiki) AnalyzeRepo(ctx context.Context, req *v1.AnalyzeRepoReq) (*v1.AnalysisJob, error) {
+	repo, err := c.projectRepo.GetRepo(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	job := &v1.AnalysisJob{
+		Id:          uuid.NewString(),
+		RepoId:      repo.Id,
+		Status:      v1.JobStatus_Pending,
+		Incremental: req.Incremental,
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	// 任务不随请求结束而取消
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	if err = c.jobs.add(job, cancel); err != nil {
+		cancel()
+		return nil, err
+	}
+	snapshot := c.jobs.get(job.Id)
+	if err = c.projectRepo.SaveAnalysisJob(ctx, snapshot); err != nil {
+		c.jobs.remove(job.Id)
+		cancel()
+		return nil, err
+	}
+	go c.runAnalysisJob(jobCtx, cancel, job.Id, repo, req.Incremental)
+	return snapshot, nil
+}
+
+func (c *CodeWiki) runAnalysisJob(ctx context.Context, cancel context.CancelFunc, id string, repo *v1.Repo, incremental bool) {
+	defer cancel()
+	defer c.jobs.remove(id)
+	c.saveAnalysisJob(ctx, c.jobs.update(id, func(job *v1.AnalysisJob) {
+		job.Status = v1.JobStatus_Running
+		job.StartedAt = time.Now().UnixMilli()
+	}))
+
+	project := NewProject(repo, c.indexer)
+	project.config.Incremental = incremental
+	project.SetProgress(func(phase v1.AnalysisPhase, processed, total int) {
+		var changed bool
+		job := c.jobs.update(id, func(job *v1.AnalysisJob) {
+			changed = job.Phase != phase
+			job.Phase = phase
+			job.ProcessedFiles = int32(processed)
+			job.TotalFiles = int32(total)
+		})
+		// 文件进度只保存在内存中，阶段变化时写入数据库
+		if changed {
+			c.saveAnalysisJob(ctx, job)
+		}
+	})
+	err := c.analyze(ctx, id, project)
+
+	job := c.jobs.update(id, func(job *v1.AnalysisJob) {
+		job.FinishedAt = time.Now().UnixMilli()
+		job.DurationMs = job.FinishedAt - job.StartedAt
+		switch {
+		case ctx.Err() != nil:
+			job.Status = v1.JobStatus_Canceled
+			job.Error = ctx.Err().Error()
+		case err != nil:
+			job.Status = v1.JobStatus_Failed
+			job.Error = err.Error()
+		default:
+			job.Status = v1.JobStatus_Succeeded
+			job.Phase = v1.AnalysisPhase_PhaseDone
+			job.Summary = project.Summary()
+		}
+	})
+	if err != nil {
+		log.Context(ctx).Errorf("analysis job %s of repo %s failure:%v", id, repo.Id, err)
+	}
+	c.saveAnalysisJob(context.WithoutCancel(ctx), job)
+}
+
+// analyze 检出、分析并保存快照，分析中的 panic 作为错误返回，任务标记为失败
+func (c *CodeWiki) analyze(ctx context.Context, id string, project *Project) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Context(ctx).Errorf("analysis job %s panic:%v\n%s", id, r, debug.Stack())
+			err = fmt.Errorf("analysis panic: %v", r)
+		}
+	}()
+	dir, err := c.checkout(ctx, id, project)
+	if err != nil {
+		return err
+	}
+	if err = project.Analyze(ctx, dir, c.projectRepo); err != nil {
+		return err
+	}
+	if err = c.checkArchitecture(ctx, project); err != nil {
+		return err
+	}
+	return c.saveSnapshot(ctx, id, project)
+}
+
+// failUnfinishedJobs 启动时本实例没有运行中的任务，上次退出时未结束的任务不会再更新
+func (c *CodeWiki) failUnfinishedJobs(ctx context.Context) {
+	count, err := c.projectRepo.FailUnfinishedAnalysisJobs(ctx, "interrupted by server restart", time.Now().UnixMilli())
+	if err != nil {
+		log.Context(ctx).Warnf("fail unfinished analysis jobs failure:%v", err)
+		return
+	}
+	if count > 0 {
+		log.Context(ctx).Infof("marked %d unfinished analysis jobs as failed", count)
+	}
+}
+
+// checkout 准备源码目录，记录分析的提交
+func (c *CodeWiki) checkout(ctx context.Context, id string, project *Project) (string, error) {
+	if IsRemote(project.Repo) {
+		c.saveAnalysisJob(ctx, c.jobs.update(id, func(job *v1.AnalysisJob) {
+			job.Phase = v1.AnalysisPhase_PhaseCheckout
+		}))
+	}
+	dir, commit, err := c.workspace.Checkout(ctx, project.Repo)
+	if err != nil {
+		return "", err
+	}
+	project.Commit = commit
+	c.jobs.update(id, func(job *v1.AnalysisJob) {
+		job.Commit = commit
+		job.Snapshot = project.SnapshotID()
+	})
+	return dir, nil
+}
+
+func (c *CodeWiki) saveAnalysisJob(ctx context.Context, job *v1.AnalysisJob) {
+	if job == nil {
+		return
+	}
+	if err := c.projectRepo.SaveAnalysisJob(ctx, job); err != nil {
+		log.Context(ctx).Warnf("save analysis job %s failure:%v", job.Id, err)
+	}
+}
+
+// GetAnalysisJob 查询任务，运行中的任务返回实时进度
+func (c *CodeWiki) GetAnalysisJob(ctx context.Context, id string) (*v1.AnalysisJob, error) {
+	if job := c.jobs.get(id); job != nil {
+		return job, nil
+	}
+	return c.projectRepo.GetAnalysisJob(ctx, id)
+}
+
+// ListAnalysisJobs 按创建时间倒序列出任务，repoId 为空时列出所有仓库的任务
+func (c *CodeWiki) ListAnalysisJobs(ctx context.Context, repoId string, limit int) ([]*v1.AnalysisJob, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	jobs, err := c.projectRepo.ListAnalysisJobs(ctx, repoId, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i, job := range jobs {
+		if running := c.jobs.get(job.Id); running != nil {
+			jobs[i] = running
+		}
+	}
+	return jobs, nil
+}
+
+// CancelAnalysisJob 取消运行中的任务，任务在当前阶段检查到取消后结束
+func (c *CodeWiki) CancelAnalysisJob(ctx context.Context, id string) error {
+	if c.jobs.cancel(id) {
+		return nil
+	}
+	job, err := c.projectRepo.GetAnalysisJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	if job.Status == v1.JobStatus_Pending || job.Status == v1.JobStatus_Running {
+		return v1.ErrorBizDuplicateOp("job %s is not running on this instance", id)
+	}
+	return v1.ErrorBizDuplicateOp("job %s already finished with status %s", id, job.Status)
+}
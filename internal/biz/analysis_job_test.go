@@ -0,0 +1,214 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// jobProjectRepo 内存中保存任务和快照，blockSave 时 SaveProject 阻塞到任务取消
+type jobProjectRepo struct {
+	discardProjectRepo
+	repo       *v1.Repo
+	blockSave  bool
+	panicSave  bool
+	saving     chan struct{}
+	lock       sync.Mutex
+	jobs       map[string]*v1.AnalysisJob
+	snapshots  []*v1.Snapshot
+	deleted    []string
+	violations map[string][]*v1.ArchitectureViolation
+}
+
+func newJobProjectRepo(repo *v1.Repo) *jobProjectRepo {
+	return &jobProjectRepo{repo: repo, jobs: make(map[string]*v1.AnalysisJob), violations: make(map[string][]*v1.ArchitectureViolation)}
+}
+
+func (r *jobProjectRepo) GetRepo(ctx context.Context, id string) (*v1.Repo, error) {
+	return r.repo, nil
+}
+
+func (r *jobProjectRepo) SaveProject(ctx context.Context, project *Project) error {
+	if r.panicSave {
+		panic("save project")
+	}
+	if !r.blockSave {
+		return nil
+	}
+	close(r.saving)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (r *jobProjectRepo) SaveAnalysisJob(ctx context.Context, job *v1.AnalysisJob) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.jobs[job.Id] = proto.Clone(job).(*v1.AnalysisJob)
+	return nil
+}
+
+func (r *jobProjectRepo) GetAnalysisJob(ctx context.Context, id string) (*v1.AnalysisJob, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, v1.ErrorDataRecordNotFound("analysis job %s not found", id)
+	}
+	return proto.Clone(job).(*v1.AnalysisJob), nil
+}
+
+func (r *jobProjectRepo) FailUnfinishedAnalysisJobs(ctx context.Context, reason string, finishedAt int64) (int64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var count int64
+	for _, job := range r.jobs {
+		if job.Status == v1.JobStatus_Pending || job.Status == v1.JobStatus_Running {
+			job.Status, job.Error, job.FinishedAt = v1.JobStatus_Failed, reason, finishedAt
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *jobProjectRepo) SaveSnapshot(ctx context.Context, snapshot *v1.Snapshot) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	snapshots := []*v1.Snapshot{snapshot}
+	for _, s := range r.snapshots {
+		if s.Id != snapshot.Id {
+			snapshots = append(snapshots, s)
+		}
+	}
+	r.snapshots = snapshots
+	return nil
+}
+
+func (r *jobProjectRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]*v1.Snapshot(nil), r.snapshots...), nil
+}
+
+func (r *jobProjectRepo) DeleteSnapshot(ctx context.Context, snapshot *v1.Snapshot) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deleted = append(r.deleted, snapshot.Id)
+	var snapshots []*v1.Snapshot
+	for _, s := range r.snapshots {
+		if s.Id != snapshot.Id {
+			snapshots = append(snapshots, s)
+		}
+	}
+	r.snapshots = snapshots
+	return nil
+}
+
+func (r *jobProjectRepo) SaveArchitectureViolations(ctx context.Context, snapshotId string, violations []*v1.ArchitectureViolation) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.violations[snapshotId] = violations
+	return nil
+}
+
+func (r *jobProjectRepo) GetFunctionByFileId(ctx context.Context, fileId string) ([]*v1.Function, error) {
+	return nil, nil
+}
+
+func newTestCodeWiki(t *testing.T, projectRepo ProjectRepo) *CodeWiki {
+	return NewCodeWiki(projectRepo, nil, newTestWorkspace(t), &SnapshotRetention{})
+}
+
+func waitJob(t *testing.T, c *CodeWiki, id string) *v1.AnalysisJob {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := c.GetAnalysisJob(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.FinishedAt > 0 {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s not finished", id)
+	return nil
+}
+
+func TestAnalysisJob(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"a.go": "package p\n\nfunc A() { B() }\n",
+		"b.go": "package p\n\nfunc B() {}\n",
+	})
+	projectRepo := newJobProjectRepo(&v1.Repo{Id: "repo", Path: root, Language: v1.Language_Golang})
+	c := newTestCodeWiki(t, projectRepo)
+
+	job, err := c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job = waitJob(t, c, job.Id)
+	if job.Status != v1.JobStatus_Succeeded || job.Phase != v1.AnalysisPhase_PhaseDone {
+		t.Fatalf("job = %+v", job)
+	}
+	if job.Summary == nil || len(job.Summary.Added) != 2 || job.Summary.Analyzed != 2 {
+		t.Errorf("summary = %+v", job.Summary)
+	}
+
+	projectRepo.blockSave = true
+	projectRepo.saving = make(chan struct{})
+	job, err = c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-projectRepo.saving
+	if _, err = c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"}); err == nil {
+		t.Errorf("expected duplicate job error")
+	}
+	if running, _ := c.GetAnalysisJob(context.Background(), job.Id); running.Phase != v1.AnalysisPhase_PhasePersist {
+		t.Errorf("running job phase = %s", running.Phase)
+	}
+	if err = c.CancelAnalysisJob(context.Background(), job.Id); err != nil {
+		t.Fatal(err)
+	}
+	if job = waitJob(t, c, job.Id); job.Status != v1.JobStatus_Canceled {
+		t.Errorf("job = %+v", job)
+	}
+	if err = c.CancelAnalysisJob(context.Background(), job.Id); err == nil {
+		t.Errorf("expected error when canceling finished job")
+	}
+}
+
+func TestAnalysisJobFailure(t *testing.T) {
+	root := writeSources(t, map[string]string{"a.go": "package p\n\nfunc A() {}\n"})
+	projectRepo := newJobProjectRepo(&v1.Repo{Id: "repo", Path: root, Language: v1.Language_Golang})
+	// 上次退出时未结束的任务在启动时标记为失败
+	projectRepo.jobs["stale"] = &v1.AnalysisJob{Id: "stale", RepoId: "repo", Status: v1.JobStatus_Running}
+	projectRepo.jobs["done"] = &v1.AnalysisJob{Id: "done", RepoId: "repo", Status: v1.JobStatus_Succeeded}
+	c := newTestCodeWiki(t, projectRepo)
+	if job, _ := c.GetAnalysisJob(context.Background(), "stale"); job.Status != v1.JobStatus_Failed || job.FinishedAt == 0 {
+		t.Errorf("stale job = %+v", job)
+	}
+	if job, _ := c.GetAnalysisJob(context.Background(), "done"); job.Status != v1.JobStatus_Succeeded {
+		t.Errorf("finished job = %+v", job)
+	}
+
+	// 分析中的 panic 不会让服务退出，任务标记为失败并保存
+	projectRepo.panicSave = true
+	job, err := c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job = waitJob(t, c, job.Id)
+	if job.Status != v1.JobStatus_Failed || !strings.Contains(job.Error, "save project") {
+		t.Errorf("job = %+v", job)
+	}
+	if saved, _ := projectRepo.GetAnalysisJob(context.Background(), job.Id); saved.Status != v1.JobStatus_Failed {
+		t.Errorf("saved job = %+v", saved)
+	}
+}
@@ -0,0 +1,437 @@
+package biz
+
+import (
+	"archive/tar"
+	"bytes"
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// apiSymbolType 导出 API 中的符号类型
+type apiSymbolType int
+
+const (
+	apiFunc apiSymbolType = iota
+	apiMethod
+	apiType
+	apiField
+	apiInterfaceMethod
+	apiValue
+)
+
+// apiSymbol 导出的符号，owner 为成员所属的类型，signature 用于判断变化
+type apiSymbol struct {
+	typ       apiSymbolType
+	owner     string
+	signature string
+}
+
+// apiSurface 包导入路径 -> 包内符号 -> 符号
+type apiSurface map[string]map[string]*apiSymbol
+
+// CheckAPICompatibility 比较 Go 仓库两个版本的导出 API
+// 远端仓库在克隆目录中拉取和读取提交，持有工作区的锁，与同一仓库的分析任务互斥
+func (c *CodeWiki) CheckAPICompatibility(ctx context.Context, req *v1.CheckAPICompatibilityReq) (*v1.CheckAPICompatibilityResp, error) {
+	repo, err := c.projectRepo.GetRepo(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if repo.Language != v1.Language_Golang {
+		return nil, v1.ErrorParamValidate("api compatibility check only supports golang, repo %s is %s", repo.Id, repo.Language)
+	}
+	unlock, err := c.workspace.lock(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	dir, head := c.workspace.RepoDir(repo), req.Head
+	if IsRemote(repo) {
+		if err = c.workspace.fetch(ctx, repo, dir); err != nil {
+			return nil, err
+		}
+		if head == "" {
+			head = repo.Ref
+		}
+		// 远端仓库的工作区是上次分析检出的版本，head 总是从 git 中读取
+		if head == "" {
+			head = "HEAD"
+		}
+	}
+	return CompareAPI(ctx, dir, gitAuthEnv(repo), req.Base, head)
+}
+
+// CompareAPI 比较 dir 所在 git 仓库中 base 和 head 两个版本的导出 API，head 为空时使用工作区
+// 不需要图数据库，供命令行在发布检查中直接调用
+func CompareAPI(ctx context.Context, dir string, env []string, base, head string) (*v1.CheckAPICompatibilityResp, error) {
+	resp := &v1.CheckAPICompatibilityResp{}
+	var err error
+	if resp.Base, err = resolveRef(ctx, dir, env, base); err != nil {
+		return nil, err
+	}
+	if head != "" {
+		if resp.Head, err = resolveRef(ctx, dir, env, head); err != nil {
+			return nil, err
+		}
+	}
+	baseAPI, err := loadAPISurface(ctx, dir, resp.Base)
+	if err != nil {
+		return nil, err
+	}
+	headAPI, err := loadAPISurface(ctx, dir, resp.Head)
+	if err != nil {
+		return nil, err
+	}
+	resp.Changes = diffAPISurface(baseAPI, headAPI)
+	for _, change := range resp.Changes {
+		if change.Breaking {
+			resp.Breaking = true
+			resp.Bump = v1.SemverBump_BumpMajor
+		} else if resp.Bump < v1.SemverBump_BumpMinor {
+			resp.Bump = v1.SemverBump_BumpMinor
+		}
+	}
+	return resp, nil
+}
+
+// loadAPISurface 解析提交 commit 的导出 API，commit 为空时解析工作区
+func loadAPISurface(ctx context.Context, dir, commit string) (apiSurface, error) {
+	if commit == "" {
+		return parseAPISurface(ctx, dir)
+	}
+	tmp, err := os.MkdirTemp("", "codewiki-api-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	archive, err := gitOutput(ctx, dir, nil, "archive", "--format=tar", "--end-of-options", commit)
+	if err != nil {
+		return nil, err
+	}
+	if err = extractTar(archive, tmp); err != nil {
+		return nil, err
+	}
+	return parseAPISurface(ctx, tmp)
+}
+
+// extractTar 把 git archive 的输出解压到 dir，只保留目录和普通文件
+func extractTar(archive []byte, dir string) error {
+	reader := tar.NewReader(bytes.NewReader(archive))
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.FromSlash(path.Clean(header.Name))
+		if !filepath.IsLocal(name) {
+			continue
+		}
+		target := filepath.Join(dir, name)
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0o755)
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(target), 0o755); err == nil {
+				err = writeTarFile(target, reader)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeTarFile(target string, reader io.Reader) error {
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, reader)
+	return err
+}
+
+// parseAPISurface 解析 root 下的 Go 源码，收集非 internal 包中导出的符号，测试文件不计入
+func parseAPISurface(ctx context.Context, root string) (apiSurface, error) {
+	project := NewProject(&v1.Repo{Id: "api", Language: v1.Language_Golang}, nil)
+	if _, err := project.ParseCode(ctx, root); err != nil {
+		return nil, v1.ErrorParseCodeError("parse %s failure", root).WithCause(err)
+	}
+	surface := make(apiSurface)
+	for _, pkg := range project.GetPackages() {
+		_, rel, _ := strings.Cut(nodeKey(pkg.ID), PathSep)
+		rel = strings.ReplaceAll(rel, PathSep, "/")
+		if rel == "internal" || strings.HasPrefix(rel, "internal/") || strings.Contains(rel, "/internal/") || strings.HasSuffix(rel, "/internal") {
+			continue
+		}
+		importPath := project.module
+		if rel != "" {
+			importPath = strings.TrimPrefix(importPath+"/"+rel, "/")
+		}
+		symbols := make(map[string]*apiSymbol)
+		for _, file := range pkg.Files {
+			if strings.HasSuffix(file.Name, "_test.go") {
+				continue
+			}
+			collectFileAPI(file, symbols)
+		}
+		if len(symbols) > 0 {
+			surface[importPath] = symbols
+		}
+	}
+	return surface, nil
+}
+
+func collectFileAPI(file *File, symbols map[string]*apiSymbol) {
+	for _, e := range file.GetEntities() {
+		if !e.Exported {
+			continue
+		}
+		switch e.Type {
+		case Constant, Variable:
+			symbols[e.Name] = &apiSymbol{typ: apiValue, signature: strings.ToLower(e.Type.Type())}
+		case Struct:
+			symbols[e.Name] = &apiSymbol{typ: apiType, signature: "struct"}
+			for _, f := range e.GetFields() {
+				name := f.Name
+				if name == "" {
+					// 嵌入字段以类型名作为字段名
+					name = strings.TrimPrefix(f.ObjType, "*")
+					name = name[strings.LastIndex(name, ".")+1:]
+				}
+				if ast.IsExported(name) {
+					symbols[e.Name+"."+name] = &apiSymbol{typ: apiField, owner: e.Name, signature: f.ObjType}
+				}
+			}
+		case Interface:
+			symbols[e.Name] = &apiSymbol{typ: apiType, signature: "interface"}
+			for _, m := range e.GetMethods() {
+				symbols[e.Name+"."+m.Name] = &apiSymbol{typ: apiInterfaceMethod, owner: e.Name, signature: funcSignature(m)}
+			}
+			for _, embed := range e.rawExtends {
+				name := "embed(" + types.ExprString(embed) + ")"
+				symbols[e.Name+"."+name] = &apiSymbol{typ: apiInterfaceMethod, owner: e.Name, signature: name}
+			}
+		}
+	}
+	for _, f := range file.GetFunctions() {
+		if f.Exported {
+			symbols[f.Name] = &apiSymbol{typ: apiFunc, signature: funcSignature(f)}
+		}
+	}
+	// 其他命名类型和方法直接取自文件中的声明，方法按接收者和方法名区分
+	if file.f1 == nil {
+		return
+	}
+	for _, decl := range file.f1.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.TYPE {
+			for _, spec := range gd.Specs {
+				if ts := spec.(*ast.TypeSpec); ts.Name.IsExported() {
+					symbols[ts.Name.Name] = &apiSymbol{typ: apiType, signature: typeSpecSignature(ts)}
+				}
+			}
+			continue
+		}
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || len(fd.Recv.List) == 0 {
+			continue
+		}
+		receiver := parseReceiver(fd.Recv.List[0].Type)
+		if receiver == "" || !fd.Name.IsExported() || !ast.IsExported(receiver) {
+			continue
+		}
+		symbols[receiver+"."+fd.Name.Name] = &apiSymbol{typ: apiMethod, owner: receiver, signature: declSignature(fd)}
+	}
+}
+
+// declSignature 方法声明的签名，格式与 funcSignature 相同
+func declSignature(fd *ast.FuncDecl) string {
+	return fmt.Sprintf("func (%s)", types.ExprString(fd.Recv.List[0].Type)) + funcTypeSignature(fd.Type)
+}
+
+// typeSpecSignature 命名类型的签名，结构体和接口只区分种类，成员单独比较
+// 其他类型为底层类型，别名以 = 开头，类型参数只保留约束
+func typeSpecSignature(ts *ast.TypeSpec) string {
+	var b strings.Builder
+	if ts.Assign.IsValid() {
+		b.WriteString("= ")
+	}
+	if ts.TypeParams != nil {
+		b.WriteString("[" + strings.Join(astFieldTypes(ts.TypeParams), ", ") + "] ")
+	}
+	switch t := ts.Type.(type) {
+	case *ast.StructType:
+		if !ts.Assign.IsValid() {
+			return b.String() + "struct"
+		}
+	case *ast.InterfaceType:
+		if !ts.Assign.IsValid() {
+			return b.String() + "interface"
+		}
+	case *ast.FuncType:
+		return b.String() + "func" + funcTypeSignature(t)
+	}
+	return b.String() + types.ExprString(ts.Type)
+}
+
+// funcTypeSignature 参数和返回值的类型，不含参数名
+func funcTypeSignature(ft *ast.FuncType) string {
+	var b strings.Builder
+	b.WriteString("(" + strings.Join(astFieldTypes(ft.Params), ", ") + ")")
+	if results := astFieldTypes(ft.Results); len(results) == 1 {
+		b.WriteString(" " + results[0])
+	} else if len(results) > 1 {
+		b.WriteString(" (" + strings.Join(results, ", ") + ")")
+	}
+	return b.String()
+}
+
+// astFieldTypes 参数类型列表，a, b int 展开为两个参数
+func astFieldTypes(fields *ast.FieldList) []string {
+	if fields == nil {
+		return nil
+	}
+	var list []string
+	for _, f := range fields.List {
+		for i := 0; i < max(len(f.Names), 1); i++ {
+			list = append(list, types.ExprString(f.Type))
+		}
+	}
+	return list
+}
+
+// funcSignature 函数签名，只包含接收者、参数和返回值的类型
+func funcSignature(f *Function) string {
+	var b strings.Builder
+	b.WriteString("func")
+	if f.decl != nil && f.decl.Recv != nil && len(f.decl.Recv.List) > 0 {
+		fmt.Fprintf(&b, " (%s)", types.ExprString(f.decl.Recv.List[0].Type))
+	}
+	b.WriteString("(" + strings.Join(fieldTypes(f.Params), ", ") + ")")
+	if results := fieldTypes(f.Results); len(results) == 1 {
+		b.WriteString(" " + results[0])
+	} else if len(results) > 1 {
+		b.WriteString(" (" + strings.Join(results, ", ") + ")")
+	}
+	return b.String()
+}
+
+// fieldTypes 参数类型列表，a, b int 展开为两个参数
+func fieldTypes(fields []*Field) []string {
+	var list []string
+	for _, f := range fields {
+		n := 1
+		if f.field != nil && len(f.field.Names) > 1 {
+			n = len(f.field.Names)
+		}
+		for i := 0; i < n; i++ {
+			list = append(list, f.ObjType)
+		}
+	}
+	return list
+}
+
+// diffAPISurface 比较两个版本的导出 API，类型新增或删除时不再列出其成员
+func diffAPISurface(base, head apiSurface) []*v1.APIChange {
+	var changes []*v1.APIChange
+	for pkg, baseSymbols := range base {
+		headSymbols := head[pkg]
+		for name, before := range baseSymbols {
+			after, ok := headSymbols[name]
+			switch {
+			case !ok:
+				if before.owner != "" && headSymbols[before.owner] == nil && baseSymbols[before.owner] != nil {
+					continue
+				}
+				changes = append(changes, apiChange(removedKinds[before.typ], pkg, name, before, nil))
+			case before.signature != after.signature:
+				changes = append(changes, apiChange(changedKinds[before.typ], pkg, name, before, after))
+			}
+		}
+		for name, after := range headSymbols {
+			if _, ok := baseSymbols[name]; ok {
+				continue
+			}
+			if after.owner != "" && baseSymbols[after.owner] == nil && headSymbols[after.owner] != nil {
+				continue
+			}
+			changes = append(changes, apiChange(addedKinds[after.typ], pkg, name, nil, after))
+		}
+	}
+	for pkg, headSymbols := range head {
+		if _, ok := base[pkg]; ok {
+			continue
+		}
+		for name, after := range headSymbols {
+			if after.owner == "" || headSymbols[after.owner] == nil {
+				changes = append(changes, apiChange(addedKinds[after.typ], pkg, name, nil, after))
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Package != changes[j].Package {
+			return changes[i].Package < changes[j].Package
+		}
+		return changes[i].Symbol < changes[j].Symbol
+	})
+	return changes
+}
+
+var (
+	addedKinds = map[apiSymbolType]v1.APIChangeKind{
+		apiFunc:            v1.APIChangeKind_FuncAdded,
+		apiMethod:          v1.APIChangeKind_MethodAdded,
+		apiType:            v1.APIChangeKind_TypeAdded,
+		apiField:           v1.APIChangeKind_FieldAdded,
+		apiInterfaceMethod: v1.APIChangeKind_InterfaceMethodAdded,
+		apiValue:           v1.APIChangeKind_ValueAdded,
+	}
+	removedKinds = map[apiSymbolType]v1.APIChangeKind{
+		apiFunc:            v1.APIChangeKind_FuncRemoved,
+		apiMethod:          v1.APIChangeKind_MethodRemoved,
+		apiType:            v1.APIChangeKind_TypeRemoved,
+		apiField:           v1.APIChangeKind_FieldRemoved,
+		apiInterfaceMethod: v1.APIChangeKind_InterfaceMethodRemoved,
+		apiValue:           v1.APIChangeKind_ValueRemoved,
+	}
+	changedKinds = map[apiSymbolType]v1.APIChangeKind{
+		apiFunc:            v1.APIChangeKind_SignatureChanged,
+		apiMethod:          v1.APIChangeKind_SignatureChanged,
+		apiType:            v1.APIChangeKind_TypeKindChanged,
+		apiField:           v1.APIChangeKind_FieldTypeChanged,
+		apiInterfaceMethod: v1.APIChangeKind_SignatureChanged,
+		apiValue:           v1.APIChangeKind_ValueKindChanged,
+	}
+	// compatibleKinds 不影响已有调用方和实现方的变更
+	compatibleKinds = map[v1.APIChangeKind]bool{
+		v1.APIChangeKind_FuncAdded:   true,
+		v1.APIChangeKind_MethodAdded: true,
+		v1.APIChangeKind_TypeAdded:   true,
+		v1.APIChangeKind_FieldAdded:  true,
+		v1.APIChangeKind_ValueAdded:  true,
+	}
+)
+
+func apiChange(kind v1.APIChangeKind, pkg, symbol string, before, after *apiSymbol) *v1.APIChange {
+	change := &v1.APIChange{Kind: kind, Package: pkg, Symbol: symbol, Breaking: !compatibleKinds[kind]}
+	if before != nil {
+		change.Before = before.signature
+	}
+	if after != nil {
+		change.After = after.signature
+	}
+	return change
+}
@@ -0,0 +1,242 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompareAPI(t *testing.T) {
+	dir := writeSources(t, map[string]string{
+		"go.mod": "module example.com/lib\n\ngo 1.22\n",
+		"lib.go": `package lib
+
+type Store interface {
+	Get(key string) (string, error)
+}
+
+type Options struct {
+	Addr, Name string
+	Timeout    int
+}
+
+func (o *Options) Validate() error { return nil }
+
+func Open(addr string) *Options { return nil }
+
+func Close() {}
+
+const Version = "1"
+`,
+		"internal/util/util.go": "package util\n\nfunc Helper() {}\n",
+	})
+	runGit(t, dir, "init", "--quiet")
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "--quiet", "-m", "v1")
+	runGit(t, dir, "tag", "v1")
+
+	changes := func(resp *v1.CheckAPICompatibilityResp) map[string]v1.APIChangeKind {
+		kinds := make(map[string]v1.APIChangeKind)
+		for _, c := range resp.Changes {
+			kinds[c.Package+"."+c.Symbol] = c.Kind
+		}
+		return kinds
+	}
+
+	// 只有兼容的新增
+	if err := os.WriteFile(filepath.Join(dir, "extra.go"), []byte("package lib\n\nfunc Dial() {}\n\ntype Client struct{ Addr string }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := CompareAPI(context.Background(), dir, nil, "v1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]v1.APIChangeKind{
+		"example.com/lib.Dial":   v1.APIChangeKind_FuncAdded,
+		"example.com/lib.Client": v1.APIChangeKind_TypeAdded,
+	}
+	if got := changes(resp); !reflect.DeepEqual(got, want) || resp.Breaking || resp.Bump != v1.SemverBump_BumpMinor || resp.Head != "" {
+		t.Errorf("compatible changes = %v, %+v", got, resp)
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "lib.go"), []byte(`package lib
+
+type Store interface {
+	Get(key string) (string, error)
+	Delete(key string) error
+}
+
+type Options struct {
+	Addr    string
+	Timeout int64
+}
+
+func (o Options) Validate() error { return nil }
+
+func Open(addr string, name string) *Options { return nil }
+
+var Version = "2"
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "internal", "util", "util.go"), []byte("package util\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "commit", "--quiet", "-m", "v2")
+	if resp, err = CompareAPI(context.Background(), dir, nil, "v1", "HEAD"); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]v1.APIChangeKind{
+		"example.com/lib.Dial":             v1.APIChangeKind_FuncAdded,
+		"example.com/lib.Client":           v1.APIChangeKind_TypeAdded,
+		"example.com/lib.Store.Delete":     v1.APIChangeKind_InterfaceMethodAdded,
+		"example.com/lib.Options.Name":     v1.APIChangeKind_FieldRemoved,
+		"example.com/lib.Options.Timeout":  v1.APIChangeKind_FieldTypeChanged,
+		"example.com/lib.Options.Validate": v1.APIChangeKind_SignatureChanged,
+		"example.com/lib.Open":             v1.APIChangeKind_SignatureChanged,
+		"example.com/lib.Close":            v1.APIChangeKind_FuncRemoved,
+		"example.com/lib.Version":          v1.APIChangeKind_ValueKindChanged,
+	}
+	if got := changes(resp); !reflect.DeepEqual(got, want) {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+	if !resp.Breaking || resp.Bump != v1.SemverBump_BumpMajor || resp.Head == "" {
+		t.Errorf("resp = %+v", resp)
+	}
+
+	if _, err = CompareAPI(context.Background(), dir, nil, "missing", ""); err == nil {
+		t.Errorf("expected error for missing base revision")
+	}
+}
+
+func TestAPISurfaceMethods(t *testing.T) {
+	// 同一文件中不同接收者的同名方法都计入，泛型接收者去掉类型参数
+	files := map[string]string{
+		"go.mod": "module example.com/lib\n\ngo 1.22\n",
+		"lib.go": `package lib
+
+type A struct{}
+
+type B struct{}
+
+type Box[K comparable, V any] struct{}
+
+func (A) Get() int { return 0 }
+
+func (*B) Get() string { return "" }
+
+func (b Box[K, V]) Get(key K) V { var v V; return v }
+`,
+	}
+	base, err := parseAPISurface(context.Background(), writeSources(t, files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for name, symbol := range base["example.com/lib"] {
+		if symbol.typ == apiMethod {
+			got[name] = symbol.signature
+		}
+	}
+	want := map[string]string{
+		"A.Get":   "func (A)() int",
+		"B.Get":   "func (*B)() string",
+		"Box.Get": "func (Box[K, V])(K) V",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("methods = %v, want %v", got, want)
+	}
+
+	files["lib.go"] = strings.Replace(files["lib.go"], "func (*B) Get() string { return \"\" }\n", "", 1)
+	head, err := parseAPISurface(context.Background(), writeSources(t, files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := diffAPISurface(base, head)
+	if len(changes) != 1 || changes[0].Symbol != "B.Get" || changes[0].Kind != v1.APIChangeKind_MethodRemoved {
+		t.Errorf("changes = %v", changes)
+	}
+}
+
+func TestAPISurfaceNamedTypes(t *testing.T) {
+	// 结构体和接口以外的命名类型和别名按底层类型比较，函数类型的参数名不计入
+	surface := func(src string) apiSurface {
+		t.Helper()
+		s, err := parseAPISurface(context.Background(), writeSources(t, map[string]string{
+			"go.mod": "module example.com/lib\n\ngo 1.22\n",
+			"lib.go": src,
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	base := surface(`package lib
+
+type Options struct{}
+
+type Status int
+
+type HandlerFunc func(name string) error
+
+type Alias = Options
+
+type Level int
+
+type Callback func()
+
+type Opts = Options
+`)
+	head := surface(`package lib
+
+type Options struct{}
+
+type Status string
+
+type HandlerFunc func(key string, n int) error
+
+type Alias = Status
+`)
+	got := make(map[string]v1.APIChangeKind)
+	for _, c := range diffAPISurface(base, head) {
+		got[c.Symbol] = c.Kind
+	}
+	want := map[string]v1.APIChangeKind{
+		"Status":      v1.APIChangeKind_TypeKindChanged,
+		"HandlerFunc": v1.APIChangeKind_TypeKindChanged,
+		"Alias":       v1.APIChangeKind_TypeKindChanged,
+		"Level":       v1.APIChangeKind_TypeRemoved,
+		"Callback":    v1.APIChangeKind_TypeRemoved,
+		"Opts":        v1.APIChangeKind_TypeRemoved,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+
+	if changes := diffAPISurface(base, surface(`package lib
+
+type Options struct{}
+
+type Status int
+
+type HandlerFunc func(key string) error
+
+type Alias = Options
+
+type Level int
+
+type Callback func()
+
+type Opts = Options
+`)); len(changes) != 0 {
+		t.Errorf("renamed parameter changes = %v", changes)
+	}
+	if sig := head["example.com/lib"]["Alias"].signature; sig != "= Status" {
+		t.Errorf("alias signature = %q", sig)
+	}
+}
@@ -0,0 +1,255 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"path"
+	"sort"
+	"strings"
+)
+
+// GetArchitectureViolations 快照分析后保存的违反架构规则的依赖和调用
+func (c *CodeWiki) GetArchitectureViolations(ctx context.Context, req *v1.GetArchitectureViolationsReq) (*v1.GetArchitectureViolationsResp, error) {
+	s, err := c.resolveSnapshot(ctx, req.Id, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return &v1.GetArchitectureViolationsResp{}, nil
+	}
+	violations, err := c.projectRepo.ListArchitectureViolations(ctx, s.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetArchitectureViolationsResp{Snapshot: s.Id, Violations: violations}, nil
+}
+
+// checkArchitecture 按仓库的架构规则检查保存后的快照，记录违规
+// 增量分析时未变化文件的关系只在仓储中，因此从仓储读取整个快照
+func (c *CodeWiki) checkArchitecture(ctx context.Context, project *Project) error {
+	snapshot := project.SnapshotID()
+	var violations []*v1.ArchitectureViolation
+	if len(project.Repo.Rules) > 0 {
+		graph, err := c.projectRepo.GetSnapshotGraph(ctx, snapshot)
+		if err != nil {
+			return err
+		}
+		violations = CheckArchitecture(project.Repo.Rules, graph)
+	}
+	return c.projectRepo.SaveArchitectureViolations(ctx, snapshot, violations)
+}
+
+// CheckArchitectureDir 分析本地目录中的源码并按规则检查，不需要仓储
+func CheckArchitectureDir(ctx context.Context, dir string, language v1.Language, rules []*v1.ArchitectureRule) ([]*v1.ArchitectureViolation, error) {
+	if err := validateArchitectureRules(rules); err != nil {
+		return nil, err
+	}
+	project := NewProject(&v1.Repo{Id: "arch", Path: dir, Language: language}, nil)
+	if project.frontend == nil {
+		return nil, v1.ErrorParseCodeError("language %s not supported", language)
+	}
+	root, err := project.ParseCode(ctx, dir)
+	if err != nil {
+		return nil, v1.ErrorParseCodeError("parse %s failure", dir).WithCause(err)
+	}
+	project.pkgs[root.ID] = root
+	root.ClassifyExtends(ctx)
+	root.ClassifyMethod(ctx)
+	project.Root = root
+	if err = project.AnalyzeRelations(ctx); err != nil {
+		return nil, err
+	}
+	return CheckArchitecture(rules, projectGraph(project)), nil
+}
+
+// validateArchitectureRules 检查规则中的模式，依赖规则只能匹配包
+func validateArchitectureRules(rules []*v1.ArchitectureRule) error {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return v1.ErrorParamValidate("rule %s: %v", rule.Name, err)
+		}
+		for _, pattern := range []string{rule.From, rule.To} {
+			pkg, symbol, hasSymbol := strings.Cut(pattern, ":")
+			if hasSymbol && rule.Kind != v1.ArchitectureRuleKind_RuleForbidCall {
+				return v1.ErrorParamValidate("rule %s: dependency pattern %s can not match functions", rule.Name, pattern)
+			}
+			for _, p := range append(strings.Split(pkg, "/"), symbol) {
+				if _, err := path.Match(p, ""); err != nil {
+					return v1.ErrorParamValidate("rule %s: bad pattern %s", rule.Name, pattern)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CheckArchitecture 按规则检查快照图中包之间的导入和函数之间的调用，结果按规则、源、目标排序
+func CheckArchitecture(rules []*v1.ArchitectureRule, graph *SnapshotGraph) []*v1.ArchitectureViolation {
+	packages := make(map[string]bool)
+	for _, p := range graph.Packages {
+		packages[p.ID] = true
+	}
+	owners := make(map[string]string)
+	for _, f := range graph.Files {
+		owners[f.ID] = f.ParentID
+	}
+	for _, f := range graph.Functions {
+		owners[f.ID] = owners[f.ParentID]
+	}
+
+	var violations []*v1.ArchitectureViolation
+	seen := make(map[string]bool)
+	for _, rel := range graph.Relations {
+		var source, target string
+		switch {
+		case rel.Type == Imports && packages[rel.TargetID]:
+			// 文件的导入汇总为包之间的依赖
+			source, target = owners[rel.SourceID], rel.TargetID
+		case rel.Type == Call && owners[rel.SourceID] != "" && owners[rel.TargetID] != "":
+			source, target = rel.SourceID, rel.TargetID
+		default:
+			continue
+		}
+		key := rel.Type + "#" + source + "#" + target
+		if source == "" || source == target || seen[key] {
+			continue
+		}
+		seen[key] = true
+		from, to := newArchNode(source, owners), newArchNode(target, owners)
+		if rel.Type == Call {
+			violations = append(violations, callViolations(rules, from, to)...)
+		} else {
+			violations = append(violations, dependencyViolations(rules, from, to)...)
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		a, b := violations[i], violations[j]
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		if a.SourcePath != b.SourcePath {
+			return a.SourcePath < b.SourcePath
+		}
+		return a.TargetPath < b.TargetPath
+	})
+	return violations
+}
+
+// archNode 规则匹配的节点：相对仓库根目录的包路径，函数带函数名称
+type archNode struct {
+	id, pkg, symbol string
+}
+
+func (n archNode) String() string {
+	if n.symbol == "" {
+		return n.pkg
+	}
+	return n.pkg + ":" + n.symbol
+}
+
+// newArchNode 包节点的包为自身，函数节点的包为所在文件的包
+func newArchNode(id string, owners map[string]string) archNode {
+	pkg := id
+	n := archNode{id: id}
+	if owner, ok := owners[id]; ok {
+		pkg = owner
+		if _, symbol, found := strings.Cut(nodeKey(id), ":"); found {
+			n.symbol = symbol
+		}
+	}
+	n.pkg = repoRelativePath(pkg)
+	return n
+}
+
+// dependencyViolations source 包导入 target 包违反的依赖规则
+func dependencyViolations(rules []*v1.ArchitectureRule, source, target archNode) []*v1.ArchitectureViolation {
+	var violations []*v1.ArchitectureViolation
+	var allow *v1.ArchitectureRule
+	allowed := false
+	for _, rule := range rules {
+		if !matchArchPattern(rule.From, source) {
+			continue
+		}
+		switch rule.Kind {
+		case v1.ArchitectureRuleKind_RuleForbidDependency:
+			if matchArchPattern(rule.To, target) {
+				violations = append(violations, newViolation(rule, source, target))
+			}
+		case v1.ArchitectureRuleKind_RuleAllowDependency:
+			if allow == nil {
+				allow = rule
+			}
+			allowed = allowed || matchArchPattern(rule.To, target)
+		}
+	}
+	if allow != nil && !allowed {
+		violations = append(violations, newViolation(allow, source, target))
+	}
+	return violations
+}
+
+// callViolations source 函数调用 target 函数违反的调用规则
+func callViolations(rules []*v1.ArchitectureRule, source, target archNode) []*v1.ArchitectureViolation {
+	var violations []*v1.ArchitectureViolation
+	for _, rule := range rules {
+		if rule.Kind == v1.ArchitectureRuleKind_RuleForbidCall && matchArchPattern(rule.From, source) && matchArchPattern(rule.To, target) {
+			violations = append(violations, newViolation(rule, source, target))
+		}
+	}
+	return violations
+}
+
+func newViolation(rule *v1.ArchitectureRule, source, target archNode) *v1.ArchitectureViolation {
+	return &v1.ArchitectureViolation{
+		Rule:       rule.Name,
+		Kind:       rule.Kind,
+		Source:     source.id,
+		Target:     target.id,
+		SourcePath: source.String(),
+		TargetPath: target.String(),
+	}
+}
+
+// matchArchPattern 包模式按 / 分段匹配，* 匹配一段，** 匹配任意多段，. 为根目录
+// 带 :函数模式 时只匹配函数，不带时匹配包及包中的所有函数
+func matchArchPattern(pattern string, node archNode) bool {
+	pkg, symbol, hasSymbol := strings.Cut(pattern, ":")
+	if hasSymbol {
+		if node.symbol == "" {
+			return false
+		}
+		if ok, _ := path.Match(symbol, node.symbol); !ok {
+			return false
+		}
+	}
+	return matchSegments(splitArchPath(pkg), splitArchPath(node.pkg))
+}
+
+func splitArchPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" || p == "." {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
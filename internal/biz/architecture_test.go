@@ -0,0 +1,88 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCheckArchitectureDir(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"internal/data/data.go": `package data
+
+type Data struct{}
+
+func NewData() *Data { return &Data{} }
+
+func (d *Data) Load() string { return "" }
+`,
+		"internal/biz/biz.go": `package biz
+
+import "example.com/app/internal/data"
+
+func Load() string { return data.NewData().Load() }
+`,
+		"internal/service/service.go": `package service
+
+import (
+	"example.com/app/internal/biz"
+	"example.com/app/internal/data"
+)
+
+func Serve() string {
+	d := data.NewData()
+	return biz.Load() + d.Load()
+}
+`,
+	})
+	rules := []*v1.ArchitectureRule{
+		{Name: "biz-no-data", Kind: v1.ArchitectureRuleKind_RuleForbidDependency, From: "internal/biz/**", To: "internal/data/**"},
+		{Name: "service-layer", Kind: v1.ArchitectureRuleKind_RuleAllowDependency, From: "internal/service", To: "internal/biz"},
+		{Name: "no-new-data", Kind: v1.ArchitectureRuleKind_RuleForbidCall, From: "internal/*", To: "internal/data:New*"},
+	}
+	violations, err := CheckArchitectureDir(context.Background(), root, v1.Language_Golang, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.Rule+" "+v.SourcePath+" -> "+v.TargetPath)
+	}
+	want := []string{
+		"biz-no-data internal/biz -> internal/data",
+		"no-new-data internal/biz:Load -> internal/data:NewData",
+		"no-new-data internal/service:Serve -> internal/data:NewData",
+		"service-layer internal/service -> internal/data",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("violations = %v, want %v", got, want)
+	}
+
+	_, err = CheckArchitectureDir(context.Background(), root, v1.Language_Golang, []*v1.ArchitectureRule{
+		{Name: "bad", Kind: v1.ArchitectureRuleKind_RuleForbidDependency, From: "internal/biz", To: "internal/data:New*"},
+	})
+	if !v1.IsParamValidate(err) {
+		t.Errorf("err = %v, want param validate", err)
+	}
+}
+
+func TestMatchArchPattern(t *testing.T) {
+	for _, tt := range []struct {
+		pattern, pkg, symbol string
+		want                 bool
+	}{
+		{"internal/biz/**", "internal/biz", "", true},
+		{"internal/biz/**", "internal/biz/sub/x", "", true},
+		{"internal/*", "internal/biz/sub", "", false},
+		{"**/data", "internal/data", "Load", true},
+		{".", "", "", true},
+		{"internal/data:*.Load", "internal/data", "Data.Load", true},
+		{"internal/data:*.Load", "internal/data", "", false},
+	} {
+		if got := matchArchPattern(tt.pattern, archNode{pkg: tt.pkg, symbol: tt.symbol}); got != tt.want {
+			t.Errorf("match(%s, %s:%s) = %v, want %v", tt.pattern, tt.pkg, tt.symbol, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+)
+
+const (
+	defaultCallDepth = 10
+	defaultCallNodes = 500
+)
+
+// callRelationTypes 调用链可以展开的关系
+var callRelationTypes = map[string]bool{
+	Call:         true,
+	DispatchesTo: true,
+}
+
+// CallEdge 与展开的函数相连的一条调用关系，From 为被展开的函数，To 为另一端
+type CallEdge struct {
+	From         string
+	To           string
+	Relationship *v1.CallRelationship
+}
+
+// callCursor 从 frontier 中的函数继续展开时需要的参数
+type callCursor struct {
+	ID            string           `json:"id"`
+	Direction     v1.CallDirection `json:"direction"`
+	RelationTypes []string         `json:"relationTypes"`
+	ExcludeTests  bool             `json:"excludeTests,omitempty"`
+}
+
+func encodeCallCursor(c *callCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCallCursor(cursor string) (*callCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, v1.ErrorParamValidate("invalid cursor %s", cursor)
+	}
+	c := &callCursor{}
+	if err = json.Unmarshal(data, c); err != nil || c.ID == "" {
+		return nil, v1.ErrorParamValidate("invalid cursor %s", cursor)
+	}
+	return c, nil
+}
+
+// QueryCallChain 从 req.Id 开始逐层展开调用关系，达到层数或节点数限制时返回未展开完的函数
+func (c *CodeWiki) QueryCallChain(ctx context.Context, req *v1.CallChainReq) (*v1.CallChainResp, error) {
+	start := &callCursor{Direction: req.Direction, RelationTypes: req.RelationTypes, ExcludeTests: req.ExcludeTests}
+	if req.Cursor != "" {
+		cursor, err := decodeCallCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = cursor
+	} else {
+		id, err := c.snapshotNodeID(ctx, req.Id, req.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		start.ID = id
+		if len(start.RelationTypes) == 0 {
+			start.RelationTypes = []string{Call}
+			if req.FollowDispatch {
+				start.RelationTypes = append(start.RelationTypes, DispatchesTo)
+			}
+		}
+	}
+	for _, t := range start.RelationTypes {
+		if !callRelationTypes[t] {
+			return nil, v1.ErrorParamValidate("relation type %s can not be expanded in call chain", t)
+		}
+	}
+	maxDepth, maxNodes := int(req.MaxDepth), int(req.MaxNodes)
+	if maxDepth <= 0 {
+		maxDepth = defaultCallDepth
+	}
+	if maxNodes <= 0 {
+		maxNodes = defaultCallNodes
+	}
+	return expandCallChain(ctx, c.projectRepo, start, maxDepth, maxNodes)
+}
+
+// expandCallChain 广度优先展开，每层查询一次图数据库
+// 每层的关系按 From 排序，被截断时 From 不小于截断位置的函数都没有展开完
+func expandCallChain(ctx context.Context, repo ProjectRepo, start *callCursor, maxDepth, maxNodes int) (*v1.CallChainResp, error) {
+	resp := &v1.CallChainResp{}
+	depths := map[string]int{start.ID: 0}
+	relations := make(map[string]bool)
+	var unfinished []string
+	level := []string{start.ID}
+	for depth := 0; len(level) > 0; depth++ {
+		if depth == maxDepth {
+			// 最后一层不再展开，只判断哪些函数还有关系
+			edges, err := repo.QueryCallEdges(ctx, level, start.Direction, start.RelationTypes, len(level), start.ExcludeTests)
+			if err != nil {
+				return nil, err
+			}
+			seen := make(map[string]bool)
+			for _, e := range edges {
+				seen[e.From] = true
+			}
+			if len(edges) == len(level) {
+				for _, id := range fromOnward(level, edges[len(edges)-1].From) {
+					seen[id] = true
+				}
+			}
+			for _, id := range level {
+				if seen[id] {
+					unfinished = append(unfinished, id)
+				}
+			}
+			break
+		}
+		limit := maxNodes * 4
+		edges, err := repo.QueryCallEdges(ctx, level, start.Direction, start.RelationTypes, limit, start.ExcludeTests)
+		if err != nil {
+			return nil, err
+		}
+		var next []string
+		full := false
+		for _, e := range edges {
+			if _, ok := depths[e.To]; !ok {
+				if len(depths) >= maxNodes {
+					// 节点数已满，这一层从 e.From 开始的函数和新加入的函数都没有展开完
+					unfinished = append(unfinished, fromOnward(level, e.From)...)
+					unfinished = append(unfinished, next...)
+					full = true
+					break
+				}
+				depths[e.To] = depth + 1
+				next = append(next, e.To)
+			}
+			r := e.Relationship
+			if key := r.CallerId + "#" + r.Relation + "#" + r.CalleeId; !relations[key] {
+				relations[key] = true
+				resp.CallRelations = append(resp.CallRelations, r)
+			}
+		}
+		if full {
+			break
+		}
+		if len(edges) == limit {
+			unfinished = append(unfinished, fromOnward(level, edges[len(edges)-1].From)...)
+		}
+		level = next
+	}
+	for _, id := range unfinished {
+		resp.Frontier = append(resp.Frontier, &v1.CallFrontier{
+			Id:     id,
+			Depth:  int32(depths[id]),
+			Cursor: encodeCallCursor(&callCursor{ID: id, Direction: start.Direction, RelationTypes: start.RelationTypes, ExcludeTests: start.ExcludeTests}),
+		})
+	}
+	resp.Truncated = len(resp.Frontier) > 0
+	return resp, nil
+}
+
+// fromOnward level 中不小于 from 的函数
+func fromOnward(level []string, from string) []string {
+	var ids []string
+	for _, id := range level {
+		if id >= from {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
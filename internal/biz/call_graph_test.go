@@ -0,0 +1,194 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// callEdgeRepo 内存中的调用关系
+type callEdgeRepo struct {
+	discardProjectRepo
+	relations []*Relation
+	// testCode 测试文件中的函数
+	testCode map[string]bool
+}
+
+func (r callEdgeRepo) QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*CallEdge, error) {
+	from := make(map[string]bool)
+	for _, id := range ids {
+		from[id] = true
+	}
+	types := make(map[string]bool)
+	for _, t := range relationTypes {
+		types[t] = true
+	}
+	var edges []*CallEdge
+	for _, rel := range r.relations {
+		if !types[rel.Type] {
+			continue
+		}
+		relationship := &v1.CallRelationship{CallerId: rel.SourceID, CalleeId: rel.TargetID, Relation: rel.Type}
+		if direction != v1.CallDirection_CallIncoming && from[rel.SourceID] && !(excludeTests && r.testCode[rel.TargetID]) {
+			edges = append(edges, &CallEdge{From: rel.SourceID, To: rel.TargetID, Relationship: relationship})
+		}
+		if direction != v1.CallDirection_CallOutgoing && from[rel.TargetID] && !(excludeTests && r.testCode[rel.SourceID]) {
+			edges = append(edges, &CallEdge{From: rel.TargetID, To: rel.SourceID, Relationship: relationship})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	if len(edges) > limit {
+		edges = edges[:limit]
+	}
+	return edges, nil
+}
+
+func TestQueryCallChain(t *testing.T) {
+	var relations []*Relation
+	for _, e := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"d", "e"}, {"e", "f"}} {
+		relations = append(relations, &Relation{Type: Call, SourceID: e[0], TargetID: e[1]})
+	}
+	relations = append(relations, &Relation{Type: DispatchesTo, SourceID: "f", TargetID: "g"})
+	c := newTestCodeWiki(t, callEdgeRepo{relations: relations, testCode: map[string]bool{"c": true}})
+
+	query := func(req *v1.CallChainReq) (*v1.CallChainResp, []string) {
+		t.Helper()
+		resp, err := c.QueryCallChain(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var calls []string
+		for _, r := range resp.CallRelations {
+			calls = append(calls, r.CallerId+"->"+r.CalleeId)
+		}
+		sort.Strings(calls)
+		return resp, calls
+	}
+	frontier := func(resp *v1.CallChainResp) map[string]int32 {
+		ids := make(map[string]int32)
+		for _, f := range resp.Frontier {
+			ids[f.Id] = f.Depth
+		}
+		return ids
+	}
+
+	resp, calls := query(&v1.CallChainReq{Id: "a"})
+	if want := []string{"a->b", "a->c", "b->d", "c->d", "d->e", "e->f"}; !reflect.DeepEqual(calls, want) || resp.Truncated {
+		t.Errorf("calls = %v, truncated = %v", calls, resp.Truncated)
+	}
+	if _, calls = query(&v1.CallChainReq{Id: "a", FollowDispatch: true}); len(calls) != 7 {
+		t.Errorf("calls with dispatch = %v", calls)
+	}
+
+	// 不展开测试文件中的函数，frontier 的 cursor 保留该选项
+	resp, calls = query(&v1.CallChainReq{Id: "a", ExcludeTests: true, MaxDepth: 1})
+	if !reflect.DeepEqual(calls, []string{"a->b"}) || len(resp.Frontier) != 1 {
+		t.Errorf("calls without tests = %v, frontier = %v", calls, resp.Frontier)
+	}
+	if next, err := decodeCallCursor(resp.Frontier[0].Cursor); err != nil || !next.ExcludeTests {
+		t.Errorf("cursor = %+v, %v", next, err)
+	}
+
+	// 层数限制
+	resp, calls = query(&v1.CallChainReq{Id: "a", MaxDepth: 2})
+	if want := []string{"a->b", "a->c", "b->d", "c->d"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("depth limited calls = %v", calls)
+	}
+	if got := frontier(resp); !resp.Truncated || !reflect.DeepEqual(got, map[string]int32{"d": 2}) {
+		t.Errorf("depth limited frontier = %v", got)
+	}
+
+	// 节点数限制，b、c 的关系没有展开
+	resp, calls = query(&v1.CallChainReq{Id: "a", MaxNodes: 3})
+	if want := []string{"a->b", "a->c"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("node limited calls = %v", calls)
+	}
+	if got := frontier(resp); !resp.Truncated || !reflect.DeepEqual(got, map[string]int32{"b": 1, "c": 1}) {
+		t.Errorf("node limited frontier = %v", got)
+	}
+
+	// 从 frontier 继续展开
+	var cursor string
+	for _, f := range resp.Frontier {
+		if f.Id == "b" {
+			cursor = f.Cursor
+		}
+	}
+	if resp, calls = query(&v1.CallChainReq{Cursor: cursor}); !reflect.DeepEqual(calls, []string{"b->d", "d->e", "e->f"}) || resp.Truncated {
+		t.Errorf("expanded calls = %v", calls)
+	}
+
+	// 反向和双向展开
+	if _, calls = query(&v1.CallChainReq{Id: "d", Direction: v1.CallDirection_CallIncoming}); !reflect.DeepEqual(calls, []string{"a->b", "a->c", "b->d", "c->d"}) {
+		t.Errorf("incoming calls = %v", calls)
+	}
+	if _, calls = query(&v1.CallChainReq{Id: "d", Direction: v1.CallDirection_CallBoth, MaxDepth: 1}); !reflect.DeepEqual(calls, []string{"b->d", "c->d", "d->e"}) {
+		t.Errorf("both direction calls = %v", calls)
+	}
+
+	for _, req := range []*v1.CallChainReq{
+		{Id: "a", RelationTypes: []string{"Call] DETACH DELETE n //"}},
+		{Id: "a", RelationTypes: []string{Contains}},
+		{Cursor: "not a cursor"},
+	} {
+		if _, err := c.QueryCallChain(context.Background(), req); err == nil {
+			t.Errorf("expected error for %v", req)
+		}
+	}
+}
+
+func TestQueryCallPath(t *testing.T) {
+	var relations []*Relation
+	for _, e := range [][3]string{
+		{"handler", "service", Call}, {"service", "repo", Call}, {"repo", "Repo.Find", Call},
+		{"Repo.Find", "mysqlRepo.Find", DispatchesTo}, {"handler", "cache", Call}, {"cache", "mysqlRepo.Find", Call},
+		{"service", "helper", Call}, {"helper", "service", Call}, {"helper", "other", Call},
+	} {
+		relations = append(relations, &Relation{Type: e[2], SourceID: e[0], TargetID: e[1]})
+	}
+	c := newTestCodeWiki(t, callEdgeRepo{relations: relations})
+	paths := func(req *v1.CallPathReq) []string {
+		t.Helper()
+		resp, err := c.QueryCallPath(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var paths []string
+		for _, p := range resp.Paths {
+			path := p.Hops[0].CallerId
+			for _, hop := range p.Hops {
+				path += ">" + hop.CalleeId
+			}
+			paths = append(paths, path)
+		}
+		return paths
+	}
+
+	if got := paths(&v1.CallPathReq{Source: "handler", Target: "mysqlRepo.Find"}); !reflect.DeepEqual(got, []string{"handler>cache>mysqlRepo.Find"}) {
+		t.Errorf("shortest = %v", got)
+	}
+	want := []string{"handler>cache>mysqlRepo.Find", "handler>service>repo>Repo.Find>mysqlRepo.Find"}
+	if got := paths(&v1.CallPathReq{Source: "handler", Target: "mysqlRepo.Find", K: 5}); !reflect.DeepEqual(got, want) {
+		t.Errorf("k shortest = %v, want %v", got, want)
+	}
+	// 不经过接口分派时只能走缓存
+	if got := paths(&v1.CallPathReq{Source: "handler", Target: "mysqlRepo.Find", K: 5, RelationTypes: []string{Call}}); !reflect.DeepEqual(got, want[:1]) {
+		t.Errorf("call only = %v", got)
+	}
+	if got := paths(&v1.CallPathReq{Source: "handler", Target: "mysqlRepo.Find", MaxDepth: 1}); len(got) != 0 {
+		t.Errorf("depth limited = %v", got)
+	}
+	if got := paths(&v1.CallPathReq{Source: "mysqlRepo.Find", Target: "handler"}); len(got) != 0 {
+		t.Errorf("reverse = %v", got)
+	}
+	if _, err := c.QueryCallPath(context.Background(), &v1.CallPathReq{Source: "handler", Target: "handler"}); err == nil {
+		t.Errorf("expected error for same source and target")
+	}
+}
@@ -0,0 +1,179 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultPathDepth = 10
+	// maxPathNodes 查找路径时最多展开的函数数
+	maxPathNodes = 5000
+)
+
+// QueryCallPath 查找 source 到 target 最短的 k 条调用路径
+// 先从 source 逐层展开 maxDepth 层得到子图，再在子图上按 Yen 算法求 k 条最短无环路径
+func (c *CodeWiki) QueryCallPath(ctx context.Context, req *v1.CallPathReq) (*v1.CallPathResp, error) {
+	source, err := c.snapshotNodeID(ctx, req.Source, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	target, err := c.snapshotNodeID(ctx, req.Target, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if source == target {
+		return nil, v1.ErrorParamValidate("source and target are the same function %s", source)
+	}
+	relationTypes := req.RelationTypes
+	if len(relationTypes) == 0 {
+		relationTypes = []string{Call, DispatchesTo}
+	}
+	for _, t := range relationTypes {
+		if !callRelationTypes[t] {
+			return nil, v1.ErrorParamValidate("relation type %s can not be used in call path", t)
+		}
+	}
+	k, maxDepth := int(req.K), int(req.MaxDepth)
+	if k <= 0 {
+		k = 1
+	}
+	if maxDepth <= 0 {
+		maxDepth = defaultPathDepth
+	}
+	graph, truncated, err := loadCallGraph(ctx, c.projectRepo, source, target, relationTypes, maxDepth, k, req.ExcludeTests)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.CallPathResp{Truncated: truncated}
+	for _, path := range graph.kShortestPaths(source, target, k) {
+		hops := make([]*v1.CallRelationship, 0, len(path))
+		for _, e := range path {
+			hops = append(hops, e.Relationship)
+		}
+		resp.Paths = append(resp.Paths, &v1.FunctionPath{Hops: hops})
+	}
+	return resp, nil
+}
+
+// callGraph 从起点展开得到的调用子图
+type callGraph struct {
+	edges map[string][]*CallEdge
+}
+
+// loadCallGraph 从 source 逐层展开，只需要最短路径时找到 target 后不再展开
+func loadCallGraph(ctx context.Context, repo ProjectRepo, source, target string, relationTypes []string, maxDepth, k int, excludeTests bool) (*callGraph, bool, error) {
+	g := &callGraph{edges: make(map[string][]*CallEdge)}
+	visited := map[string]bool{source: true}
+	level := []string{source}
+	for depth := 0; depth < maxDepth && len(level) > 0; depth++ {
+		limit := maxPathNodes * 4
+		edges, err := repo.QueryCallEdges(ctx, level, v1.CallDirection_CallOutgoing, relationTypes, limit, excludeTests)
+		if err != nil {
+			return nil, false, err
+		}
+		var next []string
+		for _, e := range edges {
+			g.edges[e.From] = append(g.edges[e.From], e)
+			if !visited[e.To] {
+				visited[e.To] = true
+				next = append(next, e.To)
+			}
+		}
+		if len(edges) == limit || len(visited) > maxPathNodes {
+			return g, true, nil
+		}
+		if k == 1 && visited[target] {
+			break
+		}
+		level = next
+	}
+	return g, false, nil
+}
+
+// shortestPath 避开 bannedNodes 和 bannedEdges 的最短路径，不存在时返回 nil
+func (g *callGraph) shortestPath(source, target string, bannedNodes, bannedEdges map[string]bool) []*CallEdge {
+	prev := map[string]*CallEdge{source: nil}
+	queue := []string{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == target {
+			var path []*CallEdge
+			for e := prev[target]; e != nil; e = prev[e.From] {
+				path = append([]*CallEdge{e}, path...)
+			}
+			return path
+		}
+		for _, e := range g.edges[node] {
+			if _, ok := prev[e.To]; ok || bannedNodes[e.To] || bannedEdges[edgeKey(e)] {
+				continue
+			}
+			prev[e.To] = e
+			queue = append(queue, e.To)
+		}
+	}
+	return nil
+}
+
+// kShortestPaths Yen 算法求最短的 k 条无环路径，长度相同时按路径上的函数 ID 排序
+func (g *callGraph) kShortestPaths(source, target string, k int) [][]*CallEdge {
+	first := g.shortestPath(source, target, nil, nil)
+	if first == nil {
+		return nil
+	}
+	paths := [][]*CallEdge{first}
+	found := map[string]bool{pathKey(first): true}
+	var candidates [][]*CallEdge
+	for len(paths) < k {
+		last := paths[len(paths)-1]
+		for i := range last {
+			spur, root := last[i].From, last[:i]
+			bannedEdges := make(map[string]bool)
+			for _, p := range paths {
+				if len(p) > i && pathKey(p[:i]) == pathKey(root) {
+					bannedEdges[edgeKey(p[i])] = true
+				}
+			}
+			bannedNodes := make(map[string]bool)
+			for _, e := range root {
+				bannedNodes[e.From] = true
+			}
+			spurPath := g.shortestPath(spur, target, bannedNodes, bannedEdges)
+			if spurPath == nil {
+				continue
+			}
+			candidate := append(append([]*CallEdge{}, root...), spurPath...)
+			if key := pathKey(candidate); !found[key] {
+				found[key] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if len(candidates[i]) != len(candidates[j]) {
+				return len(candidates[i]) < len(candidates[j])
+			}
+			return pathKey(candidates[i]) < pathKey(candidates[j])
+		})
+		paths = append(paths, candidates[0])
+		candidates = candidates[1:]
+	}
+	return paths
+}
+
+func edgeKey(e *CallEdge) string {
+	return e.From + "#" + e.Relationship.Relation + "#" + e.To
+}
+
+func pathKey(path []*CallEdge) string {
+	keys := make([]string, 0, len(path))
+	for _, e := range path {
+		keys = append(keys, edgeKey(e))
+	}
+	return strings.Join(keys, ">")
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	v1 "codewiki/api/codewiki/v1"
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,15 +12,25 @@ var PathSep = "@"
 
 type CodeRepository struct {
 	*v1.Repo
+	// Dir 源码所在目录，远端仓库为工作目录中的克隆
+	Dir string
+	// Commit 不为空时从 git 中读取该提交的文件
+	Commit string
 }
 
-func (codeRepo *CodeRepository) ReadFile(fileId string) (string, error) {
-	fileId = strings.TrimPrefix(fileId, codeRepo.Id)
+func (codeRepo *CodeRepository) ReadFile(ctx context.Context, fileId string) (string, error) {
+	_, fileId = SplitNodeID(fileId)
+	fileId = strings.TrimPrefix(fileId, filepath.Base(codeRepo.Dir))
 	fileId = strings.TrimPrefix(fileId, PathSep)
-	fileId = strings.TrimPrefix(fileId, filepath.Base(codeRepo.Target))
-	fileId = strings.TrimPrefix(fileId, PathSep)
-	filePath := filepath.Join(codeRepo.Repo.Target, strings.ReplaceAll(fileId, PathSep, string(filepath.Separator)))
-	data, err := os.ReadFile(filePath)
+	name := strings.ReplaceAll(fileId, PathSep, string(filepath.Separator))
+	if len(codeRepo.Commit) > 0 {
+		data, err := gitOutput(ctx, codeRepo.Dir, nil, "show", "--end-of-options", codeRepo.Commit+":./"+filepath.ToSlash(name))
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	data, err := os.ReadFile(filepath.Join(codeRepo.Dir, name))
 	if err != nil {
 		return "", err
 	}
@@ -8,20 +8,35 @@ import (
 type CodeWiki struct {
 	projectRepo ProjectRepo
 	indexer     *Indexer
+	jobs        *analysisJobs
+	workspace   *Workspace
+	retention   *SnapshotRetention
 }
 
-func NewCodeWiki(projectRepo ProjectRepo, indexer *Indexer) *CodeWiki {
-
-	return &CodeWiki{projectRepo: projectRepo, indexer: indexer}
+func NewCodeWiki(projectRepo ProjectRepo, indexer *Indexer, workspace *Workspace, retention *SnapshotRetention) *CodeWiki {
+	c := &CodeWiki{projectRepo: projectRepo, indexer: indexer, jobs: newAnalysisJobs(), workspace: workspace, retention: retention}
+	c.failUnfinishedJobs(context.Background())
+	return c
 }
-func (c *CodeWiki) QueryCallChain(ctx context.Context, id string) ([]*v1.CallRelationship, error) {
-
-	return c.projectRepo.QueryCallChain(ctx, id)
 
+// QueryCallersChain 查询调用 req.Id 的函数链，默认向上 5 层
+func (c *CodeWiki) QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error) {
+	id, err := c.snapshotNodeID(ctx, req.Id, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	req.Id = id
+	if req.MaxDepth <= 0 {
+		req.MaxDepth = 5
+	}
+	return c.projectRepo.QueryCallersChain(ctx, req)
 }
 
 // Repo management APIs delegating to repository layer
 func (c *CodeWiki) CreateRepo(ctx context.Context, req *v1.CreateRepoReq) (string, error) {
+	if err := validateArchitectureRules(req.Rules); err != nil {
+		return "", err
+	}
 	return c.projectRepo.CreateRepo(ctx, req)
 }
 func (c *CodeWiki) ListRepos(ctx context.Context) ([]*v1.Repo, error) {
@@ -31,47 +46,66 @@ func (c *CodeWiki) GetRepo(ctx context.Context, id string) (*v1.Repo, error) {
 	return c.projectRepo.GetRepo(ctx, id)
 }
 func (c *CodeWiki) DeleteRepo(ctx context.Context, id string) error {
-	return c.projectRepo.DeleteRepo(ctx, id)
-}
-func (c *CodeWiki) AnalyzeRepo(ctx context.Context, id string) error {
-	// get repo info
 	repo, err := c.projectRepo.GetRepo(ctx, id)
 	if err != nil {
 		return err
 	}
-	// build analyze target
-	targetPath := repo.Target
-	if len(repo.Path) > 0 {
-		targetPath = repo.Path
+	snapshots, err := c.projectRepo.ListSnapshots(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		if err = c.projectRepo.DeleteSnapshot(ctx, s); err != nil {
+			return err
+		}
 	}
-
-	project := NewProject(repo, c.indexer)
-	if err = project.Analyze(ctx, targetPath, c.projectRepo); err != nil {
+	if c.indexer != nil {
+		if err = c.indexer.DeleteRepo(ctx, repo); err != nil {
+			return err
+		}
+	}
+	if err = c.projectRepo.DeleteRepo(ctx, id); err != nil {
 		return err
 	}
-	return nil
+	return c.workspace.Remove(ctx, repo)
 }
-func (c *CodeWiki) GetRepoTree(ctx context.Context, id string) (packages []*v1.PackageNode, files []*v1.FileNode, err error) {
+
+// GetRepoTree 快照的包和文件，snapshot 为空时使用最新快照
+func (c *CodeWiki) GetRepoTree(ctx context.Context, id, snapshot string) (packages []*v1.PackageNode, files []*v1.FileNode, err error) {
+	s, err := c.resolveSnapshot(ctx, id, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s != nil {
+		id = s.Id
+	}
 	return c.projectRepo.GetRepoTree(ctx, id)
 }
 
 func (c *CodeWiki) ViewFileContent(ctx context.Context, req *v1.ViewFileReq) (*FileContent, error) {
-	repo, err := c.projectRepo.GetRepo(ctx, req.GetRepoId())
+	id, err := c.snapshotNodeID(ctx, req.Id, req.Snapshot)
 	if err != nil {
 		return nil, err
 	}
-	cr := CodeRepository{Repo: repo}
-	content, err := cr.ReadFile(req.Id)
+	cr, err := c.codeRepository(ctx, req.GetRepoId(), id)
 	if err != nil {
 		return nil, err
 	}
-	functions, err := c.projectRepo.GetFunctionByFileId(ctx, req.GetId())
+	content, err := cr.ReadFile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	functions, err := c.projectRepo.GetFunctionByFileId(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	return &FileContent{Content: content, Functions: functions}, nil
 }
-func (c *CodeWiki) GetImplements(ctx context.Context, entityId string) ([]*v1.Entity, error) {
+func (c *CodeWiki) GetImplements(ctx context.Context, entityId, snapshot string) ([]*v1.Entity, error) {
+	entityId, err := c.snapshotNodeID(ctx, entityId, snapshot)
+	if err != nil {
+		return nil, err
+	}
 	entities, err := c.projectRepo.GetImplementByEntityId(ctx, entityId)
 	if err != nil {
 		return nil, err
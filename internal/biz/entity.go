@@ -1,6 +1,7 @@
 package biz
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"go/ast"
 	"go/token"
@@ -48,11 +49,12 @@ type Entity struct {
 	Name         string     `json:"name"`
 	FileID       string     `json:"file_id"`
 	PkgID        string     `json:"pkg_id"`
-	Position     token.Position
-	Definition   string    `json:"definition"`
-	Embeddings   []float64 `json:"-"`
-	Comment      string    `json:"comment"`
-	Document     string    `json:"document"` //根据语法树生成doc
+	Span         Span       `json:"span"` //声明的位置
+	Definition   string     `json:"definition"`
+	Embeddings   []float64  `json:"-"`
+	Comment      string     `json:"comment"`
+	Document     string     `json:"document"` //根据语法树生成doc
+	Exported     bool       `json:"exported"` //是否对包外可见，由语言前端按可见性规则设置
 	fieldManager *FieldManager
 	// 函数管理
 	functionManager *FunctionManager
@@ -67,6 +69,8 @@ func NewEntity(file *File, node *ast.TypeSpec, entityType EntityType) *Entity {
 		Type:            entityType,
 		Name:            node.Name.Name,
 		FileID:          file.ID,
+		Span:            goSpan(file.fset, node.Pos(), node.End()),
+		Exported:        node.Name.IsExported(),
 		Comment:         TextWarp(node.Comment),
 		Document:        TextWarp(node.Doc),
 		PkgID:           file.PkgID,
@@ -90,6 +94,38 @@ func (e *Entity) FindMethodByName(name string) *Function {
 	return e.functionManager.GetMethodByName(name)
 }
 
+// ResolveMethod 查找方法，自身没有时从继承的实体中查找
+func (e *Entity) ResolveMethod(name string) *Function {
+	if fun := e.FindMethodByName(name); fun != nil {
+		return fun
+	}
+	for _, extend := range e.Extends {
+		if fun := extend.ResolveMethod(name); fun != nil {
+			return fun
+		}
+	}
+	return nil
+}
+
+// AnalyzeDispatchRelations 分析接口方法到实现方法的分派关系
+func (e *Entity) AnalyzeDispatchRelations(impl *Entity) []*Relation {
+	var relations []*Relation
+	for _, method := range e.GetMethods() {
+		fun := impl.ResolveMethod(method.Name)
+		// 嵌入接口的结构体无法确定具体实现
+		if fun == nil || fun.Scope == InterfaceScope {
+			continue
+		}
+		relations = append(relations, &Relation{
+			Type:       DispatchesTo,
+			TargetID:   fun.ID,
+			Confidence: 1,
+			SourceID:   method.ID,
+		})
+	}
+	return relations
+}
+
 func (e *Entity) AddMethod(function *Function) {
 	if e.Type == Struct || e.Type == Interface {
 		e.functionManager.AddMethod(function)
@@ -181,6 +217,19 @@ func (e *Entity) GetMethods() []*Function {
 	return nil
 }
 
+// DefinitionHash 按类型、字段和继承计算的定义哈希，用于比较快照间实体是否变化
+func (e *Entity) DefinitionHash() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d", e.Type)
+	for _, f := range e.GetFields() {
+		fmt.Fprintf(&b, ";%s %s", f.Name, f.ObjType)
+	}
+	for _, extend := range e.Extends {
+		fmt.Fprintf(&b, ";%s", nodeKey(extend.ID))
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(b.String())))
+}
+
 // IsImplInterface 是否实现改接口
 func (e *Entity) IsImplInterface(interfaceEntity *Entity) bool {
 	if e.Type != Struct {
@@ -233,8 +282,13 @@ type Function struct {
 	Scope    ScopeType `json:"scope"`
 	Receiver string    `json:"receiver"`
 	ID       string    `json:"id"`
+	Exported bool      `json:"exported"` //是否对包外可见，方法还要求所属类型可见
+	Span     Span      `json:"span"`     //声明的位置
 	file     *File
 	decl     *ast.FuncDecl
+	namePos  token.Pos
+	// 非 Go 语言前端的语法节点
+	syntax syntaxNode
 }
 
 func (f *Function) readFileContent() ([]byte, error) {
@@ -244,21 +298,97 @@ func (f *Function) readFileContent() ([]byte, error) {
 	return f.file.ReadFileContent()
 }
 func (f *Function) ReaderSourceCode() string {
-	if f.decl == nil || f.file == nil || len(f.file.FilePath) == 0 {
+	if (f.decl == nil && f.syntax == nil) || f.file == nil || len(f.file.FilePath) == 0 {
 		return ""
 	}
 	content, err := f.readFileContent()
 	if err != nil || content == nil {
 		return ""
 	}
-	start := f.file.fset.Position(f.decl.Pos()).Offset
-	end := f.file.fset.Position(f.decl.End()).Offset
+	var start, end int
+	if f.syntax != nil {
+		start, end = f.syntax.Span()
+	} else {
+		start = f.file.fset.Position(f.decl.Pos()).Offset
+		end = f.file.fset.Position(f.decl.End()).Offset
+	}
 	if len(content) < end {
 		return ""
 	}
 	return string(content[start:end])
 }
 
+// SourceHash 函数源码的哈希，用于比较快照间函数是否变化，读不到源码时为空
+func (f *Function) SourceHash() string {
+	source := f.ReaderSourceCode()
+	if len(source) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(source)))
+}
+
+// 函数作为程序入口的类型
+const (
+	EntrypointMain = "main"
+	EntrypointHTTP = "http"
+	EntrypointTest = "test"
+)
+
+// httpHandlerParams HTTP 处理函数的参数类型：net/http、gin、echo、kratos、fiber、Servlet
+var httpHandlerParams = map[string]bool{
+	"http.ResponseWriter": true,
+	"*http.Request":       true,
+	"*gin.Context":        true,
+	"echo.Context":        true,
+	"http.Context":        true,
+	"*fiber.Ctx":          true,
+	"HttpServletRequest":  true,
+	"HttpServletResponse": true,
+}
+
+// Entrypoint 函数作为程序入口的类型，不是入口时为空
+func (f *Function) Entrypoint() string {
+	if f.Scope == InterfaceScope {
+		return ""
+	}
+	if f.InTestFile() {
+		if f.file.pkg.GetProject().frontend.IsTestFunction(f) {
+			return EntrypointTest
+		}
+		return ""
+	}
+	if f.Name == "main" {
+		return EntrypointMain
+	}
+	for _, p := range f.Params {
+		if httpHandlerParams[p.ObjType] {
+			return EntrypointHTTP
+		}
+	}
+	return ""
+}
+
+// InTestFile 函数是否声明在测试文件中，包括测试函数和测试辅助函数
+func (f *Function) InTestFile() bool {
+	return f.file != nil && f.file.Test
+}
+
+// hasBody 是否有函数体，只有声明的函数（如接口方法）不分析调用
+func (f *Function) hasBody() bool {
+	if f.syntax != nil {
+		return f.syntax.HasBody()
+	}
+	return f.Data != nil
+}
+
+// positionKey 函数名声明位置，用于和类型检查结果对应
+func (f *Function) positionKey() string {
+	if f.file == nil || !f.namePos.IsValid() {
+		return ""
+	}
+	return positionKey(f.file.fset.Position(f.namePos))
+}
+
 func (f *Function) sameFunctionSignature(function *Function) bool {
 	if len(f.Params) != len(function.Params) {
 		return false
@@ -319,6 +449,8 @@ type FunctionManager struct {
 	methods     []*Function
 	functionMap map[string]*Function
 	methodMap   map[string]*Function
+	// methodIDs 已添加的方法，文件中不同接收者的方法可以同名
+	methodIDs map[string]bool
 }
 
 func NewFunctionManager(file *File) *FunctionManager {
@@ -326,6 +458,7 @@ func NewFunctionManager(file *File) *FunctionManager {
 		file:        file,
 		functionMap: make(map[string]*Function),
 		methodMap:   make(map[string]*Function),
+		methodIDs:   make(map[string]bool),
 	}
 }
 func (fm *FunctionManager) CountFunction() int {
@@ -343,10 +476,17 @@ func (fm *FunctionManager) AddFunction(fun *Function) {
 
 }
 func (fm *FunctionManager) AddMethod(fun *Function) {
-	if _, ok := fm.methodMap[fun.Name]; ok {
+	id := fun.ID
+	if id == "" {
+		id = fun.Name
+	}
+	if fm.methodIDs[id] {
 		return
 	}
-	fm.methodMap[fun.Name] = fun
+	fm.methodIDs[id] = true
+	if _, ok := fm.methodMap[fun.Name]; !ok {
+		fm.methodMap[fun.Name] = fun
+	}
 	fm.methods = append(fm.methods, fun)
 }
 
@@ -377,6 +517,7 @@ type Field struct {
 	Scope    ScopeType `json:"scope"`
 	ObjType  string    `json:"obj_type"`
 	ID       string    `json:"id"`
+	Span     Span      `json:"span"` //字段声明的位置，X, Y int 的两个字段相同
 	file     *File
 	field    *ast.Field
 }
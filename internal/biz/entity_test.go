@@ -0,0 +1,126 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestFunctionEntrypoint(t *testing.T) {
+	project := analyzeSources(t, v1.Language_Golang, map[string]string{
+		"main.go": `package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type server struct{}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func health(c *gin.Context) {}
+
+func run(addr string) error { return nil }
+
+func main() { run(":8080") }
+`,
+	})
+	want := map[string]string{
+		"main":      EntrypointMain,
+		"health":    EntrypointHTTP,
+		"ServeHTTP": EntrypointHTTP,
+		"run":       "",
+	}
+	file := project.Root.Files[0]
+	functions := append(file.GetFunctions(), file.GetMethods()...)
+	if len(functions) != len(want) {
+		t.Fatalf("functions = %d, want %d", len(functions), len(want))
+	}
+	for _, f := range functions {
+		if got := f.Entrypoint(); got != want[f.Name] {
+			t.Errorf("%s entrypoint = %q, want %q", f.Name, got, want[f.Name])
+		}
+	}
+	// 接口方法只是声明，不是入口
+	if m := project.GetEntity(project.Root.ID, "Handler").FindMethodByName("ServeHTTP"); m == nil || m.Entrypoint() != "" {
+		t.Errorf("Handler.ServeHTTP = %+v", m)
+	}
+}
+
+func TestDispatchRelations(t *testing.T) {
+	project := analyzeSources(t, v1.Language_Golang, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store.go": `package main
+
+type Store interface {
+	Save()
+	Load()
+}
+
+type base struct{}
+
+func (base) Load() {}
+
+// mysqlStore 的 Load 由嵌入的 base 提供
+type mysqlStore struct {
+	base
+}
+
+func (m *mysqlStore) Save() {}
+
+type memStore struct{}
+
+func (m *memStore) Save() {}
+
+func (m *memStore) Load() {}
+
+func run(s Store) { s.Save() }
+`,
+	})
+	// 接口方法 ID 以接口为前缀，实现方法 ID 以包为前缀
+	root, store := project.Root.ID, project.Root.ID+"@store.go:Store:"
+	short := func(id string) string {
+		return strings.TrimPrefix(strings.TrimPrefix(id, store), root)
+	}
+	var got []string
+	for _, r := range project.Relations {
+		if r.Type == DispatchesTo {
+			got = append(got, short(r.SourceID)+" -> "+short(r.TargetID))
+		}
+	}
+	sort.Strings(got)
+	// 同一文件中不同接收者的同名方法都要保留，mysqlStore 的 Load 来自嵌入的 base
+	want := []string{
+		"Store.Load -> :base.Load",
+		"Store.Load -> :memStore.Load",
+		"Store.Save -> :memStore.Save",
+		"Store.Save -> :mysqlStore.Save",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("dispatches = %v, want %v", got, want)
+	}
+
+	// 调用链经过接口方法分派到所有实现
+	c := newTestCodeWiki(t, callEdgeRepo{relations: project.Relations})
+	resp, err := c.QueryCallChain(context.Background(), &v1.CallChainReq{Id: root + ":run", FollowDispatch: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	for _, r := range resp.CallRelations {
+		calls = append(calls, short(r.CallerId)+" -> "+short(r.CalleeId))
+	}
+	sort.Strings(calls)
+	if want := []string{":run -> Store.Save", "Store.Save -> :memStore.Save", "Store.Save -> :mysqlStore.Save"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
@@ -3,9 +3,9 @@ package biz
 import (
 	v1 "codewiki/api/codewiki/v1"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"go/ast"
-	"go/parser"
 	"go/token"
 	"go/types"
 	"path/filepath"
@@ -17,7 +17,11 @@ type File struct {
 	Name     string `json:"name"`
 	PkgID    string `json:"pkg_id"`
 	FilePath string `json:"file_path"`
-	fset     *token.FileSet
+	// 文件内容哈希，增量分析时用于判断文件是否变化
+	Hash string `json:"hash"`
+	// 是否为测试文件，由语言前端按路径判断
+	Test bool `json:"test"`
+	fset *token.FileSet
 
 	// AST相关
 	f1 *ast.File
@@ -71,6 +75,10 @@ func NewFile(dir, name string, pkg *Package) *File {
 		fset:     token.NewFileSet(),
 	}
 
+	if project := pkg.GetProject(); project != nil && project.frontend != nil {
+		file.Test = project.frontend.IsTestFile(repoRelativePath(file.ID))
+	}
+
 	// 初始化各个管理器
 	file.importManager = NewImportManager(file)
 	file.entityManager = NewEntityManager(file)
@@ -82,17 +90,13 @@ func NewFile(dir, name string, pkg *Package) *File {
 func (file *File) ReadFileContent() ([]byte, error) {
 	return GetFileContent(file.FilePath)
 }
-func (file *File) Parse(filePath string) error {
-	f, err := parser.ParseFile(file.fset, filePath, nil, parser.AllErrors|parser.ParseComments)
+func (file *File) Parse() error {
+	content, err := RefreshFileContent(file.FilePath)
 	if err != nil {
 		return err
 	}
-	visitor := &FileVisitor{
-		file: file,
-		pkg:  file.pkg,
-	}
-	ast.Walk(visitor, f)
-	return nil
+	file.Hash = fmt.Sprintf("%x", sha256.Sum256(content))
+	return file.pkg.GetProject().frontend.ParseFile(file)
 }
 
 func (file *File) AnalyzeRelations(ctx context.Context, pkg *Package) error {
@@ -115,7 +119,7 @@ func (file *File) FindInterfaceImpl(ctx context.Context, entity *Entity) []*Enti
 		if e.Type != Struct {
 			continue
 		}
-		if e.IsImplInterface(entity) {
+		if file.pkg.GetProject().frontend.IsImplInterface(e, entity) {
 			entities = append(entities, e)
 		}
 	}
@@ -132,10 +136,7 @@ func (file *File) ClassifyMethod(ctx context.Context, pkg *Package) {
 }
 
 func (file *File) ClassifyExtends(ctx context.Context, pkg *Package) {
-	for _, entity := range file.entityManager.GetEntities() {
-		entity.HandlerExtends(file, pkg)
-	}
-
+	pkg.GetProject().frontend.ResolveExtends(file, pkg)
 }
 
 func (file *File) GetFunctionByNameInPackage(name string) *Function {
@@ -199,6 +200,7 @@ type Import struct {
 	Name   string
 	Path   string
 	FileId string
+	Span   Span
 }
 
 func (imp *Import) GetRef() string {
@@ -226,6 +228,7 @@ func (im *ImportManager) AddImport(spec *ast.ImportSpec) {
 	imp := &Import{
 		Path:   strings.Trim(spec.Path.Value, "`"),
 		FileId: im.file.ID,
+		Span:   goSpan(im.file.fset, spec.Pos(), spec.End()),
 	}
 	if spec.Name != nil {
 		imp.Name = strings.Trim(spec.Name.Name, `"`)
@@ -240,6 +243,11 @@ func (im *ImportManager) AddImport(spec *ast.ImportSpec) {
 	}
 }
 
+// Add 添加非 Go 语言前端解析出的导入
+func (im *ImportManager) Add(imp *Import) {
+	im.imports = append(im.imports, imp)
+}
+
 func (im *ImportManager) LocalImport(importName string) *Import {
 	return im.localImport[importName]
 }
@@ -310,22 +318,31 @@ func (v *FileVisitor) handleStructType(node *ast.TypeSpec, structType *ast.Struc
 	entity := NewEntity(v.file, node, Struct)
 	// 处理结构体字段
 	for _, field := range structType.Fields.List {
-		fd := &Field{
-			StructID: entity.ID,
-			Document: TextWarp(field.Doc),
-		}
-		fd.Scope = StructScope
-		fd.expr = field.Type
-		if len(field.Names) > 0 {
-			fd.Name = field.Names[0].Name
-		} else {
+		if len(field.Names) == 0 {
 			if field.Type == nil {
 				continue
 			}
 			entity.rawExtends = append(entity.rawExtends, field.Type)
 		}
-		fd.ObjType = types.ExprString(field.Type)
-		entity.AddField(fd)
+		// X, Y int 每个字段名对应一个字段，嵌入字段没有字段名
+		names := []string{""}
+		if len(field.Names) > 0 {
+			names = names[:0]
+			for _, name := range field.Names {
+				names = append(names, name.Name)
+			}
+		}
+		for _, name := range names {
+			entity.AddField(&Field{
+				Name:     name,
+				StructID: entity.ID,
+				Document: TextWarp(field.Doc),
+				Scope:    StructScope,
+				expr:     field.Type,
+				ObjType:  types.ExprString(field.Type),
+				Span:     goSpan(v.file.fset, field.Pos(), field.End()),
+			})
+		}
 	}
 
 	v.file.entityManager.AddEntity(entity)
@@ -349,6 +366,9 @@ func (v *FileVisitor) handleInterfaceType(node *ast.TypeSpec, interfaceType *ast
 			file:     file,
 			FileId:   file.ID,
 			ID:       fmt.Sprintf("%s:%s.%s", entity.ID, entity.Name, method.Names[0].Name),
+			Exported: entity.Exported && method.Names[0].IsExported(),
+			Span:     goSpan(file.fset, method.Pos(), method.End()),
+			namePos:  method.Names[0].Pos(),
 		}
 		entity.AddMethod(fun)
 		if method.Type == nil {
@@ -394,10 +414,12 @@ func (v *FileVisitor) handleVarConst(node *ast.GenDecl) {
 					entityType = Constant
 				}
 				entity := &Entity{
-					ID:     fmt.Sprintf("%s:%s", file.ID, name.Name),
-					Type:   entityType,
-					FileID: file.ID,
-					Name:   name.Name,
+					ID:       fmt.Sprintf("%s:%s", file.ID, name.Name),
+					Type:     entityType,
+					FileID:   file.ID,
+					Name:     name.Name,
+					Exported: name.IsExported(),
+					Span:     goSpan(file.fset, valueSpec.Pos(), valueSpec.End()),
 				}
 				entity.SetValueSpace(valueSpec)
 				entity.Name = name.Name
@@ -433,11 +455,15 @@ func (v *FileVisitor) handleFuncDecl(node *ast.FuncDecl) {
 		file:     file,
 		decl:     node,
 		ID:       fmt.Sprintf("%s:%s", file.PkgID, node.Name.Name),
+		Exported: node.Name.IsExported(),
+		Span:     goSpan(file.fset, node.Pos(), node.End()),
+		namePos:  node.Name.Pos(),
 	}
 	fun.Parse(node.Type)
 	if node.Recv != nil && len(node.Recv.List) > 0 && node.Recv.List[0].Type != nil {
 		fun.Receiver = parseReceiver(node.Recv.List[0].Type)
 		fun.ID = fmt.Sprintf("%s:%s.%s", file.PkgID, fun.Receiver, node.Name.Name)
+		fun.Exported = fun.Exported && ast.IsExported(fun.Receiver)
 		v.file.functionManager.AddMethod(fun)
 	} else {
 		v.file.functionManager.AddFunction(fun)
@@ -445,23 +471,21 @@ func (v *FileVisitor) handleFuncDecl(node *ast.FuncDecl) {
 
 }
 
+// parseReceiver 接收者的类型名，去掉指针和泛型参数，如 *Box[T] 为 Box
 func parseReceiver(expr ast.Expr) string {
 	switch node := expr.(type) {
 	case *ast.Ident: //标识符（Ident）
 		return node.Name
-
 	case *ast.StarExpr: //指针（StarExpr
-		switch x := node.X.(type) {
-		case *ast.Ident:
-			return x.Name
-		case *ast.IndexExpr: //指针+泛型（IndexExpr）
-			if ident, ok := x.X.(*ast.Ident); ok {
-				return ident.Name
-			}
-		}
+		return parseReceiver(node.X)
+	case *ast.ParenExpr:
+		return parseReceiver(node.X)
+	case *ast.IndexExpr: //泛型（IndexExpr）
+		return parseReceiver(node.X)
+	case *ast.IndexListExpr: //多个类型参数的泛型
+		return parseReceiver(node.X)
 	}
 	return ""
-
 }
 
 type Text interface {
@@ -38,3 +38,15 @@ func GetFileContent(filePath string) ([]byte, error) {
 	}
 	return content, nil
 }
+
+// RefreshFileContent 从磁盘重新读取文件并更新缓存，重新分析时避免读到旧内容
+func RefreshFileContent(filePath string) ([]byte, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if err = fileContentCache.Put(NewFileContentKey(filePath), content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
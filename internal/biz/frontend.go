@@ -0,0 +1,148 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"go/ast"
+	"go/parser"
+	"strings"
+)
+
+// LanguageFrontend 语言前端，把源文件解析成统一的实体/函数/字段/导入模型并分析调用关系
+type LanguageFrontend interface {
+	// Extension 源文件后缀
+	Extension() string
+	// ParseFile 解析文件，填充实体、函数、字段和导入
+	ParseFile(file *File) error
+	// ResolveExtends 解析文件内实体的继承关系
+	ResolveExtends(file *File, pkg *Package)
+	// IsImplInterface 实体是否实现了接口
+	IsImplInterface(entity, interfaceEntity *Entity) bool
+	// ResolveFieldEntity 解析字段类型对应的实体
+	ResolveFieldEntity(ra *RelationAnalyzer, field *Field) *Entity
+	// AnalyzeImports 分析文件导入项目内包的关系
+	AnalyzeImports(file *File) []*Relation
+	// AnalyzeCalls 分析函数体内的调用关系
+	AnalyzeCalls(ra *RelationAnalyzer, fun *Function) []*Relation
+	// IsTestFile 相对仓库根目录的路径是否为测试文件
+	IsTestFile(path string) bool
+	// IsTestFunction 测试文件中的函数是否为测试框架运行的测试函数
+	IsTestFunction(fun *Function) bool
+}
+
+// NewLanguageFrontend 根据语言创建前端，不支持的语言返回 nil
+func NewLanguageFrontend(language v1.Language) LanguageFrontend {
+	switch language {
+	case v1.Language_Golang:
+		return &goFrontend{}
+	case v1.Language_Java:
+		return newJavaFrontend()
+	case v1.Language_Python:
+		return newPythonFrontend()
+	}
+	return nil
+}
+
+// syntaxNode 非 Go 语言前端的函数语法节点
+type syntaxNode interface {
+	// Span 源码起止偏移
+	Span() (int, int)
+	// HasBody 是否有函数体
+	HasBody() bool
+}
+
+// goFrontend 基于 go/parser 的 Go 语言前端
+type goFrontend struct{}
+
+func (fe *goFrontend) Extension() string {
+	return ".go"
+}
+
+func (fe *goFrontend) IsTestFile(path string) bool {
+	return strings.HasSuffix(path, "_test.go")
+}
+
+// IsTestFunction 包级的 TestXxx、BenchmarkXxx、FuzzXxx 和 ExampleXxx，前缀后不能是小写字母
+func (fe *goFrontend) IsTestFunction(fun *Function) bool {
+	if fun.Receiver != "" || fun.Scope == InterfaceScope {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		if rest, ok := strings.CutPrefix(fun.Name, prefix); ok {
+			return rest == "" || !(rest[0] >= 'a' && rest[0] <= 'z')
+		}
+	}
+	return false
+}
+
+func (fe *goFrontend) ParseFile(file *File) error {
+	f, err := parser.ParseFile(file.fset, file.FilePath, nil, parser.AllErrors|parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	file.f1 = f
+	visitor := &FileVisitor{
+		file: file,
+		pkg:  file.pkg,
+	}
+	ast.Walk(visitor, f)
+	return nil
+}
+
+func (fe *goFrontend) ResolveExtends(file *File, pkg *Package) {
+	for _, entity := range file.entityManager.GetEntities() {
+		entity.HandlerExtends(file, pkg)
+	}
+}
+
+func (fe *goFrontend) IsImplInterface(entity, interfaceEntity *Entity) bool {
+	return entity.IsImplInterface(interfaceEntity)
+}
+
+func (fe *goFrontend) ResolveFieldEntity(ra *RelationAnalyzer, field *Field) *Entity {
+	if field.expr == nil {
+		return nil
+	}
+	return ra.resolveTypeEntity(field.expr)
+}
+
+func (fe *goFrontend) AnalyzeImports(file *File) []*Relation {
+	var relations []*Relation
+	for _, imp := range file.GetImports() {
+		if file.importManager.LocalImport(imp.GetRef()) != imp {
+			continue
+		}
+		pkgKey := strings.ReplaceAll(strings.TrimPrefix(imp.Path, "/"), "/", "@")
+		pkg := file.pkg.GetProject().GetPackageByName(pkgKey)
+		if pkg == nil || pkg == file.pkg {
+			continue
+		}
+		relations = append(relations, &Relation{
+			Type:       Imports,
+			TargetID:   pkg.ID,
+			Confidence: 1,
+			SourceID:   file.ID,
+		})
+	}
+	return relations
+}
+
+func (fe *goFrontend) AnalyzeCalls(ra *RelationAnalyzer, fun *Function) []*Relation {
+	if fun.Data == nil {
+		return nil
+	}
+	if resolver := ra.pkg.GetProject().typeResolver; resolver != nil {
+		if calls, ok := resolver.ResolveCalls(fun); ok {
+			return callRelations(fun, calls, ConfidenceTypeChecked)
+		}
+	}
+
+	visitor := &FunctionCallVisitor{
+		relations: make([]*Relation, 0),
+		function:  fun,
+		analyzer:  ra,
+		entities:  make(map[string]*Entity),
+	}
+	// 遍历AST
+	ast.Walk(visitor, fun.Data)
+	return visitor.relations
+}
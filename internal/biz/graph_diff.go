@@ -0,0 +1,217 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+	"strings"
+)
+
+// SnapshotGraph 快照中参与比较的节点和关系
+type SnapshotGraph struct {
+	Packages  []*GraphNode
+	Files     []*GraphNode
+	Entities  []*GraphNode
+	Functions []*GraphNode
+	Relations []*Relation
+}
+
+// GraphNode 比较用的节点信息
+type GraphNode struct {
+	ID   string
+	Name string
+	// ParentID 文件所在的包，实体和函数所在的文件
+	ParentID string
+	// Content 判断变化的内容：文件哈希、实体定义哈希或函数源码哈希，为空时不比较
+	Content  string
+	Exported bool
+}
+
+// structuralRelations 包含和声明关系随节点增删变化，默认不比较
+var structuralRelations = map[string]bool{
+	Contains:      true,
+	ContainsFile:  true,
+	DeclareFunc:   true,
+	DeclareEntity: true,
+	HasMethod:     true,
+}
+
+// DiffGraphs 比较同一仓库的两个快照，节点按去掉快照前缀的 ID 对应，关系按 UnionKey 对应
+func (c *CodeWiki) DiffGraphs(ctx context.Context, req *v1.DiffGraphsReq) (*v1.DiffGraphsResp, error) {
+	base, err := c.resolveSnapshot(ctx, req.Id, req.Base)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.resolveSnapshot(ctx, req.Id, req.Head)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil || head == nil {
+		return nil, v1.ErrorParamValidate("base and head snapshot of repo %s are required", req.Id)
+	}
+	baseGraph, err := c.projectRepo.GetSnapshotGraph(ctx, base.Id)
+	if err != nil {
+		return nil, err
+	}
+	headGraph, err := c.projectRepo.GetSnapshotGraph(ctx, head.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := diffGraphs(baseGraph, headGraph, req.ExportedOnly, req.RelationTypes)
+	resp.Base, resp.Head = base.Id, head.Id
+	return resp, nil
+}
+
+func diffGraphs(base, head *SnapshotGraph, exportedOnly bool, relationTypes []string) *v1.DiffGraphsResp {
+	filter := func(nodes []*GraphNode) []*GraphNode {
+		if !exportedOnly {
+			return nodes
+		}
+		var exported []*GraphNode
+		for _, n := range nodes {
+			if n.Exported {
+				exported = append(exported, n)
+			}
+		}
+		return exported
+	}
+	return &v1.DiffGraphsResp{
+		Packages:  diffNodes(packageDigests(base), packageDigests(head)),
+		Entities:  diffNodes(filter(base.Entities), filter(head.Entities)),
+		Functions: diffNodes(filter(base.Functions), filter(head.Functions)),
+		Relations: diffRelations(base, head, exportedOnly, relationTypes),
+	}
+}
+
+// projectGraph 按仓储保存的内容构造项目的快照图，用于不经过仓储检查本地的分析结果
+func projectGraph(p *Project) *SnapshotGraph {
+	g := &SnapshotGraph{Relations: p.Relations}
+	for _, pkg := range p.GetPackages() {
+		g.Packages = append(g.Packages, &GraphNode{ID: pkg.ID, Name: pkg.Name, ParentID: pkg.ParentID, Exported: true})
+	}
+	for _, file := range p.GetAnalyzedFiles() {
+		g.Files = append(g.Files, &GraphNode{ID: file.ID, Name: file.Name, ParentID: file.PkgID, Content: file.Hash, Exported: true})
+		functions := file.GetFunctions()
+		for _, e := range file.GetEntities() {
+			g.Entities = append(g.Entities, &GraphNode{ID: e.ID, Name: e.Name, ParentID: e.FileID, Content: e.DefinitionHash(), Exported: e.Exported})
+			functions = append(functions, e.GetMethods()...)
+		}
+		for _, f := range functions {
+			g.Functions = append(g.Functions, &GraphNode{ID: f.ID, Name: f.Name, ParentID: f.FileId, Content: f.SourceHash(), Exported: f.Exported})
+		}
+	}
+	return g
+}
+
+// nodeKey 去掉快照前缀后的节点 ID
+func nodeKey(id string) string {
+	_, path := SplitNodeID(id)
+	return path
+}
+
+// repoRelativePath 节点相对仓库根目录的路径，以 / 分隔
+func repoRelativePath(id string) string {
+	_, rel, _ := strings.Cut(nodeKey(id), PathSep)
+	return strings.ReplaceAll(rel, PathSep, "/")
+}
+
+// packageDigests 以包内文件名和文件哈希作为包的内容
+func packageDigests(g *SnapshotGraph) []*GraphNode {
+	files := make(map[string][]string)
+	for _, f := range g.Files {
+		files[f.ParentID] = append(files[f.ParentID], f.Name+"="+f.Content)
+	}
+	var pkgs []*GraphNode
+	for _, p := range g.Packages {
+		digest := files[p.ID]
+		sort.Strings(digest)
+		pkgs = append(pkgs, &GraphNode{ID: p.ID, Name: p.Name, Content: strings.Join(digest, ","), Exported: true})
+	}
+	return pkgs
+}
+
+func diffNodes(base, head []*GraphNode) *v1.NodeDiff {
+	diff := &v1.NodeDiff{}
+	baseNodes := make(map[string]*GraphNode)
+	for _, n := range base {
+		baseNodes[nodeKey(n.ID)] = n
+	}
+	headKeys := make(map[string]bool)
+	for _, n := range head {
+		key := nodeKey(n.ID)
+		headKeys[key] = true
+		old, ok := baseNodes[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, diffNode(n))
+		case len(old.Content) > 0 && len(n.Content) > 0 && old.Content != n.Content:
+			diff.Changed = append(diff.Changed, diffNode(n))
+		}
+	}
+	for _, n := range base {
+		if !headKeys[nodeKey(n.ID)] {
+			diff.Removed = append(diff.Removed, diffNode(n))
+		}
+	}
+	for _, nodes := range [][]*v1.DiffNode{diff.Added, diff.Removed, diff.Changed} {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Id < nodes[j].Id })
+	}
+	return diff
+}
+
+func diffNode(n *GraphNode) *v1.DiffNode {
+	return &v1.DiffNode{Id: n.ID, Name: n.Name, FileId: n.ParentID}
+}
+
+func diffRelations(base, head *SnapshotGraph, exportedOnly bool, relationTypes []string) *v1.RelationDiff {
+	types := make(map[string]bool)
+	for _, t := range relationTypes {
+		types[t] = true
+	}
+	relations := func(g *SnapshotGraph) map[string]*Relation {
+		hidden := make(map[string]bool)
+		if exportedOnly {
+			for _, nodes := range [][]*GraphNode{g.Entities, g.Functions} {
+				for _, n := range nodes {
+					hidden[n.ID] = !n.Exported
+				}
+			}
+		}
+		keys := make(map[string]*Relation)
+		for _, r := range g.Relations {
+			if len(types) > 0 && !types[r.Type] || len(types) == 0 && structuralRelations[r.Type] {
+				continue
+			}
+			if hidden[r.SourceID] || hidden[r.TargetID] {
+				continue
+			}
+			key := (&Relation{Type: r.Type, SourceID: nodeKey(r.SourceID), TargetID: nodeKey(r.TargetID)}).UnionKey()
+			keys[key] = r
+		}
+		return keys
+	}
+	// missing 在 from 中但不在 to 中的关系，按 UnionKey 排序
+	missing := func(from, to map[string]*Relation) []*v1.DiffRelation {
+		var keys []string
+		for key := range from {
+			if _, ok := to[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		var rs []*v1.DiffRelation
+		for _, key := range keys {
+			rs = append(rs, diffRelation(from[key]))
+		}
+		return rs
+	}
+	baseRelations, headRelations := relations(base), relations(head)
+	return &v1.RelationDiff{
+		Added:   missing(headRelations, baseRelations),
+		Removed: missing(baseRelations, headRelations),
+	}
+}
+
+func diffRelation(r *Relation) *v1.DiffRelation {
+	return &v1.DiffRelation{Type: r.Type, SourceId: r.SourceID, TargetId: r.TargetID}
+}
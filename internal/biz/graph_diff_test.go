@@ -0,0 +1,78 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDiffGraphs(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"a.go": "package p\n\ntype T struct{ X int }\n\ntype hidden struct{}\n\nfunc (T) M() {}\n\nfunc A() { b() }\n\nfunc b() {}\n",
+		"b.go": "package p\n\nfunc B() {}\n",
+	})
+	analyze := func() *SnapshotGraph {
+		t.Helper()
+		project := NewProject(&v1.Repo{Id: "repo", Language: v1.Language_Golang}, nil)
+		if err := project.Analyze(context.Background(), root, discardProjectRepo{}); err != nil {
+			t.Fatal(err)
+		}
+		return projectGraph(project)
+	}
+	base := analyze()
+	for name, content := range map[string]string{
+		"a.go": "package p\n\ntype T struct {\n\tX int\n\tY int\n}\n\nfunc A() {}\n\nfunc b() {}\n\nfunc C() { A() }\n",
+		"b.go": "package p\n\nfunc B() {}\n",
+	} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	head := analyze()
+
+	names := func(nodes []*v1.DiffNode) []string {
+		var names []string
+		for _, n := range nodes {
+			names = append(names, n.Name)
+		}
+		return names
+	}
+	relations := func(rs []*v1.DiffRelation) []string {
+		var keys []string
+		for _, r := range rs {
+			keys = append(keys, r.Type+":"+nodeKey(r.SourceId)+"->"+nodeKey(r.TargetId))
+		}
+		return keys
+	}
+	pkg := nodeKey(head.Packages[0].ID)
+	check := func(name string, got, want []string) {
+		t.Helper()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+
+	diff := diffGraphs(base, head, false, nil)
+	check("changed packages", names(diff.Packages.Changed), []string{filepath.Base(root)})
+	check("added functions", names(diff.Functions.Added), []string{"C"})
+	check("removed functions", names(diff.Functions.Removed), []string{"M"})
+	check("changed functions", names(diff.Functions.Changed), []string{"A"})
+	check("removed entities", names(diff.Entities.Removed), []string{"hidden"})
+	check("changed entities", names(diff.Entities.Changed), []string{"T"})
+	check("added relations", relations(diff.Relations.Added), []string{Call + ":" + pkg + ":C->" + pkg + ":A"})
+	check("removed relations", relations(diff.Relations.Removed), []string{Call + ":" + pkg + ":A->" + pkg + ":b"})
+
+	// 只比较导出的符号
+	diff = diffGraphs(base, head, true, nil)
+	check("exported removed entities", names(diff.Entities.Removed), nil)
+	check("exported removed relations", relations(diff.Relations.Removed), nil)
+	check("exported added relations", relations(diff.Relations.Added), []string{Call + ":" + pkg + ":C->" + pkg + ":A"})
+
+	// 按关系类型过滤，包含结构关系
+	diff = diffGraphs(base, head, false, []string{DeclareFunc})
+	check("declare relations", append(relations(diff.Relations.Added), relations(diff.Relations.Removed)...),
+		[]string{DeclareFunc + ":" + pkg + "@a.go->" + pkg + ":C"})
+}
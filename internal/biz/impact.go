@@ -0,0 +1,322 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultImpactDepth = 5
+	defaultImpactNodes = 1000
+)
+
+// impactRelationTypes 变更沿这些关系反向传播：调用方、接口方法、实现接口的类型、以该类型为字段的类型
+var impactRelationTypes = []string{Call, DispatchesTo, Implement, HasFields}
+
+// ImpactEdge Source 通过 Relation 关系指向已受影响的节点 To
+type ImpactEdge struct {
+	To       string
+	Relation string
+	Source   *v1.Symbol
+}
+
+// AnalyzeImpact 从变更的文件、函数或 diff 出发，沿反向的调用、分派、实现和字段关系查找受影响的符号
+func (c *CodeWiki) AnalyzeImpact(ctx context.Context, req *v1.AnalyzeImpactReq) (*v1.AnalyzeImpactResp, error) {
+	s, err := c.resolveSnapshot(ctx, req.RepoId, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return &v1.AnalyzeImpactResp{}, nil
+	}
+	resp := &v1.AnalyzeImpactResp{Snapshot: s.Id}
+	seeds, err := c.impactSeeds(ctx, s.Id, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	maxDepth, maxNodes := int(req.MaxDepth), int(req.MaxNodes)
+	if maxDepth <= 0 {
+		maxDepth = defaultImpactDepth
+	}
+	if maxNodes <= 0 {
+		maxNodes = defaultImpactNodes
+	}
+
+	impacted := make(map[string]*v1.ImpactedSymbol)
+	var level []string
+	for _, seed := range seeds {
+		if impacted[seed.Id] != nil {
+			continue
+		}
+		if len(impacted) >= maxNodes {
+			resp.Truncated = true
+			break
+		}
+		impacted[seed.Id] = &v1.ImpactedSymbol{Symbol: seed, Test: seed.Entrypoint == EntrypointTest}
+		level = append(level, seed.Id)
+	}
+	for depth := 1; len(level) > 0 && !resp.Truncated; depth++ {
+		limit := maxNodes * 4
+		edges, err := c.projectRepo.QueryImpactEdges(ctx, level, impactRelationTypes, limit)
+		if err != nil {
+			return nil, err
+		}
+		resp.Truncated = len(edges) == limit
+		var next []string
+		for _, e := range edges {
+			if impacted[e.Source.Id] != nil {
+				continue
+			}
+			// 超过 maxDepth 的一层不加入结果，只判断是否还有未展开的符号
+			if depth > maxDepth || len(impacted) >= maxNodes {
+				resp.Truncated = true
+				break
+			}
+			impacted[e.Source.Id] = &v1.ImpactedSymbol{Symbol: e.Source, Distance: int32(depth), Via: e.To, Relation: e.Relation, Test: e.Source.Entrypoint == EntrypointTest}
+			next = append(next, e.Source.Id)
+		}
+		level = next
+	}
+
+	for _, symbol := range impacted {
+		resp.Symbols = append(resp.Symbols, symbol)
+	}
+	sort.Slice(resp.Symbols, func(i, j int) bool {
+		a, b := resp.Symbols[i], resp.Symbols[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		return a.Symbol.Id < b.Symbol.Id
+	})
+	packages := make(map[string]*v1.ImpactedPackage)
+	for _, symbol := range resp.Symbols {
+		id := symbolFilePackage(symbol.Symbol)
+		if p, ok := packages[id]; ok {
+			p.Symbols++
+		} else {
+			packages[id] = &v1.ImpactedPackage{Id: id, Distance: symbol.Distance, Symbols: 1}
+			resp.Packages = append(resp.Packages, packages[id])
+		}
+		switch {
+		case symbol.Test:
+			resp.Tests = append(resp.Tests, symbol)
+		case symbol.Symbol.Test:
+			// 测试文件中的辅助函数和类型不是导出 API 和入口
+		default:
+			if symbol.Symbol.Exported {
+				resp.Exported = append(resp.Exported, symbol)
+			}
+			if symbol.Symbol.Entrypoint != "" {
+				resp.Entrypoints = append(resp.Entrypoints, symbol)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// impactSeeds 变更的实体和函数，字段换成所属的实体，找不到的 ID 和文件记录到 resp.Unresolved
+func (c *CodeWiki) impactSeeds(ctx context.Context, snapshot string, req *v1.AnalyzeImpactReq, resp *v1.AnalyzeImpactResp) ([]*v1.Symbol, error) {
+	var seeds []*v1.Symbol
+	var owners []string
+	if len(req.FunctionIds) > 0 {
+		ids := make([]string, 0, len(req.FunctionIds))
+		for _, id := range req.FunctionIds {
+			_, path := SplitNodeID(id)
+			ids = append(ids, geneID(snapshot, path))
+		}
+		symbols, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, IDs: ids})
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[string]bool)
+		for _, symbol := range symbols {
+			found[symbol.Id] = true
+			if symbol.Kind == v1.SymbolKind_SymbolField {
+				owners = append(owners, symbol.ParentId)
+			} else {
+				seeds = append(seeds, symbol)
+			}
+		}
+		for i, id := range ids {
+			if !found[id] {
+				resp.Unresolved = append(resp.Unresolved, req.FunctionIds[i])
+			}
+		}
+	}
+	for _, id := range req.FileIds {
+		_, path := SplitNodeID(id)
+		symbols, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, FileID: geneID(snapshot, path)})
+		if err != nil {
+			return nil, err
+		}
+		for _, symbol := range symbols {
+			if symbol.Kind != v1.SymbolKind_SymbolField {
+				seeds = append(seeds, symbol)
+			}
+		}
+	}
+	if req.Diff != "" {
+		symbols, fieldOwners, err := c.diffSymbols(ctx, snapshot, req.Diff, resp)
+		if err != nil {
+			return nil, err
+		}
+		seeds = append(seeds, symbols...)
+		owners = append(owners, fieldOwners...)
+	}
+	if len(owners) > 0 {
+		symbols, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, IDs: owners})
+		if err != nil {
+			return nil, err
+		}
+		seeds = append(seeds, symbols...)
+	}
+	return seeds, nil
+}
+
+// diffSymbols 包含 diff 中变更行的最内层的实体和函数，以及变更字段所属的实体
+func (c *CodeWiki) diffSymbols(ctx context.Context, snapshot, diff string, resp *v1.AnalyzeImpactResp) (symbols []*v1.Symbol, owners []string, err error) {
+	_, files, err := c.projectRepo.GetRepoTree(ctx, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	paths := make(map[string]string, len(files))
+	for _, f := range files {
+		paths[repoRelativePath(f.Id)] = f.Id
+	}
+	for _, changed := range parseUnifiedDiff(diff) {
+		fileID, ok := paths[changed.path]
+		if !ok {
+			resp.Unresolved = append(resp.Unresolved, changed.path)
+			continue
+		}
+		declarations, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, FileID: fileID})
+		if err != nil {
+			return nil, nil, err
+		}
+		seen := make(map[string]bool)
+		for _, line := range changed.lines {
+			symbol := innermostAtLine(declarations, line)
+			if symbol == nil || seen[symbol.Id] {
+				continue
+			}
+			seen[symbol.Id] = true
+			if symbol.Kind == v1.SymbolKind_SymbolField {
+				owners = append(owners, symbol.ParentId)
+			} else {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	return symbols, owners, nil
+}
+
+// innermostAtLine 声明范围包含该行且范围最小的符号
+func innermostAtLine(declarations []*v1.Symbol, line int) *v1.Symbol {
+	var innermost *v1.Symbol
+	for _, d := range declarations {
+		s := d.Span
+		if s == nil || s.StartLine <= 0 || line < int(s.StartLine) || line > int(s.EndLine) {
+			continue
+		}
+		if innermost == nil || s.EndLine-s.StartLine < innermost.Span.EndLine-innermost.Span.StartLine {
+			innermost = d
+		}
+	}
+	return innermost
+}
+
+// diffFile diff 中一个已有文件变更前的路径和变更的行
+type diffFile struct {
+	path  string
+	lines []int
+}
+
+// parseUnifiedDiff 解析 unified diff 中每个文件变更前的行号：删除的行和插入位置之后的行
+// 新增的文件在快照中不存在，忽略
+func parseUnifiedDiff(diff string) []*diffFile {
+	var files []*diffFile
+	var file *diffFile
+	var oldPath string
+	// old 变更前的当前行号，oldLeft、newLeft 当前 hunk 剩余的变更前、变更后行数
+	old, oldLeft, newLeft := 0, 0, 0
+	for _, line := range strings.Split(diff, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(line, "-"):
+				if file != nil {
+					file.lines = append(file.lines, old)
+				}
+				old++
+				oldLeft--
+			case strings.HasPrefix(line, "+"):
+				if file != nil {
+					file.lines = append(file.lines, old)
+				}
+				newLeft--
+			case strings.HasPrefix(line, "\\"):
+				// \ No newline at end of file
+			default:
+				old++
+				oldLeft--
+				newLeft--
+			}
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			oldPath = diffPath(line[4:], "a/")
+		case strings.HasPrefix(line, "+++ "):
+			file = nil
+			if oldPath != "" {
+				file = &diffFile{path: oldPath}
+				files = append(files, file)
+			}
+		case strings.HasPrefix(line, "@@ "):
+			// @@ -start,count +start,count @@
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			old, oldLeft = diffRange(fields[1])
+			_, newLeft = diffRange(fields[2])
+			if oldLeft == 0 {
+				// 只有插入时 start 为插入位置之前的行
+				old++
+			}
+		}
+	}
+	return files
+}
+
+// diffRange 解析 hunk 头中的 -start,count 或 +start,count，省略 count 时为 1
+func diffRange(s string) (start, count int) {
+	startText, countText, found := strings.Cut(s[1:], ",")
+	start, _ = strconv.Atoi(startText)
+	count = 1
+	if found {
+		count, _ = strconv.Atoi(countText)
+	}
+	return start, count
+}
+
+// diffPath --- 行中的路径，去掉时间戳和 a/ 前缀，/dev/null 时为空
+func diffPath(s, prefix string) string {
+	s, _, _ = strings.Cut(s, "\t")
+	s = strings.Trim(s, `"`)
+	if s == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(s, prefix)
+}
+
+// symbolFilePackage 符号声明所在文件的包
+func symbolFilePackage(symbol *v1.Symbol) string {
+	if i := strings.LastIndex(symbol.FileId, PathSep); i >= 0 {
+		return symbol.FileId[:i]
+	}
+	return symbol.FileId
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// impactRepo 按分析结果中的关系反向查找受影响的符号
+type impactRepo struct {
+	*resolveRepo
+}
+
+func (r *impactRepo) QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*ImpactEdge, error) {
+	var edges []*ImpactEdge
+	for _, rel := range r.project.Relations {
+		if !slices.Contains(ids, rel.TargetID) || !slices.Contains(relationTypes, rel.Type) {
+			continue
+		}
+		symbols, _ := r.LookupSymbols(ctx, &SymbolLookup{IDs: []string{rel.SourceID}})
+		if len(symbols) > 0 {
+			edges = append(edges, &ImpactEdge{To: rel.TargetID, Relation: rel.Type, Source: symbols[0]})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+		return edges[i].Source.Id < edges[j].Source.Id
+	})
+	return edges[:min(len(edges), limit)], nil
+}
+
+func (r *impactRepo) GetRepoTree(ctx context.Context, id string) ([]*v1.PackageNode, []*v1.FileNode, error) {
+	var files []*v1.FileNode
+	for _, f := range r.project.GetFiles() {
+		files = append(files, &v1.FileNode{Id: f.ID, Name: f.Name, PkgId: f.PkgID})
+	}
+	return nil, files, nil
+}
+
+func TestAnalyzeImpact(t *testing.T) {
+	repo := &impactRepo{newResolveRepo(t, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store/store.go": `package store
+
+type Store struct {
+	Name string
+}
+
+func (s *Store) Save(name string) {
+	s.Name = name
+}
+
+func New() *Store {
+	return &Store{}
+}
+
+type Cache struct {
+	inner Store
+}
+`,
+		"store/store_test.go": `package store
+
+import "testing"
+
+func TestSave(t *testing.T) {
+	New().Save("a")
+}
+
+func helper() {}
+`,
+		"main.go": `package main
+
+import "example.com/app/store"
+
+func main() {
+	run(store.New())
+}
+
+func run(s *store.Store) {
+	s.Save("b")
+}
+`,
+	})}
+	c := newTestCodeWiki(t, repo)
+	root := repo.project.Root.ID
+	diff := `diff --git a/store/store.go b/store/store.go
+--- a/store/store.go
++++ b/store/store.go
+@@ -7,3 +7,4 @@ type Store struct {
+ func (s *Store) Save(name string) {
++	name = strings.TrimSpace(name)
+ 	s.Name = name
+ }
+--- /dev/null
++++ b/store/new.go
+@@ -0,0 +1 @@
++package store
+--- a/missing.go
++++ b/missing.go
+@@ -1 +1 @@
+-package main
++package app
+`
+	resp, err := c.AnalyzeImpact(context.Background(), &v1.AnalyzeImpactReq{RepoId: "repo", Diff: diff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, s := range resp.Symbols {
+		got = append(got, strings.TrimPrefix(s.Symbol.Id, root)+" "+string(rune('0'+s.Distance)))
+	}
+	want := []string{"@store:Store.Save 0", ":run 1", "@store:TestSave 1", ":main 2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("symbols = %v, want %v", got, want)
+	}
+	if len(resp.Tests) != 1 || resp.Tests[0].Symbol.Name != "TestSave" || len(resp.Entrypoints) != 1 || resp.Entrypoints[0].Symbol.Name != "main" {
+		t.Errorf("tests = %v, entrypoints = %v", resp.Tests, resp.Entrypoints)
+	}
+	if len(resp.Packages) != 2 || resp.Packages[0].Id != root+"@store" || resp.Packages[0].Symbols != 2 {
+		t.Errorf("packages = %v", resp.Packages)
+	}
+	if !reflect.DeepEqual(resp.Unresolved, []string{"missing.go"}) || resp.Truncated {
+		t.Errorf("unresolved = %v, truncated = %v", resp.Unresolved, resp.Truncated)
+	}
+
+	// 字段变更影响所属的实体和以它为字段的实体，maxDepth 限制距离
+	resp, err = c.AnalyzeImpact(context.Background(), &v1.AnalyzeImpactReq{RepoId: "repo", FunctionIds: []string{root + "@store@store.go:Store_Name"}, MaxDepth: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = got[:0]
+	for _, s := range resp.Symbols {
+		got = append(got, strings.TrimPrefix(s.Symbol.Id, root)+" "+s.Relation)
+	}
+	if want := []string{"@store@store.go:Store ", "@store@store.go:Cache HasFields"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("symbols = %v, want %v", got, want)
+	}
+}
+
+func TestParseUnifiedDiff(t *testing.T) {
+	files := parseUnifiedDiff("--- a/a.go\t2024-01-01\n+++ b/a.go\n@@ -3,2 +3,0 @@\n-x\n--- y\n@@ -10,0 +9,1 @@\n+z\n")
+	if len(files) != 1 || files[0].path != "a.go" || !reflect.DeepEqual(files[0].lines, []int{3, 4, 11}) {
+		t.Errorf("files = %+v", files[0])
+	}
+}
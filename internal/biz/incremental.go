@@ -0,0 +1,153 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+	"strings"
+)
+
+// FileChanges 与图中上次分析结果相比的文件变更
+type FileChanges struct {
+	Added   []string
+	Changed []string
+	Removed []string
+	// Dependents 内容未变但有关系指向变更文件的文件，需要一起重新分析
+	Dependents []string
+}
+
+// DetectChanges 对比已保存的文件哈希，确定需要重新分析的文件
+// 全量分析时所有文件都重新分析，增量分析时只分析新增、变化的文件及依赖它们的文件
+func (p *Project) DetectChanges(ctx context.Context, projectRepo ProjectRepo) error {
+	hashes, err := projectRepo.GetFileHashes(ctx, p.Root.ID)
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 && p.config.Incremental {
+		if hashes, err = p.carryOverSnapshot(ctx, projectRepo); err != nil {
+			return err
+		}
+	}
+	changes := &FileChanges{}
+	current := make(map[string]bool)
+	p.analyzed = make(map[string]bool)
+	for _, file := range p.GetFiles() {
+		current[file.ID] = true
+		hash, ok := hashes[file.ID]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, file.ID)
+		case hash != file.Hash:
+			changes.Changed = append(changes.Changed, file.ID)
+		case p.config.Incremental:
+			continue
+		}
+		p.analyzed[file.ID] = true
+	}
+	for id := range hashes {
+		if !current[id] {
+			changes.Removed = append(changes.Removed, id)
+		}
+	}
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Changed)
+	sort.Strings(changes.Removed)
+
+	if p.config.Incremental && len(changes.Changed)+len(changes.Removed) > 0 {
+		var targets []string
+		targets = append(targets, changes.Changed...)
+		targets = append(targets, changes.Removed...)
+		dependents, err := projectRepo.GetDependentFiles(ctx, targets)
+		if err != nil {
+			return err
+		}
+		sort.Strings(dependents)
+		for _, id := range dependents {
+			if current[id] && !p.analyzed[id] {
+				p.analyzed[id] = true
+				changes.Dependents = append(changes.Dependents, id)
+			}
+		}
+	}
+	p.Changes = changes
+	return nil
+}
+
+// carryOverSnapshot 新提交的快照还没有节点时，把仓库最新的快照复制为新快照，之后只需重新分析变化的文件
+// 返回复制后新快照中的文件哈希，没有可用的快照时为空
+func (p *Project) carryOverSnapshot(ctx context.Context, projectRepo ProjectRepo) (map[string]string, error) {
+	snapshot := p.SnapshotID()
+	snapshots, err := projectRepo.ListSnapshots(ctx, p.Repo.Id)
+	if err != nil || len(snapshots) == 0 || snapshots[0].Id == snapshot {
+		return nil, err
+	}
+	// 根包的目录名不同时两个快照的节点无法对应，按全新仓库分析
+	latest := snapshots[0].Id
+	hashes, err := projectRepo.GetFileHashes(ctx, latest+strings.TrimPrefix(p.Root.ID, snapshot))
+	if err != nil || len(hashes) == 0 {
+		return nil, err
+	}
+	if err = projectRepo.CopySnapshot(ctx, latest, snapshot); err != nil {
+		return nil, err
+	}
+	return projectRepo.GetFileHashes(ctx, p.Root.ID)
+}
+
+// IsAnalyzed 文件是否需要重新分析并保存，未检测变更时全部需要
+func (p *Project) IsAnalyzed(file *File) bool {
+	return p.analyzed == nil || p.analyzed[file.ID]
+}
+
+// GetAnalyzedFiles 需要重新分析并保存的文件
+func (p *Project) GetAnalyzedFiles() []*File {
+	var files []*File
+	for _, file := range p.GetFiles() {
+		if p.IsAnalyzed(file) {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// filterAnalyzedRelations 增量分析只保留两端涉及重新分析文件的关系，包级别的关系全部保留
+func (p *Project) filterAnalyzedRelations() {
+	if p.analyzed == nil || !p.config.Incremental {
+		return
+	}
+	owners := make(map[string]string)
+	for _, file := range p.GetFiles() {
+		owners[file.ID] = file.ID
+		for _, entity := range file.GetEntities() {
+			owners[entity.ID] = file.ID
+			for _, method := range entity.GetMethods() {
+				owners[method.ID] = file.ID
+			}
+		}
+		for _, fun := range file.GetFunctions() {
+			owners[fun.ID] = file.ID
+		}
+	}
+	relations := p.Relations[:0]
+	for _, r := range p.Relations {
+		source, ok := owners[r.SourceID]
+		if !ok || p.analyzed[source] {
+			relations = append(relations, r)
+			continue
+		}
+		if target, ok := owners[r.TargetID]; ok && p.analyzed[target] {
+			relations = append(relations, r)
+		}
+	}
+	p.Relations = relations
+}
+
+// Summary 分析结果摘要
+func (p *Project) Summary() *v1.AnalyzeSummary {
+	summary := &v1.AnalyzeSummary{Analyzed: int32(len(p.GetAnalyzedFiles()))}
+	if p.Changes != nil {
+		summary.Added = p.Changes.Added
+		summary.Changed = p.Changes.Changed
+		summary.Removed = p.Changes.Removed
+	}
+	return summary
+}
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type snapshotProjectRepo struct {
+	discardProjectRepo
+	hashes     map[string]string
+	dependents []string
+}
+
+func (r snapshotProjectRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	return r.hashes, nil
+}
+
+func (r snapshotProjectRepo) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	return r.dependents, nil
+}
+
+func TestIncrementalAnalyze(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"a.go": "package p\n\nfunc A() { B() }\n",
+		"b.go": "package p\n\nfunc B() {}\n",
+		"c.go": "package p\n\nfunc C() {}\n",
+		"e.go": "package p\n\nfunc E() {}\n",
+	})
+	repo := &v1.Repo{Id: "repo", Language: v1.Language_Golang}
+	previous := NewProject(repo, nil)
+	if err := previous.Analyze(context.Background(), root, snapshotProjectRepo{}); err != nil {
+		t.Fatal(err)
+	}
+	hashes := make(map[string]string)
+	for _, file := range previous.GetFiles() {
+		hashes[file.ID] = file.Hash
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "b.go"), []byte("package p\n\nfunc B() { D() }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(root, "c.go")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "d.go"), []byte("package p\n\nfunc D() {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg := previous.Root.ID
+	project := NewProject(repo, nil)
+	project.config.Incremental = true
+	if err := project.Analyze(context.Background(), root, snapshotProjectRepo{
+		hashes:     hashes,
+		dependents: []string{pkg + "@a.go"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &FileChanges{
+		Added:      []string{pkg + "@d.go"},
+		Changed:    []string{pkg + "@b.go"},
+		Removed:    []string{pkg + "@c.go"},
+		Dependents: []string{pkg + "@a.go"},
+	}
+	if !reflect.DeepEqual(project.Changes, want) {
+		t.Fatalf("changes = %+v, want %+v", project.Changes, want)
+	}
+	if summary := project.Summary(); summary.Analyzed != 3 {
+		t.Errorf("analyzed = %d", summary.Analyzed)
+	}
+	if !hasRelation(project, Call, pkg+":A", pkg+":B") || !hasRelation(project, Call, pkg+":B", pkg+":D") {
+		t.Errorf("missing call relations of analyzed files")
+	}
+	for _, r := range project.Relations {
+		if r.SourceID == pkg+"@e.go" || r.SourceID == pkg+":E" {
+			t.Errorf("unexpected relation of unchanged file %+v", r)
+		}
+	}
+}
+
+// carryOverProjectRepo 按根包保存文件哈希，复制快照时把哈希复制到新快照
+type carryOverProjectRepo struct {
+	discardProjectRepo
+	hashes map[string]map[string]string
+	latest string
+	copied *[2]string
+}
+
+func (r carryOverProjectRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	return r.hashes[rootPkgId], nil
+}
+
+func (r carryOverProjectRepo) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	return nil, nil
+}
+
+func (r carryOverProjectRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	return []*v1.Snapshot{{Id: r.latest, RepoId: repoId}}, nil
+}
+
+func (r carryOverProjectRepo) CopySnapshot(ctx context.Context, from, to string) error {
+	*r.copied = [2]string{from, to}
+	for root, hashes := range r.hashes {
+		if !strings.HasPrefix(root, from+PathSep) {
+			continue
+		}
+		copied := make(map[string]string)
+		for id, hash := range hashes {
+			copied[to+strings.TrimPrefix(id, from)] = hash
+		}
+		r.hashes[to+strings.TrimPrefix(root, from)] = copied
+	}
+	return nil
+}
+
+func TestIncrementalAnalyzeNewCommit(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"a.go": "package p\n\nfunc A() {}\n",
+		"b.go": "package p\n\nfunc B() {}\n",
+	})
+	repo := &v1.Repo{Id: "repo", Language: v1.Language_Golang}
+	previous := NewProject(repo, nil)
+	previous.Commit = "1111111111111111"
+	if err := previous.Analyze(context.Background(), root, discardProjectRepo{}); err != nil {
+		t.Fatal(err)
+	}
+	hashes := make(map[string]string)
+	for _, file := range previous.GetFiles() {
+		hashes[file.ID] = file.Hash
+	}
+	if err := os.WriteFile(filepath.Join(root, "b.go"), []byte("package p\n\nfunc B() { A() }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// 新提交的快照以上次的快照为基础，只重新分析变化的文件
+	projectRepo := carryOverProjectRepo{
+		hashes: map[string]map[string]string{previous.Root.ID: hashes},
+		latest: previous.SnapshotID(),
+		copied: &[2]string{},
+	}
+	project := NewProject(repo, nil)
+	project.Commit = "2222222222222222"
+	project.config.Incremental = true
+	if err := project.Analyze(context.Background(), root, projectRepo); err != nil {
+		t.Fatal(err)
+	}
+	if want := [2]string{"repo~111111111111", "repo~222222222222"}; *projectRepo.copied != want {
+		t.Errorf("copied = %v, want %v", *projectRepo.copied, want)
+	}
+	want := &FileChanges{Changed: []string{project.Root.ID + "@b.go"}}
+	if !reflect.DeepEqual(project.Changes, want) {
+		t.Fatalf("changes = %+v, want %+v", project.Changes, want)
+	}
+	if summary := project.Summary(); summary.Analyzed != 1 {
+		t.Errorf("analyzed = %d", summary.Analyzed)
+	}
+}
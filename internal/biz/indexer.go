@@ -46,11 +46,18 @@ func (cc *CodeChunk) LogicVector() []float32 {
 	return cc.logicVector
 }
 
+// SetCodeVector 设置代码向量，用于从向量存储中还原代码块
+func (cc *CodeChunk) SetCodeVector(vector []float32) {
+	cc.codeVector = vector
+}
+
 type SearchCodeChunksReq struct {
 	Limit       int
 	QueryVector []float32
 	ProjectName string
 	Partition   string
+	Scope       Scope  // 不为空时只搜索该范围的代码块
+	Path        string // 不为空时只搜索该路径的代码块
 }
 
 // Indexer 创建索引
@@ -93,6 +100,11 @@ func (idx *Indexer) buildCodeChunk(ctx context.Context, file *File) (*CodeChunk,
 	return rawCodeChunk, nil
 }
 
+// DeleteRepo 删除仓库的所有代码块
+func (idx *Indexer) DeleteRepo(ctx context.Context, repo *v1.Repo) error {
+	return idx.repo.DeleteCodeChunks(ctx, repo.Name, repo.Id)
+}
+
 // SearchCode 搜索代码
 func (idx *Indexer) SearchCode(ctx context.Context, repo *v1.Repo, query string) ([]*CodeChunk, error) {
 	if !idx.llm.Enable() {
@@ -0,0 +1,718 @@
+package biz
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// javaFrontend 纯 Go 实现的 Java 语言前端
+// 类/枚举/记录解析为 Struct，接口/注解解析为 Interface，父类和实现的接口都记录在 Extends 中。
+// 方法按名称归类，重载的方法只保留第一个声明。
+type javaFrontend struct {
+	units    map[*File]*javaCompilationUnit
+	types    map[*Entity]*javaType
+	methods  map[*Function]*javaMethod
+	packages map[string][]*Package // Java 包名 -> 声明该包的目录
+}
+
+func newJavaFrontend() *javaFrontend {
+	return &javaFrontend{
+		units:    make(map[*File]*javaCompilationUnit),
+		types:    make(map[*Entity]*javaType),
+		methods:  make(map[*Function]*javaMethod),
+		packages: make(map[string][]*Package),
+	}
+}
+
+func (fe *javaFrontend) Extension() string {
+	return ".java"
+}
+
+// IsTestFile Maven/Gradle 的 src/test 目录，以及 XxxTest、XxxTests、XxxIT、TestXxx 类文件
+func (fe *javaFrontend) IsTestFile(p string) bool {
+	if strings.HasPrefix(p, "src/test/") || strings.Contains(p, "/src/test/") {
+		return true
+	}
+	name := strings.TrimSuffix(path.Base(p), fe.Extension())
+	for _, suffix := range []string{"Test", "Tests", "IT"} {
+		if strings.HasSuffix(name, suffix) && name != suffix {
+			return true
+		}
+	}
+	return strings.HasPrefix(name, "Test") && name != "Test"
+}
+
+// javaTestAnnotations JUnit 和 TestNG 运行的测试方法的注解
+var javaTestAnnotations = map[string]bool{
+	"Test":              true,
+	"ParameterizedTest": true,
+	"RepeatedTest":      true,
+	"TestFactory":       true,
+	"TestTemplate":      true,
+}
+
+// IsTestFunction 带测试注解的方法，或 JUnit 3 风格的 public void testXxx()
+func (fe *javaFrontend) IsTestFunction(fun *Function) bool {
+	m := fe.methods[fun]
+	if m == nil || m.constructor {
+		return false
+	}
+	for _, a := range m.annotations {
+		if javaTestAnnotations[a] {
+			return true
+		}
+	}
+	return m.visible && m.result == "void" && strings.HasPrefix(m.name, "test")
+}
+
+func (fe *javaFrontend) ParseFile(file *File) error {
+	content, err := file.ReadFileContent()
+	if err != nil {
+		return err
+	}
+	unit := parseJava(content)
+	fe.units[file] = unit
+	fe.addPackage(unit.pkg, file.pkg)
+	for _, imp := range unit.imports {
+		path := imp.path
+		if imp.wildcard {
+			path += ".*"
+		}
+		file.importManager.Add(&Import{Path: path, FileId: file.ID, Span: unit.lines.span(imp.start, imp.end)})
+	}
+	for _, t := range unit.types {
+		fe.addType(file, t, unit.lines)
+	}
+	return nil
+}
+
+func (fe *javaFrontend) addPackage(name string, pkg *Package) {
+	for _, p := range fe.packages[name] {
+		if p == pkg {
+			return
+		}
+	}
+	fe.packages[name] = append(fe.packages[name], pkg)
+}
+
+func (fe *javaFrontend) addType(file *File, t *javaType, lines lineIndex) {
+	entityType := Struct
+	if t.isInterface() {
+		entityType = Interface
+	}
+	entity := &Entity{
+		ID:              fmt.Sprintf("%s:%s", file.ID, t.name),
+		Type:            entityType,
+		Name:            t.name,
+		FileID:          file.ID,
+		PkgID:           file.PkgID,
+		Document:        t.doc,
+		Exported:        t.exported(),
+		Span:            lines.span(t.start, t.end),
+		functionManager: NewFunctionManager(file),
+		fieldManager:    NewFieldManager(),
+	}
+	t.entity = entity
+	fe.types[entity] = t
+	for _, f := range t.fields {
+		entity.AddField(&Field{
+			Name:     f.name,
+			StructID: entity.ID,
+			Document: f.doc,
+			Scope:    StructScope,
+			ObjType:  f.typ,
+			Span:     lines.span(f.start, f.end),
+			file:     file,
+		})
+	}
+	for _, m := range t.methods {
+		fun := &Function{
+			Name:     m.name,
+			Document: m.doc,
+			PkgID:    file.PkgID,
+			FileId:   file.ID,
+			file:     file,
+			syntax:   m,
+			Exported: m.exported(),
+			Span:     lines.span(m.start, m.end),
+		}
+		if t.isInterface() {
+			fun.EntId = entity.ID
+			fun.Scope = InterfaceScope
+			fun.ID = fmt.Sprintf("%s:%s.%s", entity.ID, entity.Name, m.name)
+		} else {
+			fun.EntId = file.ID
+			fun.Scope = FunctionScope
+			fun.Receiver = t.name
+			fun.ID = fmt.Sprintf("%s:%s.%s", file.PkgID, t.name, m.name)
+		}
+		for _, param := range m.params {
+			fun.Params = append(fun.Params, &Field{Name: param.name, ObjType: param.typ, Scope: fun.Scope, file: file})
+		}
+		if len(m.result) > 0 && m.result != "void" {
+			fun.Results = append(fun.Results, &Field{ObjType: m.result, Scope: fun.Scope, file: file})
+		}
+		if entity.FindMethodByName(m.name) != nil {
+			continue
+		}
+		entity.AddMethod(fun)
+		fe.methods[fun] = m
+	}
+	file.AddEntity(entity)
+}
+
+func (fe *javaFrontend) ResolveExtends(file *File, pkg *Package) {
+	for _, entity := range file.GetEntities() {
+		t := fe.types[entity]
+		if t == nil {
+			continue
+		}
+		for _, name := range append(append([]string{}, t.extends...), t.implements...) {
+			extend := fe.resolveType(file, name)
+			if extend == nil || extend == entity {
+				continue
+			}
+			entity.Extends = append(entity.Extends, extend)
+		}
+	}
+}
+
+// IsImplInterface 类沿父类和 implements 链可以到达接口即为实现
+func (fe *javaFrontend) IsImplInterface(entity, interfaceEntity *Entity) bool {
+	if entity.Type != Struct {
+		return false
+	}
+	return fe.reachable(entity, interfaceEntity, make(map[*Entity]bool))
+}
+
+func (fe *javaFrontend) reachable(from, to *Entity, visited map[*Entity]bool) bool {
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+	for _, extend := range from.Extends {
+		if extend == to || fe.reachable(extend, to, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveFieldEntity 解析字段类型，类型本身不是项目实体时尝试泛型参数，例如 List<User>
+func (fe *javaFrontend) ResolveFieldEntity(ra *RelationAnalyzer, field *Field) *Entity {
+	file := field.file
+	if file == nil {
+		file = ra.file
+	}
+	if entity := fe.resolveType(file, field.ObjType); entity != nil {
+		return entity
+	}
+	for _, arg := range javaTypeArguments(field.ObjType) {
+		if entity := fe.resolveType(file, arg); entity != nil {
+			return entity
+		}
+	}
+	return nil
+}
+
+func (fe *javaFrontend) AnalyzeCalls(ra *RelationAnalyzer, fun *Function) []*Relation {
+	m := fe.methods[fun]
+	if m == nil || !m.HasBody() {
+		return nil
+	}
+	analyzer := newJavaCallAnalyzer(fe, fun.file, m)
+	return callRelations(fun, analyzer.calls(), ConfidenceHeuristic)
+}
+
+// resolveType 按 同文件 -> 单类型导入 -> 同包 -> 通配符导入 -> 全限定名 的顺序查找类型对应的实体
+func (fe *javaFrontend) resolveType(file *File, typ string) *Entity {
+	name := javaRawType(typ)
+	if len(name) == 0 {
+		return nil
+	}
+	unit := fe.units[file]
+	if unit == nil {
+		return nil
+	}
+	if entity := fe.findInFile(file, name); entity != nil {
+		return entity
+	}
+	first, rest, nested := strings.Cut(name, ".")
+	for _, imp := range unit.imports {
+		if imp.static || imp.wildcard {
+			continue
+		}
+		if imp.path == first || strings.HasSuffix(imp.path, "."+first) {
+			qualified := imp.path
+			if nested {
+				qualified += "." + rest
+			}
+			if entity := fe.findQualified(qualified); entity != nil {
+				return entity
+			}
+		}
+	}
+	if entity := fe.findInPackage(unit.pkg, name); entity != nil {
+		return entity
+	}
+	for _, imp := range unit.imports {
+		if imp.static || !imp.wildcard {
+			continue
+		}
+		if entity := fe.findInPackage(imp.path, name); entity != nil {
+			return entity
+		}
+	}
+	if nested {
+		return fe.findQualified(name)
+	}
+	return nil
+}
+
+func (fe *javaFrontend) AnalyzeImports(file *File) []*Relation {
+	unit := fe.units[file]
+	if unit == nil {
+		return nil
+	}
+	var relations []*Relation
+	for _, imp := range unit.imports {
+		var pkgs []*Package
+		path := imp.path
+		if imp.static && !imp.wildcard {
+			// 静态导入的最后一段是成员名
+			path = path[:max(strings.LastIndex(path, "."), 0)]
+		}
+		if entity := fe.findQualified(path); entity != nil {
+			pkgs = append(pkgs, file.pkg.GetProject().GetPackageById(entity.PkgID))
+		} else if imp.wildcard {
+			pkgs = fe.packages[path]
+		}
+		for _, pkg := range pkgs {
+			if pkg == nil || pkg == file.pkg {
+				continue
+			}
+			relations = append(relations, &Relation{
+				Type:       Imports,
+				TargetID:   pkg.ID,
+				Confidence: 1,
+				SourceID:   file.ID,
+			})
+		}
+	}
+	return relations
+}
+
+// findInFile 在文件内查找类型，支持嵌套类型的简单名
+func (fe *javaFrontend) findInFile(file *File, name string) *Entity {
+	if entity := file.GetEntity(name); entity != nil {
+		return entity
+	}
+	for _, entity := range file.GetEntities() {
+		if strings.HasSuffix(entity.Name, "."+name) {
+			return entity
+		}
+	}
+	return nil
+}
+
+func (fe *javaFrontend) findInPackage(pkgName, name string) *Entity {
+	for _, pkg := range fe.packages[pkgName] {
+		if entity := pkg.GetEntity(name); entity != nil {
+			return entity
+		}
+	}
+	return nil
+}
+
+// findQualified 查找全限定名，从右往左拆分包名和（嵌套）类型名
+func (fe *javaFrontend) findQualified(qualified string) *Entity {
+	for index := strings.LastIndex(qualified, "."); index > 0; index = strings.LastIndex(qualified[:index], ".") {
+		if entity := fe.findInPackage(qualified[:index], qualified[index+1:]); entity != nil {
+			return entity
+		}
+	}
+	return nil
+}
+
+// findField 查找字段，自身没有时从父类型中查找
+func (fe *javaFrontend) findField(entity *Entity, name string, visited map[*Entity]bool) *Field {
+	if entity == nil || visited[entity] {
+		return nil
+	}
+	visited[entity] = true
+	if field := entity.FindFieldByName(name); field != nil {
+		return field
+	}
+	for _, extend := range entity.Extends {
+		if field := fe.findField(extend, name, visited); field != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// superClass 父类，接口没有父类
+func (fe *javaFrontend) superClass(entity *Entity) *Entity {
+	for _, extend := range entity.Extends {
+		if extend.Type == Struct {
+			return extend
+		}
+	}
+	return nil
+}
+
+// javaRawType 去掉泛型参数、数组和可变参数，例如 Map<K, V>[] -> Map
+func javaRawType(typ string) string {
+	typ = strings.TrimSuffix(typ, "...")
+	if index := strings.Index(typ, "<"); index >= 0 {
+		typ = typ[:index]
+	}
+	if index := strings.Index(typ, "["); index >= 0 {
+		typ = typ[:index]
+	}
+	return strings.TrimSpace(typ)
+}
+
+// javaTypeArguments 返回泛型参数中的各个类型
+func javaTypeArguments(typ string) []string {
+	start := strings.Index(typ, "<")
+	end := strings.LastIndex(typ, ">")
+	if start < 0 || end <= start {
+		return nil
+	}
+	var args []string
+	depth, from := 0, start+1
+	for i := start + 1; i < end; i++ {
+		switch typ[i] {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, typ[from:i])
+				from = i + 1
+			}
+		}
+	}
+	args = append(args, typ[from:end])
+	var result []string
+	for _, arg := range args {
+		arg = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(arg), "?"))
+		arg = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(arg, "extends "), "super "))
+		result = append(result, arg)
+		result = append(result, javaTypeArguments(arg)...)
+	}
+	return result
+}
+
+// javaCallAnalyzer 基于函数体词法单元的 Java 调用分析
+type javaCallAnalyzer struct {
+	fe     *javaFrontend
+	file   *File
+	method *javaMethod
+	owner  *Entity
+	tokens []javaToken
+	lines  lineIndex
+	locals map[string]string // 局部变量/参数 -> 类型
+}
+
+func newJavaCallAnalyzer(fe *javaFrontend, file *File, m *javaMethod) *javaCallAnalyzer {
+	a := &javaCallAnalyzer{
+		fe:     fe,
+		file:   file,
+		method: m,
+		owner:  m.owner.entity,
+		tokens: m.body,
+		locals: make(map[string]string),
+	}
+	if unit := fe.units[file]; unit != nil {
+		a.lines = unit.lines
+	}
+	for _, param := range m.params {
+		a.locals[param.name] = param.typ
+	}
+	a.collectLocals()
+	return a
+}
+
+func (a *javaCallAnalyzer) token(index int) javaToken {
+	if index < 0 || index >= len(a.tokens) {
+		return javaToken{kind: javaEOF}
+	}
+	return a.tokens[index]
+}
+
+// parseTypeAt 从 index 开始解析类型，返回类型文本和类型之后的位置
+func (a *javaCallAnalyzer) parseTypeAt(index int) (string, int) {
+	t := a.token(index)
+	if t.kind != javaIdent || isJavaKeyword(t.text) {
+		return "", index
+	}
+	var sb strings.Builder
+	sb.WriteString(t.text)
+	i := index + 1
+	for {
+		switch {
+		case a.token(i).is(".") && a.token(i+1).kind == javaIdent && !isJavaKeyword(a.token(i+1).text):
+			sb.WriteString("." + a.token(i+1).text)
+			i += 2
+		case a.token(i).is("<"):
+			depth, start := 0, i
+			for ; ; i++ {
+				tk := a.token(i)
+				switch {
+				case tk.is("<"):
+					depth++
+				case tk.is(">"):
+					depth--
+				case tk.kind == javaIdent || tk.is(",") || tk.is(".") || tk.is("?") || tk.is("[") || tk.is("]") || tk.is("&"):
+				default:
+					return "", index
+				}
+				if depth == 0 {
+					i++
+					break
+				}
+			}
+			sb.WriteString(joinJavaTokens(a.tokens[start:i]))
+		case a.token(i).is("[") && a.token(i+1).is("]"):
+			sb.WriteString("[]")
+			i += 2
+		default:
+			return sb.String(), i
+		}
+	}
+}
+
+// collectLocals 收集局部变量声明：Type name = / ; / , / : / )
+func (a *javaCallAnalyzer) collectLocals() {
+	for i := range a.tokens {
+		prev := a.token(i - 1)
+		if !(prev.is("{") || prev.is(";") || prev.is("(") || prev.is(",") || prev.is("}") || prev.is("final") || prev.is(")")) {
+			continue
+		}
+		typ, j := a.parseTypeAt(i)
+		if len(typ) == 0 {
+			continue
+		}
+		name := a.token(j)
+		if name.kind != javaIdent || isJavaKeyword(name.text) {
+			continue
+		}
+		after := a.token(j + 1)
+		if !(after.is("=") || after.is(";") || after.is(",") || after.is(":") || after.is(")")) {
+			continue
+		}
+		if typ == "var" && after.is("=") && a.token(j+2).is("new") {
+			typ, _ = a.parseTypeAt(j + 3)
+		}
+		if len(typ) > 0 && typ != "var" {
+			a.locals[name.text] = typ
+		}
+	}
+}
+
+// calls 返回函数体内能解析到的调用，调用位置从方法名或 new 到右括号，方法引用只有方法名
+func (a *javaCallAnalyzer) calls() []funcCall {
+	var calls []funcCall
+	for i, t := range a.tokens {
+		var callee *Function
+		end := t.end()
+		switch {
+		case t.is("new"):
+			typ, j := a.parseTypeAt(i + 1)
+			if len(typ) > 0 && a.token(j).is("(") {
+				if entity := a.fe.resolveType(a.file, typ); entity != nil {
+					callee = entity.FindMethodByName(javaSimpleName(entity.Name))
+				}
+				end = a.closeEnd(j, end)
+			}
+		case t.kind == javaIdent && a.token(i+1).is("(") && !a.token(i-1).is("new") && !a.afterNew(i):
+			callee = a.resolveCall(i)
+			end = a.closeEnd(i+1, end)
+		case t.kind == javaIdent && a.token(i-1).is(":") && a.token(i-2).is(":"):
+			// 方法引用 Type::method / obj::method
+			if entity := a.evalEntity(i - 3); entity != nil {
+				callee = entity.ResolveMethod(t.text)
+			}
+		}
+		if callee != nil {
+			calls = append(calls, funcCall{callee: callee, site: a.lines.span(t.offset, end)})
+		}
+	}
+	return calls
+}
+
+// closeEnd open 处的左括号对应的右括号结束的偏移，没有匹配时返回 def
+func (a *javaCallAnalyzer) closeEnd(open, def int) int {
+	depth := 0
+	for i := open; i < len(a.tokens); i++ {
+		switch {
+		case a.tokens[i].is("("):
+			depth++
+		case a.tokens[i].is(")"):
+			depth--
+			if depth == 0 {
+				return a.tokens[i].end()
+			}
+		}
+	}
+	return def
+}
+
+// afterNew 是否为 new a.b.Type( 中的全限定类型名
+func (a *javaCallAnalyzer) afterNew(index int) bool {
+	for i := index; a.token(i-1).is(".") && a.token(i-2).kind == javaIdent; i -= 2 {
+		if a.token(i - 3).is("new") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveCall 解析 index 处的方法调用 name(...)
+func (a *javaCallAnalyzer) resolveCall(index int) *Function {
+	name := a.token(index).text
+	switch {
+	case name == "this":
+		return a.owner.FindMethodByName(javaSimpleName(a.owner.Name))
+	case name == "super":
+		if super := a.fe.superClass(a.owner); super != nil {
+			return super.FindMethodByName(javaSimpleName(super.Name))
+		}
+		return nil
+	case isJavaKeyword(name):
+		return nil
+	}
+	if a.token(index - 1).is(".") {
+		if receiver := a.evalEntity(index - 2); receiver != nil {
+			return receiver.ResolveMethod(name)
+		}
+		return nil
+	}
+	// 未限定的调用：当前类及其父类，再到外部类
+	for t := a.method.owner; t != nil; t = t.outer {
+		if t.entity == nil {
+			continue
+		}
+		if fun := t.entity.ResolveMethod(name); fun != nil {
+			return fun
+		}
+	}
+	return nil
+}
+
+// evalEntity 推断以 index 结尾的表达式的类型实体
+func (a *javaCallAnalyzer) evalEntity(index int) *Entity {
+	t := a.token(index)
+	switch {
+	case t.is("this"):
+		if a.token(index - 1).is(".") {
+			// Outer.this
+			return a.fe.resolveType(a.file, a.token(index-2).text)
+		}
+		return a.owner
+	case t.is("super"):
+		return a.fe.superClass(a.owner)
+	case t.kind == javaIdent && a.token(index-1).is("."):
+		if receiver := a.evalEntity(index - 2); receiver != nil {
+			if field := a.fe.findField(receiver, t.text, make(map[*Entity]bool)); field != nil {
+				return a.fe.resolveType(field.file, field.ObjType)
+			}
+			return nil
+		}
+		// 全限定类型名 a.b.Type
+		return a.fe.resolveType(a.file, a.qualifiedNameAt(index))
+	case t.kind == javaIdent:
+		if typ, ok := a.locals[t.text]; ok {
+			return a.fe.resolveType(a.file, typ)
+		}
+		for owner := a.method.owner; owner != nil; owner = owner.outer {
+			if owner.entity == nil {
+				continue
+			}
+			if field := a.fe.findField(owner.entity, t.text, make(map[*Entity]bool)); field != nil {
+				return a.fe.resolveType(field.file, field.ObjType)
+			}
+		}
+		// 静态调用 Type.method()
+		return a.fe.resolveType(a.file, t.text)
+	case t.is(")"):
+		open := a.matchOpen(index)
+		if open < 0 {
+			return nil
+		}
+		before := a.token(open - 1)
+		switch {
+		case before.kind == javaIdent && a.token(open-2).is("new"):
+			return a.fe.resolveType(a.file, before.text)
+		case before.kind == javaIdent && a.afterNew(open-1):
+			return a.fe.resolveType(a.file, a.qualifiedNameAt(open-1))
+		case before.is(">"):
+			// new Type<>()
+			if typ, j := a.parseTypeAtNew(open); len(typ) > 0 && j == open {
+				return a.fe.resolveType(a.file, typ)
+			}
+		case before.kind == javaIdent:
+			if callee := a.resolveCall(open - 1); callee != nil && len(callee.Results) > 0 {
+				return a.fe.resolveType(callee.Results[0].file, callee.Results[0].ObjType)
+			}
+		default:
+			// ((Type) expr)
+			if a.token(open + 1).is("(") {
+				if typ, j := a.parseTypeAt(open + 2); len(typ) > 0 && a.token(j).is(")") {
+					return a.fe.resolveType(a.file, typ)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parseTypeAtNew 向前查找 new，解析 new 之后到 open 之间的类型
+func (a *javaCallAnalyzer) parseTypeAtNew(open int) (string, int) {
+	for i := open - 1; i >= 0; i-- {
+		if a.token(i).is("new") {
+			return a.parseTypeAt(i + 1)
+		}
+		if a.token(i).is(";") || a.token(i).is("{") || a.token(i).is("}") {
+			break
+		}
+	}
+	return "", -1
+}
+
+// matchOpen 查找与 index 处右括号匹配的左括号
+func (a *javaCallAnalyzer) matchOpen(index int) int {
+	depth := 0
+	for i := index; i >= 0; i-- {
+		switch {
+		case a.token(i).is(")"):
+			depth++
+		case a.token(i).is("("):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// qualifiedNameAt 向前拼接以 index 结尾的 a.b.c
+func (a *javaCallAnalyzer) qualifiedNameAt(index int) string {
+	parts := []string{a.token(index).text}
+	for i := index; a.token(i-1).is(".") && a.token(i-2).kind == javaIdent; i -= 2 {
+		parts = append([]string{a.token(i - 2).text}, parts...)
+	}
+	return strings.Join(parts, ".")
+}
+
+func javaSimpleName(name string) string {
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		return name[index+1:]
+	}
+	return name
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type discardProjectRepo struct {
+	ProjectRepo
+}
+
+func (discardProjectRepo) SaveProject(ctx context.Context, project *Project) error {
+	return nil
+}
+
+func (discardProjectRepo) FailUnfinishedAnalysisJobs(ctx context.Context, reason string, finishedAt int64) (int64, error) {
+	return 0, nil
+}
+
+func (discardProjectRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	return nil, nil
+}
+
+func writeSources(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func analyzeSources(t *testing.T, language v1.Language, files map[string]string) *Project {
+	t.Helper()
+	root := writeSources(t, files)
+	project := NewProject(&v1.Repo{Id: "repo", Language: language}, nil)
+	if err := project.Analyze(context.Background(), root, discardProjectRepo{}); err != nil {
+		t.Fatal(err)
+	}
+	return project
+}
+
+func hasRelation(project *Project, relationType, source, target string) bool {
+	for _, r := range project.Relations {
+		if r.Type == relationType && r.SourceID == source && r.TargetID == target {
+			return true
+		}
+	}
+	return false
+}
+
+func TestJavaFrontend(t *testing.T) {
+	project := analyzeSources(t, v1.Language_Java, map[string]string{
+		"src/com/example/repo/UserRepo.java": `package com.example.repo;
+
+import com.example.model.User;
+
+/** 用户仓库 */
+public interface UserRepo {
+    User find(String id);
+}
+`,
+		"src/com/example/repo/MysqlUserRepo.java": `package com.example.repo;
+
+import com.example.model.*;
+import java.util.Map;
+
+public class MysqlUserRepo extends BaseRepo implements UserRepo {
+    private final Map<String, User> cache = new HashMap<String, User>(), other;
+
+    @Override
+    public User find(String id) {
+        User user = cache.get(id);
+        if (user == null) {
+            user = load(id);
+        }
+        return user.normalize();
+    }
+}
+`,
+		"src/com/example/repo/BaseRepo.java": `package com.example.repo;
+
+abstract class BaseRepo {
+    protected com.example.model.User load(String id) {
+        return new com.example.model.User(id);
+    }
+}
+`,
+		"src/com/example/model/User.java": `package com.example.model;
+
+public class User {
+    private String id;
+
+    public User(String id) {
+        this.id = id;
+    }
+
+    public User normalize() {
+        return this;
+    }
+
+    public static class Builder {
+        User build() {
+            return new User("").normalize();
+        }
+    }
+}
+`,
+		"src/com/example/service/UserService.java": `package com.example.service;
+
+import com.example.repo.UserRepo;
+
+public class UserService {
+    private UserRepo repo;
+
+    public String name(String id) {
+        return repo.find(id).normalize().toString();
+    }
+}
+`,
+	})
+
+	repoPkg := project.Root.ID + "@src@com@example@repo"
+	modelPkg := project.Root.ID + "@src@com@example@model"
+	servicePkg := project.Root.ID + "@src@com@example@service"
+	userRepo := repoPkg + "@UserRepo.java:UserRepo"
+	mysqlRepo := repoPkg + "@MysqlUserRepo.java:MysqlUserRepo"
+	baseRepo := repoPkg + "@BaseRepo.java:BaseRepo"
+	user := modelPkg + "@User.java:User"
+
+	entity := project.GetEntity(repoPkg, "MysqlUserRepo")
+	if entity == nil || entity.Type != Struct {
+		t.Fatalf("MysqlUserRepo entity = %+v", entity)
+	}
+	if doc := project.GetEntity(repoPkg, "UserRepo").Document; doc != "用户仓库" {
+		t.Errorf("UserRepo document = %q", doc)
+	}
+	if project.GetEntity(modelPkg, "User.Builder") == nil {
+		t.Errorf("nested class User.Builder not found")
+	}
+	// 包内可见的类型和方法不导出，接口方法隐式导出
+	builder := project.GetEntity(modelPkg, "User.Builder")
+	if !entity.Exported || project.GetEntity(repoPkg, "BaseRepo").Exported || !builder.Exported || builder.FindMethodByName("build").Exported {
+		t.Errorf("unexpected exported flags of MysqlUserRepo, BaseRepo or User.Builder")
+	}
+	if find := project.GetEntity(repoPkg, "UserRepo").FindMethodByName("find"); find == nil || !find.Exported {
+		t.Errorf("UserRepo.find = %+v", find)
+	}
+
+	for _, want := range []struct {
+		relationType, source, target string
+	}{
+		{Extends, mysqlRepo, baseRepo},
+		{Extends, mysqlRepo, userRepo},
+		{Implement, mysqlRepo, userRepo},
+		{DispatchesTo, userRepo + ":UserRepo.find", repoPkg + ":MysqlUserRepo.find"},
+		{HasFields, mysqlRepo, user},
+		{Call, repoPkg + ":MysqlUserRepo.find", repoPkg + ":BaseRepo.load"},
+		{Call, repoPkg + ":MysqlUserRepo.find", modelPkg + ":User.normalize"},
+		{Call, repoPkg + ":BaseRepo.load", modelPkg + ":User.User"},
+		{Call, modelPkg + ":User.Builder.build", modelPkg + ":User.normalize"},
+		{Call, servicePkg + ":UserService.name", userRepo + ":UserRepo.find"},
+		{Call, servicePkg + ":UserService.name", modelPkg + ":User.normalize"},
+	} {
+		if !hasRelation(project, want.relationType, want.source, want.target) {
+			t.Errorf("missing %s %s -> %s", want.relationType, want.source, want.target)
+		}
+	}
+}
+
+func TestLexJavaPosition(t *testing.T) {
+	tokens := lexJava([]byte("/* 注释 */ class A {\n  String s = \"x}\";\n}"))
+	var texts []string
+	for _, tk := range tokens {
+		texts = append(texts, tk.text)
+	}
+	if len(tokens) != 10 {
+		t.Fatalf("tokens = %q", texts)
+	}
+	if tokens[0].text != "class" || tokens[0].line != 1 || tokens[0].column != 10 {
+		t.Errorf("class token = %+v", tokens[0])
+	}
+	if tokens[4].text != "s" || tokens[4].line != 2 || tokens[4].column != 10 {
+		t.Errorf("s token = %+v", tokens[4])
+	}
+}
+
+func TestParseJavaMalformedAnnotation(t *testing.T) {
+	for _, src := range []string{
+		"class A { Foo @interface }",
+		"class A { void m(Foo @interface x) {} }",
+		"class A extends B @interface {}",
+	} {
+		done := make(chan struct{})
+		go func() {
+			parseJava([]byte(src))
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("parseJava(%q) did not return", src)
+		}
+	}
+}
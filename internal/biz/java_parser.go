@@ -0,0 +1,730 @@
+package biz
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type javaTokenKind int
+
+const (
+	javaEOF javaTokenKind = iota
+	javaIdent
+	javaNumber
+	javaString
+	javaPunct
+)
+
+// javaToken Java 词法单元，关键字也按标识符处理
+type javaToken struct {
+	kind   javaTokenKind
+	text   string
+	offset int
+	line   int
+	column int
+	doc    string // 紧邻的 javadoc 注释
+}
+
+func (t javaToken) is(text string) bool {
+	return t.kind != javaString && t.text == text
+}
+
+// end 词法单元结束的偏移
+func (t javaToken) end() int {
+	return t.offset + len(t.text)
+}
+
+// javaModifiers 声明修饰符
+var javaModifiers = map[string]bool{
+	"public": true, "protected": true, "private": true, "static": true, "final": true,
+	"abstract": true, "native": true, "synchronized": true, "transient": true, "volatile": true,
+	"strictfp": true, "default": true, "sealed": true,
+}
+
+// javaKeywords 不能作为类型名/变量名/方法名的关键字
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": false, "break": true, "byte": false, "case": true,
+	"catch": true, "char": false, "class": true, "const": true, "continue": true, "default": true,
+	"do": true, "double": false, "else": true, "enum": true, "extends": true, "final": true,
+	"finally": true, "float": false, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": false, "interface": true, "long": false, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true, "return": true,
+	"short": false, "static": true, "strictfp": true, "super": true, "switch": true, "synchronized": true,
+	"this": true, "throw": true, "throws": true, "transient": true, "try": true, "void": false,
+	"volatile": true, "while": true, "null": true, "true": true, "false": true,
+}
+
+func isJavaKeyword(text string) bool {
+	return javaKeywords[text]
+}
+
+// lexJava 词法分析，跳过注释并记录行列号
+func lexJava(src []byte) []javaToken {
+	var tokens []javaToken
+	line, column := 1, 1
+	doc := ""
+	advance := func(from, to int) {
+		for i := from; i < to; i++ {
+			if src[i] == '\n' {
+				line++
+				column = 1
+			} else if src[i]&0xC0 != 0x80 {
+				column++
+			}
+		}
+	}
+	emit := func(kind javaTokenKind, start, end int) {
+		tokens = append(tokens, javaToken{kind: kind, text: string(src[start:end]), offset: start, line: line, column: column, doc: doc})
+		doc = ""
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f':
+			i++
+			advance(start, i)
+			continue
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			advance(start, i)
+			continue
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(string(src[i+2:]), "*/")
+			if end < 0 {
+				i = len(src)
+			} else {
+				i = i + 2 + end + 2
+			}
+			if strings.HasPrefix(string(src[start:i]), "/**") {
+				doc = javadocText(string(src[start:i]))
+			}
+			advance(start, i)
+			continue
+		case c == '"' && strings.HasPrefix(string(src[i:]), `"""`):
+			i += 3
+			for i < len(src) && !strings.HasPrefix(string(src[i:]), `"""`) {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i = min(i+3, len(src))
+			emit(javaString, start, i)
+		case c == '"' || c == '\'':
+			i++
+			for i < len(src) && src[i] != c && src[i] != '\n' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i = min(i+1, len(src))
+			emit(javaString, start, i)
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			i++
+			for i < len(src) {
+				d := src[i]
+				if (d == '+' || d == '-') && (src[i-1] == 'e' || src[i-1] == 'E' || src[i-1] == 'p' || src[i-1] == 'P') {
+					i++
+					continue
+				}
+				if d == '.' || d == '_' || d >= '0' && d <= '9' || d >= 'a' && d <= 'z' || d >= 'A' && d <= 'Z' {
+					i++
+					continue
+				}
+				break
+			}
+			emit(javaNumber, start, i)
+		default:
+			r, size := utf8.DecodeRune(src[i:])
+			if r == '_' || r == '$' || unicode.IsLetter(r) {
+				i += size
+				for i < len(src) {
+					r, size = utf8.DecodeRune(src[i:])
+					if r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+						break
+					}
+					i += size
+				}
+				emit(javaIdent, start, i)
+			} else {
+				i += size
+				emit(javaPunct, start, i)
+			}
+		}
+		advance(start, i)
+	}
+	tokens = append(tokens, javaToken{kind: javaEOF, offset: len(src), line: line, column: column})
+	return tokens
+}
+
+// javadocText 去掉 javadoc 的注释符号
+func javadocText(comment string) string {
+	comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/**"), "*/")
+	var lines []string
+	for _, l := range strings.Split(comment, "\n") {
+		l = strings.TrimSpace(l)
+		l = strings.TrimSpace(strings.TrimPrefix(l, "*"))
+		if len(l) > 0 {
+			lines = append(lines, l)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+type javaImport struct {
+	path       string
+	static     bool
+	wildcard   bool
+	start, end int
+}
+
+type javaCompilationUnit struct {
+	pkg     string
+	imports []*javaImport
+	types   []*javaType
+	lines   lineIndex
+}
+
+// javaType 类、接口、枚举、记录和注解类型的声明
+type javaType struct {
+	kind       string // class/interface/enum/record/@interface
+	name       string // 嵌套类型使用 Outer.Inner
+	simpleName string
+	doc        string
+	extends    []string
+	implements []string
+	fields     []*javaField
+	methods    []*javaMethod
+	outer      *javaType
+	pos        javaToken
+	start, end int // 从修饰符到类型体结束的偏移
+	entity     *Entity
+	visible    bool // 有 public 或 protected 修饰符
+}
+
+func (t *javaType) isInterface() bool {
+	return t.kind == "interface" || t.kind == "@interface"
+}
+
+// exported 类型是否对包外可见，接口的成员类型隐式为 public，嵌套类型还要求外部类型可见
+func (t *javaType) exported() bool {
+	if t.outer == nil {
+		return t.visible
+	}
+	return (t.visible || t.outer.isInterface()) && t.outer.exported()
+}
+
+type javaField struct {
+	name       string
+	typ        string
+	doc        string
+	start, end int // 从声明开始到该字段名或初始化表达式结束的偏移
+}
+
+type javaParam struct {
+	name string
+	typ  string
+}
+
+// javaMethod 方法或构造函数，body 为包含花括号的函数体词法单元
+type javaMethod struct {
+	name        string
+	result      string
+	params      []*javaParam
+	doc         string
+	constructor bool
+	body        []javaToken
+	pos         javaToken
+	start, end  int
+	owner       *javaType
+	visible     bool     // 有 public 或 protected 修饰符
+	annotations []string // 注解的简单名称，例如 Test
+}
+
+// exported 方法是否对包外可见，接口方法隐式为 public
+func (m *javaMethod) exported() bool {
+	return (m.visible || m.owner.isInterface()) && m.owner.exported()
+}
+
+func (m *javaMethod) Span() (int, int) {
+	return m.start, m.end
+}
+
+func (m *javaMethod) HasBody() bool {
+	return len(m.body) > 0
+}
+
+// javaParser 只解析声明结构，函数体保留为词法单元供调用分析使用
+type javaParser struct {
+	tokens []javaToken
+	pos    int
+	unit   *javaCompilationUnit
+}
+
+func parseJava(src []byte) *javaCompilationUnit {
+	p := &javaParser{tokens: lexJava(src), unit: &javaCompilationUnit{lines: newLineIndex(src)}}
+	p.parseCompilationUnit()
+	return p.unit
+}
+
+func (p *javaParser) peek(n int) javaToken {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *javaParser) cur() javaToken {
+	return p.peek(0)
+}
+
+func (p *javaParser) next() javaToken {
+	t := p.cur()
+	if p.pos < len(p.tokens)-1 {
+		p.pos++
+	}
+	return t
+}
+
+func (p *javaParser) eof() bool {
+	return p.cur().kind == javaEOF
+}
+
+func (p *javaParser) accept(text string) bool {
+	if p.cur().is(text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *javaParser) parseCompilationUnit() {
+	for !p.eof() {
+		start := p.pos
+		switch {
+		case p.cur().is("package"):
+			p.next()
+			p.unit.pkg = p.parseQualifiedName()
+			p.accept(";")
+		case p.cur().is("import"):
+			imp := &javaImport{start: p.next().offset, static: p.accept("static")}
+			imp.path = p.parseQualifiedName()
+			if p.accept(".") && p.accept("*") {
+				imp.wildcard = true
+			}
+			p.accept(";")
+			imp.end = p.tokens[p.pos-1].end()
+			p.unit.imports = append(p.unit.imports, imp)
+		default:
+			if t := p.parseTypeDecl(nil); t != nil {
+				p.unit.types = append(p.unit.types, t)
+			}
+		}
+		if p.pos == start {
+			p.next()
+		}
+	}
+}
+
+// parseQualifiedName 解析 a.b.c，遇到 .* 时停在点号之前
+func (p *javaParser) parseQualifiedName() string {
+	var parts []string
+	for p.cur().kind == javaIdent {
+		parts = append(parts, p.next().text)
+		if !p.cur().is(".") || p.peek(1).kind != javaIdent {
+			break
+		}
+		p.next()
+	}
+	return strings.Join(parts, ".")
+}
+
+// skipModifiers 跳过修饰符和注解，返回第一个词法单元上的 javadoc 及是否有 public 或 protected 修饰符
+func (p *javaParser) skipModifiers() (doc string, visible bool) {
+	doc = p.cur().doc
+	for !p.eof() {
+		switch {
+		case p.cur().is("@") && !p.peek(1).is("interface"):
+			p.next()
+			p.parseQualifiedName()
+			if p.cur().is("(") {
+				p.skipBalanced("(", ")")
+			}
+		case p.cur().kind == javaIdent && javaModifiers[p.cur().text]:
+			visible = visible || p.cur().text == "public" || p.cur().text == "protected"
+			p.next()
+		case p.cur().is("non") && p.peek(1).is("-") && p.peek(2).is("sealed"):
+			p.pos += 3
+		default:
+			return doc, visible
+		}
+	}
+	return doc, visible
+}
+
+// annotations 从 from 到当前位置的修饰符和类型中注解的简单名称
+func (p *javaParser) annotations(from int) []string {
+	var names []string
+	for i := from; i+1 < p.pos; i++ {
+		if !p.tokens[i].is("@") || p.tokens[i+1].kind != javaIdent {
+			continue
+		}
+		i++
+		for i+2 < p.pos && p.tokens[i+1].is(".") && p.tokens[i+2].kind == javaIdent {
+			i += 2
+		}
+		names = append(names, p.tokens[i].text)
+	}
+	return names
+}
+
+// skipBalanced 当前词法单元为 open，跳到与之匹配的 close 之后，返回跳过的词法单元
+func (p *javaParser) skipBalanced(open, close string) []javaToken {
+	start := p.pos
+	depth := 0
+	for !p.eof() {
+		t := p.next()
+		if t.is(open) {
+			depth++
+		} else if t.is(close) {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+	return p.tokens[start:p.pos]
+}
+
+func (p *javaParser) isTypeDeclStart() bool {
+	t := p.cur()
+	switch {
+	case t.is("class") || t.is("interface") || t.is("enum"):
+		return true
+	case t.is("record"):
+		return p.peek(1).kind == javaIdent && (p.peek(2).is("(") || p.peek(2).is("<"))
+	case t.is("@"):
+		return p.peek(1).is("interface")
+	}
+	return false
+}
+
+// parseTypeDecl 解析类型声明，当前位置不是类型声明时回退并返回 nil
+func (p *javaParser) parseTypeDecl(outer *javaType) *javaType {
+	start := p.pos
+	doc, visible := p.skipModifiers()
+	if !p.isTypeDeclStart() {
+		p.pos = start
+		return nil
+	}
+	t := &javaType{start: p.tokens[start].offset, kind: p.next().text, doc: doc, outer: outer, visible: visible}
+	if t.kind == "@" {
+		p.next()
+		t.kind = "@interface"
+	}
+	if p.cur().kind != javaIdent {
+		return nil
+	}
+	t.pos = p.cur()
+	t.simpleName = p.next().text
+	t.name = t.simpleName
+	if outer != nil {
+		t.name = outer.name + "." + t.simpleName
+	}
+	if p.cur().is("<") {
+		p.skipBalanced("<", ">")
+	}
+	if t.kind == "record" && p.cur().is("(") {
+		p.next()
+		for !p.eof() && !p.accept(")") {
+			fieldStart := p.cur().offset
+			p.skipModifiers()
+			typ := p.parseType()
+			if p.cur().kind == javaIdent {
+				name := p.next()
+				t.fields = append(t.fields, &javaField{name: name.text, typ: typ, start: fieldStart, end: name.end()})
+			}
+			if !p.accept(",") && !p.cur().is(")") {
+				p.next()
+			}
+		}
+	}
+	for !p.eof() && !p.cur().is("{") {
+		switch {
+		case p.accept("extends"):
+			t.extends = p.parseTypeList()
+		case p.accept("implements"):
+			t.implements = p.parseTypeList()
+		case p.accept("permits"):
+			p.parseTypeList()
+		default:
+			p.next()
+		}
+	}
+	p.parseTypeBody(t)
+	t.end = p.tokens[p.pos-1].end()
+	return t
+}
+
+func (p *javaParser) parseTypeList() []string {
+	var types []string
+	for {
+		p.skipModifiers()
+		typ := p.parseType()
+		if len(typ) == 0 {
+			return types
+		}
+		types = append(types, typ)
+		if !p.accept(",") {
+			return types
+		}
+	}
+}
+
+// parseType 解析类型，返回去掉空白的类型文本，无法解析时返回空字符串
+func (p *javaParser) parseType() string {
+	if p.cur().kind != javaIdent || isJavaKeyword(p.cur().text) {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(p.next().text)
+	for {
+		switch {
+		case p.cur().is(".") && p.peek(1).kind == javaIdent:
+			p.next()
+			sb.WriteString("." + p.next().text)
+		case p.cur().is("."):
+			p.next()
+		case p.cur().is("<"):
+			sb.WriteString(joinJavaTokens(p.skipBalanced("<", ">")))
+		case p.cur().is("[") && p.peek(1).is("]"):
+			p.pos += 2
+			sb.WriteString("[]")
+		case p.cur().is("@") && !p.peek(1).is("interface"):
+			p.skipModifiers()
+		default:
+			// @interface 不是类型注解，到此结束
+			typ := sb.String()
+			if p.cur().is(".") && p.peek(1).is(".") && p.peek(2).is(".") {
+				p.pos += 3
+				typ += "..."
+			}
+			return typ
+		}
+	}
+}
+
+// joinJavaTokens 拼接词法单元，相邻的标识符之间保留空格，例如 <? extends T>
+func joinJavaTokens(tokens []javaToken) string {
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t.kind == javaIdent && tokens[i-1].kind == javaIdent {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(t.text)
+	}
+	return sb.String()
+}
+
+// parseTypeBody 解析类型体，当前位置为 {
+func (p *javaParser) parseTypeBody(t *javaType) {
+	if !p.accept("{") {
+		return
+	}
+	if t.kind == "enum" {
+		p.skipEnumConstants()
+	}
+	for !p.eof() && !p.accept("}") {
+		start := p.pos
+		p.parseMember(t)
+		if p.pos == start {
+			p.next()
+		}
+	}
+}
+
+// skipEnumConstants 跳过枚举常量列表
+func (p *javaParser) skipEnumConstants() {
+	for !p.eof() {
+		switch {
+		case p.accept(";"):
+			return
+		case p.cur().is("}"):
+			return
+		case p.cur().is("("):
+			p.skipBalanced("(", ")")
+		case p.cur().is("{"):
+			p.skipBalanced("{", "}")
+		default:
+			p.next()
+		}
+	}
+}
+
+func (p *javaParser) parseMember(t *javaType) {
+	if p.accept(";") {
+		return
+	}
+	if nested := p.parseTypeDecl(t); nested != nil {
+		p.unit.types = append(p.unit.types, nested)
+		return
+	}
+	start := p.cur()
+	modifiers := p.pos
+	doc, visible := p.skipModifiers()
+	if p.cur().is("{") {
+		// 初始化块
+		p.skipBalanced("{", "}")
+		return
+	}
+	if p.cur().is("<") {
+		p.skipBalanced("<", ">")
+	}
+	if p.cur().is(t.simpleName) && p.peek(1).is("(") {
+		m := &javaMethod{name: p.cur().text, constructor: true, doc: doc, pos: p.next(), start: start.offset, owner: t, visible: visible}
+		p.parseMethodRest(t, m)
+		return
+	}
+	if t.kind == "record" && p.cur().is(t.simpleName) && p.peek(1).is("{") {
+		// 紧凑构造函数
+		m := &javaMethod{name: p.cur().text, constructor: true, doc: doc, pos: p.next(), start: start.offset, owner: t, visible: visible}
+		m.body = p.skipBalanced("{", "}")
+		m.end = p.tokens[p.pos-1].offset + 1
+		t.methods = append(t.methods, m)
+		return
+	}
+	typ := p.parseType()
+	if len(typ) == 0 || p.cur().kind != javaIdent {
+		p.skipStatement()
+		return
+	}
+	if p.peek(1).is("(") {
+		m := &javaMethod{name: p.cur().text, result: typ, doc: doc, pos: p.next(), start: start.offset, owner: t, visible: visible}
+		m.annotations = p.annotations(modifiers)
+		p.parseMethodRest(t, m)
+		return
+	}
+	p.parseFields(t, typ, doc, start.offset)
+}
+
+// parseMethodRest 解析参数列表、throws 和函数体，当前位置为 (
+func (p *javaParser) parseMethodRest(t *javaType, m *javaMethod) {
+	p.next()
+	for !p.eof() && !p.accept(")") {
+		p.skipModifiers()
+		typ := p.parseType()
+		if p.cur().kind == javaIdent {
+			name := p.next().text
+			if name == "this" {
+				// 接收者参数
+				typ = ""
+			}
+			for p.cur().is("[") && p.peek(1).is("]") {
+				p.pos += 2
+				typ += "[]"
+			}
+			if len(typ) > 0 {
+				m.params = append(m.params, &javaParam{name: name, typ: typ})
+			}
+		} else if p.cur().is(".") {
+			// Outer.this 接收者参数
+			p.next()
+		}
+		if !p.accept(",") && !p.cur().is(")") {
+			p.next()
+		}
+	}
+	for p.cur().is("[") && p.peek(1).is("]") {
+		p.pos += 2
+		m.result += "[]"
+	}
+	for !p.eof() {
+		switch {
+		case p.cur().is("{"):
+			m.body = p.skipBalanced("{", "}")
+			m.end = p.tokens[p.pos-1].offset + 1
+			t.methods = append(t.methods, m)
+			return
+		case p.cur().is(";"):
+			m.end = p.next().offset + 1
+			t.methods = append(t.methods, m)
+			return
+		case p.cur().is("}"):
+			return
+		default:
+			p.next()
+		}
+	}
+}
+
+// parseFields 解析字段声明 Type a = x, b;，start 为声明开始的偏移
+func (p *javaParser) parseFields(t *javaType, typ, doc string, start int) {
+	for !p.eof() {
+		if p.cur().kind != javaIdent {
+			p.skipStatement()
+			return
+		}
+		field := &javaField{name: p.next().text, typ: typ, doc: doc, start: start}
+		for p.cur().is("[") && p.peek(1).is("]") {
+			p.pos += 2
+			field.typ += "[]"
+		}
+		t.fields = append(t.fields, field)
+		if p.accept("=") {
+			p.skipInitializer()
+		}
+		field.end = p.tokens[p.pos-1].end()
+		if !p.accept(",") {
+			p.accept(";")
+			return
+		}
+	}
+}
+
+// skipInitializer 跳过字段初始化表达式，停在声明之间的逗号或分号上
+func (p *javaParser) skipInitializer() {
+	depth := 0
+	for !p.eof() {
+		t := p.cur()
+		switch {
+		case t.is("(") || t.is("{") || t.is("["):
+			depth++
+		case t.is(")") || t.is("}") || t.is("]"):
+			if depth == 0 {
+				return
+			}
+			depth--
+		case t.is(";") && depth == 0:
+			return
+		case t.is(",") && depth == 0:
+			// 泛型参数中的逗号不是声明分隔符
+			if p.peek(1).kind == javaIdent && (p.peek(2).is("=") || p.peek(2).is(",") || p.peek(2).is(";") || p.peek(2).is("[")) {
+				return
+			}
+		}
+		p.next()
+	}
+}
+
+// skipStatement 错误恢复：跳到分号或下一个花括号块之后
+func (p *javaParser) skipStatement() {
+	for !p.eof() {
+		switch {
+		case p.cur().is(";"):
+			p.next()
+			return
+		case p.cur().is("{"):
+			p.skipBalanced("{", "}")
+			return
+		case p.cur().is("}"):
+			return
+		default:
+			p.next()
+		}
+	}
+}
@@ -62,20 +62,33 @@ func (p *Package) Parse(ctx context.Context, rootPath string) error {
 				continue
 			}
 
+			if err = ctx.Err(); err != nil {
+				return err
+			}
 			file := NewFile(rootPath, dir.Name(), p)
-			if err = file.Parse(file.FilePath); err != nil {
+			if err = file.Parse(); err != nil {
 				return err
 			}
 			p.Files = append(p.Files, file)
+			p.project.fileProcessed()
 
 		}
 	}
 	return nil
 }
+
+// AnalyzeRelations 分析包内文件的关系，子包由 Project.AnalyzeRelations 逐个分析
 func (p *Package) AnalyzeRelations(ctx context.Context, module string) error {
 	for _, file := range p.Files {
-		if err := file.AnalyzeRelations(ctx, p); err != nil {
-			return err
+		// 增量分析时跳过未变化的文件
+		if p.GetProject().IsAnalyzed(file) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := file.AnalyzeRelations(ctx, p); err != nil {
+				return err
+			}
+			p.GetProject().fileProcessed()
 		}
 
 		var relations []*Relation
@@ -85,11 +98,6 @@ func (p *Package) AnalyzeRelations(ctx context.Context, module string) error {
 			SourceID:   file.PkgID})
 		p.GetProject().AddRelations(relations)
 	}
-	for _, pkg := range p.Packages {
-		if err := pkg.AnalyzeRelations(ctx, module); err != nil {
-			return nil
-		}
-	}
 	return nil
 }
 
@@ -182,6 +190,7 @@ func (p *Package) AnalyzeInterfaceImplRelations(ctx context.Context, project *Pr
 					Confidence: 0,
 					SourceID:   e.ID,
 				})
+				relations = append(relations, entity.AnalyzeDispatchRelations(e)...)
 			}
 		}
 	}
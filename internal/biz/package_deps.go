@@ -0,0 +1,103 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+)
+
+// GetPackageDependencies 仓库或子包范围内的包依赖图，以及依赖图中的循环依赖
+func (c *CodeWiki) GetPackageDependencies(ctx context.Context, req *v1.GetPackageDependenciesReq) (*v1.GetPackageDependenciesResp, error) {
+	scope := req.Package
+	if scope != "" {
+		var err error
+		if scope, err = c.snapshotNodeID(ctx, scope, req.Snapshot); err != nil {
+			return nil, err
+		}
+	} else {
+		s, err := c.resolveSnapshot(ctx, req.Id, req.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return &v1.GetPackageDependenciesResp{}, nil
+		}
+		scope = s.Id
+	}
+	packages, dependencies, err := c.projectRepo.GetPackageDependencies(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(packages, func(i, j int) bool { return packages[i].Id < packages[j].Id })
+	sort.Slice(dependencies, func(i, j int) bool {
+		a, b := dependencies[i], dependencies[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.Target < b.Target
+	})
+	resp := &v1.GetPackageDependenciesResp{Packages: packages, Dependencies: dependencies}
+	for _, component := range dependencyCycles(dependencies) {
+		resp.Cycles = append(resp.Cycles, &v1.PackageCycle{Packages: component})
+	}
+	return resp, nil
+}
+
+// dependencyCycles 用 Tarjan 算法求依赖图中包含多个包的强连通分量
+// 分量内的包按 ID 排序，分量按第一个包排序
+func dependencyCycles(dependencies []*v1.PackageDependency) [][]string {
+	edges := make(map[string][]string)
+	var nodes []string
+	for _, d := range dependencies {
+		if _, ok := edges[d.Source]; !ok {
+			nodes = append(nodes, d.Source)
+		}
+		edges[d.Source] = append(edges[d.Source], d.Target)
+	}
+	sort.Strings(nodes)
+
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+	var visit func(node string)
+	visit = func(node string) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+		for _, next := range edges[node] {
+			if _, ok := index[next]; !ok {
+				visit(next)
+				lowLink[node] = min(lowLink[node], lowLink[next])
+			} else if onStack[next] {
+				lowLink[node] = min(lowLink[node], index[next])
+			}
+		}
+		if lowLink[node] != index[node] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == node {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Strings(component)
+			components = append(components, component)
+		}
+	}
+	for _, node := range nodes {
+		if _, ok := index[node]; !ok {
+			visit(node)
+		}
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+	return components
+}
@@ -0,0 +1,72 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// dependencyRepo 返回固定依赖边的仓储，记录查询的范围
+type dependencyRepo struct {
+	discardProjectRepo
+	dependencies []*v1.PackageDependency
+	scope        string
+}
+
+func (r *dependencyRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	return []*v1.Snapshot{{Id: "repo~new", Commit: "bbbbbbbb"}, {Id: "repo~old", Commit: "aaaaaaaa"}}, nil
+}
+
+func (r *dependencyRepo) GetPackageDependencies(ctx context.Context, scope string) ([]*v1.PackageNode, []*v1.PackageDependency, error) {
+	r.scope = scope
+	var packages []*v1.PackageNode
+	seen := make(map[string]bool)
+	for _, d := range r.dependencies {
+		for _, id := range []string{d.Source, d.Target} {
+			if !seen[id] {
+				seen[id] = true
+				packages = append(packages, &v1.PackageNode{Id: id})
+			}
+		}
+	}
+	return packages, r.dependencies, nil
+}
+
+func TestGetPackageDependencies(t *testing.T) {
+	var dependencies []*v1.PackageDependency
+	for _, edge := range []string{"f>a", "a>b", "b>c", "c>a", "c>d", "d>e", "e>d", "e>g"} {
+		source, target, _ := strings.Cut(edge, ">")
+		dependencies = append(dependencies, &v1.PackageDependency{Source: "repo~new@r@" + source, Target: "repo~new@r@" + target, Count: 1})
+	}
+	repo := &dependencyRepo{dependencies: dependencies}
+	c := newTestCodeWiki(t, repo)
+
+	resp, err := c.GetPackageDependencies(context.Background(), &v1.GetPackageDependenciesReq{Id: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.scope != "repo~new" || len(resp.Packages) != 7 || resp.Dependencies[0].Source != "repo~new@r@a" {
+		t.Errorf("scope = %s, resp = %v", repo.scope, resp)
+	}
+	var cycles [][]string
+	for _, cycle := range resp.Cycles {
+		cycles = append(cycles, cycle.Packages)
+	}
+	want := [][]string{
+		{"repo~new@r@a", "repo~new@r@b", "repo~new@r@c"},
+		{"repo~new@r@d", "repo~new@r@e"},
+	}
+	if !reflect.DeepEqual(cycles, want) {
+		t.Errorf("cycles = %v, want %v", cycles, want)
+	}
+
+	// 子包范围换到请求的快照中
+	if _, err = c.GetPackageDependencies(context.Background(), &v1.GetPackageDependenciesReq{Id: "repo", Package: "repo~new@r@a", Snapshot: "aaaa"}); err != nil {
+		t.Fatal(err)
+	}
+	if repo.scope != "repo~old@r@a" {
+		t.Errorf("scope = %s", repo.scope)
+	}
+}
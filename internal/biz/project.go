@@ -4,6 +4,7 @@ import (
 	v1 "codewiki/api/codewiki/v1"
 	"context"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
 	"golang.org/x/mod/modfile"
 	"os"
 	"path/filepath"
@@ -11,65 +12,161 @@ import (
 )
 
 type Project struct {
-	config      *Config
-	module      string
-	RootPath    string
-	pkgs        map[string]*Package
-	Relations   []*Relation
-	Root        *Package
+	config    *Config
+	module    string
+	RootPath  string
+	pkgs      map[string]*Package
+	Relations []*Relation
+	Root      *Package
+	// 分析的 git 提交，非 git 仓库时为空
+	Commit      string
 	Repo        *v1.Repo
 	relationMap map[string]bool
 	indexer     *Indexer
+	// 类型检查解析器，为空时使用 AST 启发式解析调用关系
+	typeResolver *TypeResolver
+	// 语言前端
+	frontend LanguageFrontend
+	// 与上次分析结果相比的文件变更
+	Changes *FileChanges
+	// 需要重新分析的文件，为空时全部分析
+	analyzed map[string]bool
+	// 分析进度回调
+	progress AnalyzeProgress
+	// 当前阶段及其已处理、需处理的文件数
+	phase            v1.AnalysisPhase
+	processed, total int
 }
+
+// AnalyzeProgress 分析进度回调，total 为 0 时表示总数未知
+type AnalyzeProgress func(phase v1.AnalysisPhase, processed, total int)
 type Config struct {
-	Language v1.Language
-	Includes []string
-	Excludes []string
+	Language     v1.Language
+	Includes     []string
+	Excludes     []string
+	CallResolver v1.CallResolver
+	// 增量分析，只重新分析变化的文件
+	Incremental bool
 }
 
 func NewProject(repo *v1.Repo, indexer *Indexer) *Project {
 	return &Project{config: &Config{
-		Language: repo.Language,
-		Excludes: repo.Excludes,
+		Language:     repo.Language,
+		Excludes:     repo.Excludes,
+		CallResolver: repo.CallResolver,
 	},
 		pkgs:        make(map[string]*Package),
 		relationMap: make(map[string]bool),
 		Repo:        repo,
 		indexer:     indexer,
+		frontend:    NewLanguageFrontend(repo.Language),
 	}
 }
 
+// SnapshotID 分析结果所在的快照，也是所有节点 ID 的前缀
+func (p *Project) SnapshotID() string {
+	return SnapshotID(p.Repo.Id, p.Commit)
+}
+
 func (p *Project) LanguagePrefix() string {
-	switch p.config.Language {
-	case v1.Language_Golang:
-		return ".go"
-	case v1.Language_Java:
-		return ".java"
+	if p.frontend == nil {
+		return ""
 	}
-	return ""
+	return p.frontend.Extension()
 }
 
 func (p *Project) Analyze(ctx context.Context, rootPath string, projectRepo ProjectRepo) error {
+	if p.frontend == nil {
+		return v1.ErrorParseCodeError("language %s not supported", p.config.Language)
+	}
+	if err := p.startPhase(ctx, v1.AnalysisPhase_PhaseParse, 0); err != nil {
+		return err
+	}
 	root, err := p.ParseCode(ctx, rootPath)
 	if err != nil {
 		return v1.ErrorParseCodeError("parseCode failure ").WithCause(err)
 	}
 	p.pkgs[root.ID] = root
+
+	if err = p.startPhase(ctx, v1.AnalysisPhase_PhaseClassify, 0); err != nil {
+		return err
+	}
 	root.ClassifyExtends(ctx)
 	root.ClassifyMethod(ctx)
 	p.Root = root
+	if err = p.DetectChanges(ctx, projectRepo); err != nil {
+		return err
+	}
+
+	if err = p.startPhase(ctx, v1.AnalysisPhase_PhaseRelations, len(p.GetAnalyzedFiles())); err != nil {
+		return err
+	}
+	p.loadTypeResolver(ctx, rootPath)
 	if err = p.AnalyzeRelations(ctx); err != nil {
 		return err
 	}
 	p.AnalyzeInterfaceImplRelations(ctx)
+	p.filterAnalyzedRelations()
+
+	if err = p.startPhase(ctx, v1.AnalysisPhase_PhasePersist, len(p.GetAnalyzedFiles())); err != nil {
+		return err
+	}
+	if err = projectRepo.SaveProject(ctx, p); err != nil {
+		return err
+	}
+
+	if err = p.startPhase(ctx, v1.AnalysisPhase_PhaseIndex, 0); err != nil {
+		return err
+	}
 	for _, pkg := range p.pkgs {
 		if pkg.Name == "vminformer" {
-			go p.indexer.Indexer(ctx, pkg, p.Repo)
+			if err = p.indexer.Indexer(ctx, pkg, p.Repo); err != nil {
+				log.Context(ctx).Warnf("index package %s failure:%v", pkg.ID, err)
+			}
 		}
 	}
-	return projectRepo.SaveProject(ctx, p)
+	return ctx.Err()
+}
+
+// SetProgress 设置分析进度回调
+func (p *Project) SetProgress(progress AnalyzeProgress) {
+	p.progress = progress
+}
 
+// startPhase 进入新的分析阶段，分析已取消时返回错误
+func (p *Project) startPhase(ctx context.Context, phase v1.AnalysisPhase, total int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	p.phase, p.processed, p.total = phase, 0, total
+	if p.progress != nil {
+		p.progress(phase, 0, total)
+	}
+	return nil
 }
+
+// fileProcessed 当前阶段处理完一个文件
+func (p *Project) fileProcessed() {
+	p.processed++
+	if p.progress != nil {
+		p.progress(p.phase, p.processed, p.total)
+	}
+}
+
+// loadTypeResolver 按配置加载类型检查解析器，加载失败时整体回退到启发式解析
+func (p *Project) loadTypeResolver(ctx context.Context, rootPath string) {
+	if p.config.CallResolver != v1.CallResolver_TypeChecked || p.config.Language != v1.Language_Golang {
+		return
+	}
+	resolver, err := NewTypeResolver(ctx, rootPath)
+	if err != nil {
+		log.Context(ctx).Warnf("type check %s failure, fallback to heuristic:%v", rootPath, err)
+		return
+	}
+	resolver.Bind(p)
+	p.typeResolver = resolver
+}
+
 func (p *Project) ParseCode(ctx context.Context, rootPath string) (*Package, error) {
 	pathPath, err := FindGoModPath(rootPath)
 	var module string
@@ -79,7 +176,7 @@ func (p *Project) ParseCode(ctx context.Context, rootPath string) (*Package, err
 		}
 		p.module = module
 	}
-	root := NewPackage(p.shouldInclude, p, p.Repo.Id, filepath.Base(rootPath))
+	root := NewPackage(p.shouldInclude, p, p.SnapshotID(), filepath.Base(rootPath))
 	p.RootPath = filepath.Base(rootPath)
 	err = root.Parse(ctx, rootPath)
 	if err != nil {
@@ -115,7 +212,7 @@ func (p *Project) GetEntity(pkgName, key string) *Entity {
 
 	}
 	if len(p.RootPath) > 0 {
-		pkg, ok = p.pkgs[fmt.Sprintf("%s@%s@%s", p.Repo.Id, p.RootPath, pkgName)]
+		pkg, ok = p.pkgs[fmt.Sprintf("%s@%s@%s", p.SnapshotID(), p.RootPath, pkgName)]
 	}
 	if ok {
 		return pkg.GetEntity(key)
@@ -137,7 +234,7 @@ func (p *Project) GetPackageByName(pkgName string) *Package {
 		return pkg
 	}
 	if len(p.RootPath) > 0 {
-		pkg, ok = p.pkgs[fmt.Sprintf("%s@%s@%s", p.Repo.Id, p.RootPath, pkgName)]
+		pkg, ok = p.pkgs[fmt.Sprintf("%s@%s@%s", p.SnapshotID(), p.RootPath, pkgName)]
 	}
 	return pkg
 }
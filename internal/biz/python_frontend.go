@@ -0,0 +1,679 @@
+package biz
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// pythonFrontend 纯 Go 实现的 Python 语言前端
+// 目录映射为 Package，模块文件映射为 File，类映射为 Struct，基类记录在 Extends 中，
+// 模块级变量映射为 Variable/Constant 实体。模块是 Python 的命名空间，函数和方法的 ID 以文件 ID 为前缀。
+type pythonFrontend struct {
+	modules   map[*File]*pyModule
+	classes   map[*Entity]*pyClass
+	variables map[*Entity]*pyVariable
+	functions map[*Function]*pyFunction
+	paths     map[string]*File    // 模块路径 -> 文件
+	packages  map[string]*Package // 包路径 -> 目录
+	resolved  map[string]string   // 导入的模块路径 -> 项目中的模块路径
+}
+
+func newPythonFrontend() *pythonFrontend {
+	return &pythonFrontend{
+		modules:   make(map[*File]*pyModule),
+		classes:   make(map[*Entity]*pyClass),
+		variables: make(map[*Entity]*pyVariable),
+		functions: make(map[*Function]*pyFunction),
+		paths:     make(map[string]*File),
+		packages:  make(map[string]*Package),
+		resolved:  make(map[string]string),
+	}
+}
+
+func (fe *pythonFrontend) Extension() string {
+	return ".py"
+}
+
+// IsTestFile pytest 和 unittest 默认收集的 test_*.py、*_test.py，以及 conftest.py
+func (fe *pythonFrontend) IsTestFile(p string) bool {
+	name := strings.TrimSuffix(path.Base(p), fe.Extension())
+	return strings.HasPrefix(name, "test_") || strings.HasSuffix(name, "_test") || name == "conftest"
+}
+
+// IsTestFunction 测试文件中名称以 test 开头的函数和方法
+func (fe *pythonFrontend) IsTestFunction(fun *Function) bool {
+	return strings.HasPrefix(fun.Name, "test")
+}
+
+// packagePath 目录对应的包路径，根目录为空
+func (fe *pythonFrontend) packagePath(pkg *Package) string {
+	project := pkg.GetProject()
+	rootID := geneID(project.SnapshotID(), project.RootPath)
+	return strings.ReplaceAll(strings.TrimPrefix(strings.TrimPrefix(pkg.ID, rootID), "@"), "@", ".")
+}
+
+// modulePath 文件对应的模块路径，__init__.py 为所在包的路径
+func (fe *pythonFrontend) modulePath(file *File) string {
+	pkgPath := fe.packagePath(file.pkg)
+	name := strings.TrimSuffix(file.Name, fe.Extension())
+	if name == "__init__" {
+		return pkgPath
+	}
+	if len(pkgPath) == 0 {
+		return name
+	}
+	return pkgPath + "." + name
+}
+
+func (fe *pythonFrontend) ParseFile(file *File) error {
+	content, err := file.ReadFileContent()
+	if err != nil {
+		return err
+	}
+	module := parsePython(content)
+	fe.modules[file] = module
+	fe.paths[fe.modulePath(file)] = file
+	fe.packages[fe.packagePath(file.pkg)] = file.pkg
+	for _, imp := range module.imports {
+		path := imp.module
+		if imp.from {
+			path = pyJoin(imp.module, imp.name)
+		}
+		file.importManager.Add(&Import{Name: imp.alias, Path: path, FileId: file.ID, Span: module.lines.span(imp.start, imp.end)})
+	}
+	for _, c := range module.classes {
+		fe.addClass(file, c)
+	}
+	for _, f := range module.functions {
+		if fun := fe.newFunction(file, f, nil); file.GetEntity(fun.Name) == nil {
+			file.functionManager.AddFunction(fun)
+			fe.functions[fun] = f
+		}
+	}
+	for _, v := range module.variables {
+		if file.GetEntity(v.name) != nil || file.GetFunctionByName(v.name) != nil {
+			continue
+		}
+		entityType := Variable
+		if strings.ToUpper(v.name) == v.name && strings.IndexFunc(v.name, unicode.IsLetter) >= 0 {
+			entityType = Constant
+		}
+		entity := &Entity{
+			ID:       fmt.Sprintf("%s:%s", file.ID, v.name),
+			Type:     entityType,
+			Name:     v.name,
+			FileID:   file.ID,
+			PkgID:    file.PkgID,
+			Exported: pyExported(v.name),
+			Span:     module.lines.span(v.start, v.end),
+		}
+		fe.variables[entity] = v
+		file.AddEntity(entity)
+	}
+	return nil
+}
+
+// span 文件中起止偏移对应的位置
+func (fe *pythonFrontend) span(file *File, start, end int) Span {
+	module := fe.modules[file]
+	if module == nil {
+		return Span{}
+	}
+	return module.lines.span(start, end)
+}
+
+func (fe *pythonFrontend) addClass(file *File, c *pyClass) {
+	entity := &Entity{
+		ID:              fmt.Sprintf("%s:%s", file.ID, c.name),
+		Type:            Struct,
+		Name:            c.name,
+		FileID:          file.ID,
+		PkgID:           file.PkgID,
+		Document:        c.doc,
+		Exported:        c.exported(),
+		Span:            fe.span(file, c.start, c.end),
+		functionManager: NewFunctionManager(file),
+		fieldManager:    NewFieldManager(),
+	}
+	c.entity = entity
+	fe.classes[entity] = c
+	for _, v := range c.fields {
+		typ := v.typ
+		if len(typ) == 0 {
+			typ = pyInferType(v.value)
+		}
+		entity.AddField(&Field{
+			Name:     v.name,
+			StructID: entity.ID,
+			Scope:    StructScope,
+			ObjType:  typ,
+			Span:     fe.span(file, v.start, v.end),
+			file:     file,
+		})
+	}
+	for _, m := range c.methods {
+		if entity.FindMethodByName(m.name) != nil {
+			// 同名方法（如 property setter）只保留第一个
+			continue
+		}
+		fun := fe.newFunction(file, m, c)
+		entity.AddMethod(fun)
+		fe.functions[fun] = m
+	}
+	file.AddEntity(entity)
+}
+
+func (fe *pythonFrontend) newFunction(file *File, f *pyFunction, c *pyClass) *Function {
+	fun := &Function{
+		EntId:    file.ID,
+		Name:     f.name,
+		Document: f.doc,
+		Scope:    FunctionScope,
+		PkgID:    file.PkgID,
+		FileId:   file.ID,
+		file:     file,
+		syntax:   f,
+		ID:       fmt.Sprintf("%s:%s", file.ID, f.name),
+		Exported: pyExported(f.name),
+		Span:     fe.span(file, f.start, f.end),
+	}
+	params := f.params
+	if c != nil {
+		fun.Receiver = c.name
+		fun.ID = fmt.Sprintf("%s:%s.%s", file.ID, c.name, f.name)
+		fun.Exported = fun.Exported && c.exported()
+		if !f.isStatic() && len(params) > 0 {
+			// self/cls
+			params = params[1:]
+		}
+	}
+	for _, param := range params {
+		fun.Params = append(fun.Params, &Field{Name: param.name, ObjType: param.typ, Scope: fun.Scope, file: file})
+	}
+	if len(f.result) > 0 && f.result != "None" {
+		fun.Results = append(fun.Results, &Field{ObjType: f.result, Scope: fun.Scope, file: file})
+	}
+	return fun
+}
+
+func (fe *pythonFrontend) ResolveExtends(file *File, pkg *Package) {
+	for _, entity := range file.GetEntities() {
+		c := fe.classes[entity]
+		if c == nil {
+			continue
+		}
+		for _, base := range c.bases {
+			extend := fe.typeValue(file, base).class
+			if extend == nil || extend == entity {
+				continue
+			}
+			entity.Extends = append(entity.Extends, extend)
+		}
+	}
+}
+
+// IsImplInterface Python 没有接口类型
+func (fe *pythonFrontend) IsImplInterface(entity, interfaceEntity *Entity) bool {
+	return false
+}
+
+func (fe *pythonFrontend) ResolveFieldEntity(ra *RelationAnalyzer, field *Field) *Entity {
+	file := field.file
+	if file == nil {
+		file = ra.file
+	}
+	return fe.typeValue(file, field.ObjType).class
+}
+
+func (fe *pythonFrontend) AnalyzeImports(file *File) []*Relation {
+	module := fe.modules[file]
+	if module == nil {
+		return nil
+	}
+	var relations []*Relation
+	for _, imp := range module.imports {
+		path := fe.absolutePath(file, imp.module)
+		var pkg *Package
+		if imp.from && imp.name != "*" {
+			pkg = fe.findPackage(pyJoin(path, imp.name))
+		}
+		if pkg == nil {
+			pkg = fe.findPackage(path)
+		}
+		if pkg == nil || pkg == file.pkg {
+			continue
+		}
+		relations = append(relations, &Relation{
+			Type:       Imports,
+			TargetID:   pkg.ID,
+			Confidence: 1,
+			SourceID:   file.ID,
+		})
+	}
+	return relations
+}
+
+func (fe *pythonFrontend) AnalyzeCalls(ra *RelationAnalyzer, fun *Function) []*Relation {
+	f := fe.functions[fun]
+	if f == nil || !f.HasBody() {
+		return nil
+	}
+	return callRelations(fun, newPyCallAnalyzer(fe, fun.file, f).calls(), ConfidenceHeuristic)
+}
+
+// pyJoin 拼接模块路径，处理 from . import x
+func pyJoin(module, name string) string {
+	if len(module) == 0 || strings.HasSuffix(module, ".") {
+		return module + name
+	}
+	return module + "." + name
+}
+
+// absolutePath 把相对导入转换为项目内的模块路径
+func (fe *pythonFrontend) absolutePath(file *File, module string) string {
+	rest := strings.TrimLeft(module, ".")
+	level := len(module) - len(rest)
+	if level == 0 {
+		return module
+	}
+	base := fe.packagePath(file.pkg)
+	for i := 1; i < level; i++ {
+		if index := strings.LastIndex(base, "."); index >= 0 {
+			base = base[:index]
+		} else {
+			base = ""
+		}
+	}
+	if len(base) == 0 {
+		return rest
+	}
+	if len(rest) == 0 {
+		return base
+	}
+	return base + "." + rest
+}
+
+// resolvePath 查找项目中的模块或包路径，导入路径不含源码根目录时按后缀匹配最短的路径
+func (fe *pythonFrontend) resolvePath(path string) string {
+	if len(path) == 0 {
+		return ""
+	}
+	if resolved, ok := fe.resolved[path]; ok {
+		return resolved
+	}
+	var candidates []string
+	if _, ok := fe.paths[path]; ok {
+		candidates = append(candidates, path)
+	} else if _, ok := fe.packages[path]; ok {
+		candidates = append(candidates, path)
+	} else {
+		for p := range fe.paths {
+			if strings.HasSuffix(p, "."+path) {
+				candidates = append(candidates, p)
+			}
+		}
+		for p := range fe.packages {
+			if strings.HasSuffix(p, "."+path) {
+				candidates = append(candidates, p)
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if len(candidates[i]) != len(candidates[j]) {
+			return len(candidates[i]) < len(candidates[j])
+		}
+		return candidates[i] < candidates[j]
+	})
+	resolved := ""
+	if len(candidates) > 0 {
+		resolved = candidates[0]
+	}
+	fe.resolved[path] = resolved
+	return resolved
+}
+
+func (fe *pythonFrontend) findModule(path string) *File {
+	return fe.paths[fe.resolvePath(path)]
+}
+
+func (fe *pythonFrontend) findPackage(path string) *Package {
+	resolved := fe.resolvePath(path)
+	if file, ok := fe.paths[resolved]; ok {
+		return file.pkg
+	}
+	return fe.packages[resolved]
+}
+
+// pyValue 表达式的值：模块、类（或其实例）、函数
+type pyValue struct {
+	module   string
+	class    *Entity
+	function *Function
+}
+
+func (v pyValue) empty() bool {
+	return len(v.module) == 0 && v.class == nil && v.function == nil
+}
+
+const pyMaxResolveDepth = 8
+
+// resolveName 在模块作用域内解析名称，依次查找 类/变量、函数、导入
+func (fe *pythonFrontend) resolveName(file *File, name string, depth int) pyValue {
+	if depth > pyMaxResolveDepth {
+		return pyValue{}
+	}
+	if entity := file.GetEntity(name); entity != nil {
+		if fe.classes[entity] != nil {
+			return pyValue{class: entity}
+		}
+		if v := fe.variables[entity]; v != nil {
+			if len(v.typ) > 0 {
+				return fe.typeValueDepth(file, v.typ, depth+1)
+			}
+			if typ := pyInferType(v.value); len(typ) > 0 {
+				return fe.callResult(fe.typeValueDepth(file, typ, depth+1), depth+1)
+			}
+		}
+		return pyValue{}
+	}
+	if fun := file.GetFunctionByName(name); fun != nil {
+		return pyValue{function: fun}
+	}
+	module := fe.modules[file]
+	if module == nil {
+		return pyValue{}
+	}
+	// 后出现的导入覆盖之前的同名导入
+	for i := len(module.imports) - 1; i >= 0; i-- {
+		imp := module.imports[i]
+		path := fe.absolutePath(file, imp.module)
+		switch {
+		case imp.from && imp.name == "*":
+			if f := fe.findModule(path); f != nil && f != file {
+				if v := fe.resolveName(f, name, depth+1); !v.empty() {
+					return v
+				}
+			}
+		case imp.ref() != name:
+		case imp.from:
+			return fe.attr(pyValue{module: path}, imp.name, depth+1)
+		case len(imp.alias) > 0:
+			return pyValue{module: path}
+		default:
+			return pyValue{module: name}
+		}
+	}
+	return pyValue{}
+}
+
+// attr 解析属性访问 value.name
+func (fe *pythonFrontend) attr(value pyValue, name string, depth int) pyValue {
+	if depth > pyMaxResolveDepth {
+		return pyValue{}
+	}
+	switch {
+	case len(value.module) > 0:
+		if f := fe.findModule(value.module); f != nil {
+			if v := fe.resolveName(f, name, depth+1); !v.empty() {
+				return v
+			}
+		}
+		sub := pyJoin(value.module, name)
+		if len(fe.resolvePath(sub)) > 0 {
+			return pyValue{module: sub}
+		}
+	case value.class != nil:
+		if fun := value.class.ResolveMethod(name); fun != nil {
+			return pyValue{function: fun}
+		}
+		if field := fe.findField(value.class, name, make(map[*Entity]bool)); field != nil {
+			return fe.typeValueDepth(field.file, field.ObjType, depth+1)
+		}
+		for entity, c := range fe.classes {
+			if c.outer != nil && c.outer.entity == value.class && c.simpleName == name {
+				return pyValue{class: entity}
+			}
+		}
+	}
+	return pyValue{}
+}
+
+// callResult 调用的结果：类调用得到实例，函数调用得到返回值类型
+func (fe *pythonFrontend) callResult(callee pyValue, depth int) pyValue {
+	switch {
+	case callee.class != nil:
+		return pyValue{class: callee.class}
+	case callee.function != nil && len(callee.function.Results) > 0:
+		result := callee.function.Results[0]
+		return fe.typeValueDepth(result.file, result.ObjType, depth+1)
+	}
+	return pyValue{}
+}
+
+// findField 查找字段，自身没有时从基类中查找
+func (fe *pythonFrontend) findField(entity *Entity, name string, visited map[*Entity]bool) *Field {
+	if entity == nil || visited[entity] {
+		return nil
+	}
+	visited[entity] = true
+	if field := entity.FindFieldByName(name); field != nil {
+		return field
+	}
+	for _, extend := range entity.Extends {
+		if field := fe.findField(extend, name, visited); field != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+var pyTypeNamePattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*`)
+
+func (fe *pythonFrontend) typeValue(file *File, typ string) pyValue {
+	return fe.typeValueDepth(file, typ, 0)
+}
+
+// typeValueDepth 解析类型注解，例如 Optional[List["models.User"]] 依次尝试 Optional、List、models.User
+func (fe *pythonFrontend) typeValueDepth(file *File, typ string, depth int) pyValue {
+	if file == nil || len(typ) == 0 || depth > pyMaxResolveDepth {
+		return pyValue{}
+	}
+	for _, name := range pyTypeNamePattern.FindAllString(typ, -1) {
+		parts := strings.Split(name, ".")
+		value := fe.resolveName(file, parts[0], depth+1)
+		for _, part := range parts[1:] {
+			if value.empty() {
+				break
+			}
+			value = fe.attr(value, part, depth+1)
+		}
+		if value.class != nil {
+			return pyValue{class: value.class}
+		}
+	}
+	return pyValue{}
+}
+
+// pyCallAnalyzer 基于函数体词法单元的 Python 调用分析
+type pyCallAnalyzer struct {
+	fe          *pythonFrontend
+	file        *File
+	fun         *pyFunction
+	owner       *Entity
+	self        string
+	tokens      []pyToken
+	locals      map[string]int    // 局部变量 -> 赋值表达式最后一个词法单元
+	annotations map[string]string // 局部变量/参数 -> 类型注解
+	evaluating  map[string]bool
+}
+
+func newPyCallAnalyzer(fe *pythonFrontend, file *File, f *pyFunction) *pyCallAnalyzer {
+	a := &pyCallAnalyzer{
+		fe:          fe,
+		file:        file,
+		fun:         f,
+		tokens:      f.body,
+		locals:      make(map[string]int),
+		annotations: make(map[string]string),
+		evaluating:  make(map[string]bool),
+	}
+	params := f.params
+	if f.owner != nil {
+		a.owner = f.owner.entity
+		if !f.isStatic() && len(params) > 0 {
+			a.self = params[0].name
+			params = params[1:]
+		}
+	}
+	for _, param := range params {
+		if len(param.typ) > 0 {
+			a.annotations[param.name] = param.typ
+		}
+	}
+	a.collectLocals()
+	return a
+}
+
+func (a *pyCallAnalyzer) token(index int) pyToken {
+	if index < 0 || index >= len(a.tokens) {
+		return pyToken{kind: pyEOF}
+	}
+	return a.tokens[index]
+}
+
+// statementEnd 返回从 index 开始的语句最后一个词法单元
+func (a *pyCallAnalyzer) statementEnd(index int) int {
+	for i := index; i < len(a.tokens); i++ {
+		if kind := a.tokens[i].kind; kind == pyNewline || kind == pyIndent || kind == pyDedent {
+			return i - 1
+		}
+	}
+	return len(a.tokens) - 1
+}
+
+// collectLocals 收集 name = expr、name: T = expr 和 with expr as name
+func (a *pyCallAnalyzer) collectLocals() {
+	for i, t := range a.tokens {
+		if t.kind != pyName || pyKeywords[t.text] {
+			continue
+		}
+		prev := a.token(i - 1)
+		atStart := i == 0 || prev.kind == pyNewline || prev.kind == pyIndent || prev.kind == pyDedent
+		switch {
+		case atStart && a.token(i+1).is("=") && !a.token(i+2).is("="):
+			if _, ok := a.locals[t.text]; !ok {
+				a.locals[t.text] = a.statementEnd(i)
+			}
+		case atStart && a.token(i+1).is(":"):
+			end := a.statementEnd(i)
+			typEnd := end + 1
+			for j := i + 2; j <= end; j++ {
+				if a.tokens[j].is("=") {
+					typEnd = j
+					break
+				}
+			}
+			if typEnd > i+2 {
+				a.annotations[t.text] = pyExprText(a.tokens[i+2 : typEnd])
+			}
+		case prev.is("as") && a.token(i-2).is(")"):
+			if _, ok := a.locals[t.text]; !ok {
+				a.locals[t.text] = i - 2
+			}
+		}
+	}
+}
+
+// calls 返回函数体内能解析到的调用，调用类时指向其 __init__，调用位置从名称到右括号
+func (a *pyCallAnalyzer) calls() []funcCall {
+	var calls []funcCall
+	for i, t := range a.tokens {
+		if t.kind != pyName || pyKeywords[t.text] || !a.token(i+1).is("(") {
+			continue
+		}
+		var callee *Function
+		value := a.eval(i)
+		switch {
+		case value.function != nil:
+			callee = value.function
+		case value.class != nil:
+			callee = value.class.ResolveMethod("__init__")
+		}
+		if callee == nil {
+			continue
+		}
+		end := t.end
+		if closing := pyCloseIndex(a.tokens, i+1); closing > 0 {
+			end = a.tokens[closing].end
+		}
+		calls = append(calls, funcCall{callee: callee, site: a.fe.span(a.file, t.offset, end)})
+	}
+	return calls
+}
+
+// eval 推断以 index 结尾的表达式的值
+func (a *pyCallAnalyzer) eval(index int) pyValue {
+	t := a.token(index)
+	switch {
+	case t.kind == pyName && a.token(index-1).is("."):
+		receiver := a.eval(index - 2)
+		if receiver.empty() {
+			return pyValue{}
+		}
+		return a.fe.attr(receiver, t.text, 0)
+	case t.kind == pyName:
+		if len(a.self) > 0 && t.text == a.self {
+			return pyValue{class: a.owner}
+		}
+		if typ, ok := a.annotations[t.text]; ok {
+			return a.fe.typeValue(a.file, typ)
+		}
+		if end, ok := a.locals[t.text]; ok {
+			if a.evaluating[t.text] || end >= index && end < index+1 {
+				return pyValue{}
+			}
+			a.evaluating[t.text] = true
+			defer delete(a.evaluating, t.text)
+			return a.eval(end)
+		}
+		return a.fe.resolveName(a.file, t.text, 0)
+	case t.is(")"):
+		open := a.matchOpen(index)
+		before := a.token(open - 1)
+		if open < 0 || before.kind != pyName || pyKeywords[before.text] {
+			return pyValue{}
+		}
+		if before.text == "super" && !a.token(open-2).is(".") {
+			if a.owner != nil && len(a.owner.Extends) > 0 {
+				return pyValue{class: a.owner.Extends[0]}
+			}
+			return pyValue{}
+		}
+		return a.fe.callResult(a.eval(open-1), 0)
+	}
+	return pyValue{}
+}
+
+// matchOpen 查找与 index 处右括号匹配的左括号
+func (a *pyCallAnalyzer) matchOpen(index int) int {
+	depth := 0
+	for i := index; i >= 0; i-- {
+		switch {
+		case a.tokens[i].is(")") || a.tokens[i].is("]") || a.tokens[i].is("}"):
+			depth++
+		case a.tokens[i].is("(") || a.tokens[i].is("[") || a.tokens[i].is("{"):
+			depth--
+			if depth == 0 {
+				if a.tokens[i].is("(") {
+					return i
+				}
+				return -1
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"testing"
+)
+
+func TestPythonFrontend(t *testing.T) {
+	project := analyzeSources(t, v1.Language_Python, map[string]string{
+		"app/__init__.py": "",
+		"app/models/__init__.py": `from .user import User
+`,
+		"app/models/user.py": `"""用户模型"""
+
+DEFAULT_NAME = "guest"
+
+
+class Base:
+    def save(self) -> None:
+        pass
+
+
+class User(Base):
+    """用户"""
+
+    name: str = DEFAULT_NAME
+
+    def __init__(self, name: str):
+        self.name = name
+        self.profile = Profile()
+
+    def normalize(self) -> "User":
+        return self
+
+    class Meta:
+        table = "user"
+
+
+class Profile:
+    def render(self):
+        return ""
+`,
+		"app/service.py": `import logging
+from app.models import User
+from .models import user as user_module
+
+
+def load(name) -> User:
+    return User(name)
+
+
+class UserService:
+    def __init__(self, repo=None):
+        self.repo = repo
+
+    def create(self, name: str) -> User:
+        user = load(name).normalize()
+        user.save()
+        user.profile.render()
+        other = user_module.User(name)
+        with open(name) as f:
+            logging.info(f.read())
+        return other
+`,
+	})
+
+	modelsPkg := project.Root.ID + "@app@models"
+	appPkg := project.Root.ID + "@app"
+	userFile := modelsPkg + "@user.py"
+	serviceFile := appPkg + "@service.py"
+	user := userFile + ":User"
+
+	entity := project.GetEntity(modelsPkg, "User")
+	if entity == nil || entity.Type != Struct || entity.Document != "用户" {
+		t.Fatalf("User entity = %+v", entity)
+	}
+	if project.GetEntity(modelsPkg, "User.Meta") == nil {
+		t.Errorf("nested class User.Meta not found")
+	}
+	if c := project.GetEntity(modelsPkg, "DEFAULT_NAME"); c == nil || c.Type != Constant {
+		t.Errorf("DEFAULT_NAME entity = %+v", c)
+	}
+	if fun := entity.FindMethodByName("__init__"); fun == nil || len(fun.Params) != 1 {
+		t.Errorf("User.__init__ = %+v", fun)
+	}
+	if fun := entity.FindMethodByName("__init__"); fun == nil || !fun.Exported || !entity.Exported {
+		t.Errorf("User.__init__ should be exported")
+	}
+
+	for _, want := range []struct {
+		relationType, source, target string
+	}{
+		{Extends, user, userFile + ":Base"},
+		{HasFields, user, userFile + ":Profile"},
+		{Imports, serviceFile, modelsPkg},
+		{Call, serviceFile + ":load", userFile + ":User.__init__"},
+		{Call, serviceFile + ":UserService.create", serviceFile + ":load"},
+		{Call, serviceFile + ":UserService.create", userFile + ":User.normalize"},
+		{Call, serviceFile + ":UserService.create", userFile + ":Base.save"},
+		{Call, serviceFile + ":UserService.create", userFile + ":Profile.render"},
+		{Call, serviceFile + ":UserService.create", userFile + ":User.__init__"},
+	} {
+		if !hasRelation(project, want.relationType, want.source, want.target) {
+			t.Errorf("missing %s %s -> %s", want.relationType, want.source, want.target)
+		}
+	}
+}
+
+func TestLexPythonIndent(t *testing.T) {
+	// 括号内换行后的缩进不参与计算
+	tokens := lexPython([]byte("class A:\n    def f(a,\nb):\n        '''doc\n        '''\n        return a  # c\n"))
+	var indents, dedents int
+	for _, tk := range tokens {
+		switch tk.kind {
+		case pyIndent:
+			indents++
+		case pyDedent:
+			dedents++
+		}
+	}
+	if indents != 2 || dedents != 2 {
+		t.Fatalf("indent = %d, dedent = %d", indents, dedents)
+	}
+	for _, tk := range tokens {
+		if tk.text == "return" && (tk.line != 6 || tk.column != 9) {
+			t.Errorf("return token = %+v", tk)
+		}
+	}
+
+	// 文件以反斜杠加 \r 结尾
+	src := []byte("x = 1 + \\\r")
+	if tokens = lexPython(src); tokens[len(tokens)-1].kind != pyEOF {
+		t.Errorf("tokens = %+v", tokens)
+	}
+	parsePython(src)
+}
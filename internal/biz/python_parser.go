@@ -0,0 +1,911 @@
+package biz
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type pyTokenKind int
+
+const (
+	pyEOF pyTokenKind = iota
+	pyName
+	pyNumber
+	pyString
+	pyOp
+	pyNewline
+	pyIndent
+	pyDedent
+)
+
+// pyToken Python 词法单元，关键字也按名称处理
+type pyToken struct {
+	kind   pyTokenKind
+	text   string
+	offset int
+	end    int
+	line   int
+	column int
+}
+
+func (t pyToken) is(text string) bool {
+	return (t.kind == pyName || t.kind == pyOp) && t.text == text
+}
+
+// pyKeywords 不能作为变量名/函数名的关键字
+var pyKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// lexPython 词法分析，生成 NEWLINE/INDENT/DEDENT 并记录行列号
+func lexPython(src []byte) []pyToken {
+	var tokens []pyToken
+	indents := []int{0}
+	depth := 0
+	line, column := 1, 1
+	lineStart := true
+	advance := func(from, to int) {
+		for i := from; i < to; i++ {
+			if src[i] == '\n' {
+				line++
+				column = 1
+			} else if src[i]&0xC0 != 0x80 {
+				column++
+			}
+		}
+	}
+	emit := func(kind pyTokenKind, start, end int) {
+		tokens = append(tokens, pyToken{kind: kind, text: string(src[start:end]), offset: start, end: end, line: line, column: column})
+	}
+	newline := func(offset int) {
+		if len(tokens) > 0 && tokens[len(tokens)-1].kind != pyNewline && tokens[len(tokens)-1].kind != pyIndent && tokens[len(tokens)-1].kind != pyDedent {
+			tokens = append(tokens, pyToken{kind: pyNewline, offset: offset, end: offset, line: line, column: column})
+		}
+	}
+	for i := 0; i < len(src); {
+		if lineStart && depth == 0 {
+			// 计算缩进，空行和注释行不影响缩进
+			width, j := 0, i
+			for j < len(src) && (src[j] == ' ' || src[j] == '\t' || src[j] == '\f') {
+				if src[j] == '\t' {
+					width = (width/8 + 1) * 8
+				} else if src[j] == ' ' {
+					width++
+				}
+				j++
+			}
+			advance(i, j)
+			i = j
+			if i >= len(src) {
+				break
+			}
+			if src[i] == '\n' || src[i] == '\r' || src[i] == '#' {
+				lineStart = false
+			} else {
+				lineStart = false
+				if width > indents[len(indents)-1] {
+					indents = append(indents, width)
+					tokens = append(tokens, pyToken{kind: pyIndent, offset: i, end: i, line: line, column: column})
+				}
+				for width < indents[len(indents)-1] {
+					indents = indents[:len(indents)-1]
+					tokens = append(tokens, pyToken{kind: pyDedent, offset: i, end: i, line: line, column: column})
+				}
+				continue
+			}
+		}
+		c := src[i]
+		start := i
+		switch {
+		case c == '\n':
+			if depth == 0 {
+				newline(i)
+			}
+			i++
+			advance(start, i)
+			// 括号内换行不开始新的逻辑行
+			lineStart = depth == 0
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+		case c == '\\' && i+1 < len(src) && (src[i+1] == '\n' || src[i+1] == '\r'):
+			// 续行
+			i++
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			// 文件以续行结尾时没有换行符
+			i = min(i+1, len(src))
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case pyStringStart(src[i:]) > 0:
+			i += pyStringStart(src[i:]) - 1
+			quote := src[i]
+			triple := i+2 < len(src) && src[i+1] == quote && src[i+2] == quote
+			if triple {
+				i += 3
+				for i < len(src) && !(src[i] == quote && i+2 < len(src) && src[i+1] == quote && src[i+2] == quote) {
+					if src[i] == '\\' {
+						i++
+					}
+					i++
+				}
+				i = min(i+3, len(src))
+			} else {
+				i++
+				for i < len(src) && src[i] != quote && src[i] != '\n' {
+					if src[i] == '\\' {
+						i++
+					}
+					i++
+				}
+				i = min(i+1, len(src))
+			}
+			emit(pyString, start, i)
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			i++
+			for i < len(src) {
+				d := src[i]
+				if (d == '+' || d == '-') && (src[i-1] == 'e' || src[i-1] == 'E') {
+					i++
+					continue
+				}
+				if d == '.' || d == '_' || d >= '0' && d <= '9' || d >= 'a' && d <= 'z' || d >= 'A' && d <= 'Z' {
+					i++
+					continue
+				}
+				break
+			}
+			emit(pyNumber, start, i)
+		default:
+			r, size := utf8.DecodeRune(src[i:])
+			if r == '_' || unicode.IsLetter(r) {
+				i += size
+				for i < len(src) {
+					r, size = utf8.DecodeRune(src[i:])
+					if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+						break
+					}
+					i += size
+				}
+				emit(pyName, start, i)
+			} else {
+				switch c {
+				case '(', '[', '{':
+					depth++
+				case ')', ']', '}':
+					if depth > 0 {
+						depth--
+					}
+				}
+				i += size
+				emit(pyOp, start, i)
+			}
+		}
+		advance(start, i)
+	}
+	newline(len(src))
+	for len(indents) > 1 {
+		indents = indents[:len(indents)-1]
+		tokens = append(tokens, pyToken{kind: pyDedent, offset: len(src), end: len(src), line: line, column: column})
+	}
+	tokens = append(tokens, pyToken{kind: pyEOF, offset: len(src), end: len(src), line: line, column: column})
+	return tokens
+}
+
+// pyStringStart 字符串字面量（含 r/b/f/u 前缀）开头的长度，包含第一个引号，不是字符串返回 0
+func pyStringStart(src []byte) int {
+	for i := 0; i < len(src) && i < 3; i++ {
+		switch src[i] {
+		case '"', '\'':
+			return i + 1
+		case 'r', 'R', 'b', 'B', 'f', 'F', 'u', 'U':
+			continue
+		default:
+			return 0
+		}
+	}
+	return 0
+}
+
+// pyStringValue 去掉字符串前缀和引号
+func pyStringValue(text string) string {
+	text = strings.TrimLeft(text, "rRbBfFuU")
+	for _, quote := range []string{`"""`, `'''`, `"`, `'`} {
+		if strings.HasPrefix(text, quote) {
+			text = strings.TrimSuffix(strings.TrimPrefix(text, quote), quote)
+			break
+		}
+	}
+	var lines []string
+	for _, l := range strings.Split(text, "\n") {
+		lines = append(lines, strings.TrimSpace(l))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+type pyImport struct {
+	module string // 模块路径，相对导入以 . 开头
+	name   string // from 导入的名称，* 表示全部
+	alias  string
+	from   bool
+	// start, end 整条导入语句的偏移
+	start, end int
+}
+
+// ref 导入后在模块内可以使用的名称
+func (imp *pyImport) ref() string {
+	switch {
+	case len(imp.alias) > 0:
+		return imp.alias
+	case imp.from:
+		return imp.name
+	default:
+		first, _, _ := strings.Cut(imp.module, ".")
+		return first
+	}
+}
+
+type pyModule struct {
+	doc       string
+	imports   []*pyImport
+	classes   []*pyClass
+	functions []*pyFunction
+	variables []*pyVariable
+	lines     lineIndex
+}
+
+type pyClass struct {
+	name       string // 嵌套类使用 Outer.Inner
+	simpleName string
+	doc        string
+	bases      []string
+	fields     []*pyVariable
+	methods    []*pyFunction
+	outer      *pyClass
+	pos        pyToken
+	start, end int // 从 class 到类体最后一个词法单元的偏移
+	entity     *Entity
+}
+
+// exported 类及其外部类都不是私有名称
+func (c *pyClass) exported() bool {
+	return pyExported(c.simpleName) && (c.outer == nil || c.outer.exported())
+}
+
+// pyExported 按命名约定判断是否公开，单下划线开头为私有，__init__ 等特殊方法公开
+func pyExported(name string) bool {
+	return !strings.HasPrefix(name, "_") || (strings.HasPrefix(name, "__") && strings.HasSuffix(name, "__"))
+}
+
+type pyVariable struct {
+	name       string
+	typ        string // 类型注解
+	value      []pyToken
+	pos        pyToken
+	start, end int // 赋值语句的偏移
+}
+
+type pyParam struct {
+	name string
+	typ  string
+}
+
+// pyFunction 函数或方法，body 为函数体词法单元
+type pyFunction struct {
+	name       string
+	doc        string
+	result     string
+	params     []*pyParam
+	decorators []string
+	body       []pyToken
+	pos        pyToken
+	start, end int
+	owner      *pyClass
+}
+
+func (f *pyFunction) Span() (int, int) {
+	return f.start, f.end
+}
+
+func (f *pyFunction) HasBody() bool {
+	return len(f.body) > 0
+}
+
+// isStatic 静态方法没有 self/cls 参数
+func (f *pyFunction) isStatic() bool {
+	for _, decorator := range f.decorators {
+		if decorator == "staticmethod" {
+			return true
+		}
+	}
+	return false
+}
+
+// pyParser 只解析模块、类和函数声明，函数体保留为词法单元供调用分析使用
+type pyParser struct {
+	tokens []pyToken
+	pos    int
+	module *pyModule
+}
+
+func parsePython(src []byte) *pyModule {
+	p := &pyParser{tokens: lexPython(src), module: &pyModule{lines: newLineIndex(src)}}
+	p.module.doc = p.parseDocString()
+	p.parseBlock(nil, false)
+	return p.module
+}
+
+func (p *pyParser) peek(n int) pyToken {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *pyParser) cur() pyToken {
+	return p.peek(0)
+}
+
+func (p *pyParser) next() pyToken {
+	t := p.cur()
+	if p.pos < len(p.tokens)-1 {
+		p.pos++
+	}
+	return t
+}
+
+func (p *pyParser) eof() bool {
+	return p.cur().kind == pyEOF
+}
+
+func (p *pyParser) accept(text string) bool {
+	if p.cur().is(text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// parseDocString 块的第一条语句是字符串时作为文档
+func (p *pyParser) parseDocString() string {
+	if p.cur().kind == pyString && (p.peek(1).kind == pyNewline || p.peek(1).kind == pyEOF) {
+		doc := pyStringValue(p.next().text)
+		p.next()
+		return doc
+	}
+	return ""
+}
+
+// parseBlock 解析语句块直到 DEDENT，nested 为 true 时当前位置在 INDENT 之后
+func (p *pyParser) parseBlock(class *pyClass, nested bool) {
+	var decorators []string
+	var decoratorStart *pyToken
+	for !p.eof() {
+		if p.cur().kind == pyDedent {
+			if nested {
+				p.next()
+				return
+			}
+			p.next()
+			continue
+		}
+		start := p.pos
+		t := p.cur()
+		switch {
+		case t.kind == pyNewline || t.kind == pyIndent:
+			p.next()
+			continue
+		case t.is("@"):
+			if decoratorStart == nil {
+				decoratorStart = &t
+			}
+			p.next()
+			decorators = append(decorators, pyExprText(p.restOfLine()))
+			continue
+		case t.is("class"):
+			p.parseClass(class)
+		case t.is("def") || t.is("async") && p.peek(1).is("def"):
+			fun := p.parseFunction(class, decorators, decoratorStart)
+			if fun != nil && class == nil {
+				p.module.functions = append(p.module.functions, fun)
+			}
+		case t.is("import") || t.is("from"):
+			p.parseImport()
+		case p.isCompoundStatement():
+			p.parseCompound(class)
+		default:
+			p.parseSimpleStatement(class)
+		}
+		decorators, decoratorStart = nil, nil
+		if p.pos == start {
+			p.next()
+		}
+	}
+}
+
+func (p *pyParser) isCompoundStatement() bool {
+	t := p.cur()
+	if t.kind != pyName {
+		return false
+	}
+	switch t.text {
+	case "if", "elif", "else", "try", "except", "finally", "with", "for", "while":
+		return true
+	case "match", "case":
+		// match/case 是软关键字，以冒号结束的才是语句
+		return !p.peek(1).is("=") && !p.peek(1).is(".") && p.headerEnd() >= 0
+	case "async":
+		return p.peek(1).is("with") || p.peek(1).is("for")
+	}
+	return false
+}
+
+// restOfLine 返回到行尾的词法单元并跳过 NEWLINE
+func (p *pyParser) restOfLine() []pyToken {
+	start := p.pos
+	for !p.eof() && p.cur().kind != pyNewline {
+		p.next()
+	}
+	end := p.pos
+	p.next()
+	return p.tokens[start:end]
+}
+
+// parseCompound 模块级和类体中的 if/try/with 等复合语句，块内的声明仍属于外层
+func (p *pyParser) parseCompound(class *pyClass) {
+	header := p.headerEnd()
+	if header < 0 {
+		p.restOfLine()
+		return
+	}
+	p.pos = header + 1
+	if p.cur().kind == pyNewline && p.peek(1).kind == pyIndent {
+		p.pos += 2
+		p.parseBlock(class, true)
+		return
+	}
+	p.parseSimpleStatement(class)
+}
+
+// headerEnd 查找复合语句头部结束的冒号
+func (p *pyParser) headerEnd() int {
+	depth := 0
+	for i := p.pos; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		switch {
+		case t.kind == pyNewline || t.kind == pyEOF:
+			return -1
+		case t.is("(") || t.is("[") || t.is("{"):
+			depth++
+		case t.is(")") || t.is("]") || t.is("}"):
+			depth--
+		case t.is("lambda"):
+			// lambda 参数后的冒号不是头部结束
+			depth++
+		case t.is(":") && depth > 0:
+			if p.isLambdaColon(i) {
+				depth--
+			}
+		case t.is(":") && depth == 0:
+			return i
+		}
+	}
+	return -1
+}
+
+// isLambdaColon 判断冒号是否结束了一个 lambda 参数列表
+func (p *pyParser) isLambdaColon(index int) bool {
+	brackets := 0
+	for i := index - 1; i >= p.pos; i-- {
+		t := p.tokens[i]
+		switch {
+		case t.is(")") || t.is("]") || t.is("}"):
+			brackets++
+		case t.is("(") || t.is("[") || t.is("{"):
+			if brackets == 0 {
+				return false
+			}
+			brackets--
+		case t.is("lambda") && brackets == 0:
+			return true
+		case t.is(":") && brackets == 0:
+			return false
+		}
+	}
+	return false
+}
+
+// parseSimpleStatement 记录模块变量和类属性：name [: type] = value
+func (p *pyParser) parseSimpleStatement(class *pyClass) {
+	name := p.cur()
+	line := p.restOfLine()
+	if name.kind != pyName || pyKeywords[name.text] || len(line) < 2 {
+		return
+	}
+	v := &pyVariable{name: name.text, pos: name, start: name.offset, end: line[len(line)-1].end}
+	switch {
+	case line[1].is(":"):
+		end := len(line)
+		for i := 2; i < len(line); i++ {
+			if line[i].is("=") && pyDepth(line[2:i]) == 0 {
+				end = i
+				v.value = line[i+1:]
+				break
+			}
+		}
+		v.typ = pyExprText(line[2:end])
+	case line[1].is("=") && !(len(line) > 2 && line[2].is("=")):
+		v.value = line[2:]
+	default:
+		return
+	}
+	if class != nil {
+		class.fields = append(class.fields, v)
+	} else {
+		p.module.variables = append(p.module.variables, v)
+	}
+}
+
+// pyInferType 从赋值推断类型：a.b.C(...) 推断为 a.b.C
+func pyInferType(value []pyToken) string {
+	if len(value) < 3 || value[0].kind != pyName || !value[len(value)-1].is(")") {
+		return ""
+	}
+	i := 1
+	for i+1 < len(value) && value[i].is(".") && value[i+1].kind == pyName {
+		i += 2
+	}
+	if !value[i].is("(") || pyCloseIndex(value, i) != len(value)-1 {
+		return ""
+	}
+	return pyExprText(value[:i])
+}
+
+// pyCloseIndex 返回与 open 处括号匹配的右括号位置
+func pyCloseIndex(tokens []pyToken, open int) int {
+	depth := 0
+	for i := open; i < len(tokens); i++ {
+		switch {
+		case tokens[i].is("(") || tokens[i].is("[") || tokens[i].is("{"):
+			depth++
+		case tokens[i].is(")") || tokens[i].is("]") || tokens[i].is("}"):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func pyDepth(tokens []pyToken) int {
+	depth := 0
+	for _, t := range tokens {
+		switch {
+		case t.is("(") || t.is("[") || t.is("{"):
+			depth++
+		case t.is(")") || t.is("]") || t.is("}"):
+			depth--
+		}
+	}
+	return depth
+}
+
+// pyExprText 拼接表达式文本，名称之间保留空格
+func pyExprText(tokens []pyToken) string {
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 && (t.kind == pyName || t.kind == pyNumber) && (tokens[i-1].kind == pyName || tokens[i-1].kind == pyNumber) {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(t.text)
+	}
+	return sb.String()
+}
+
+// parseImport 解析 import a.b as c, d 和 from .a import (b as c, d)
+func (p *pyParser) parseImport() {
+	line := p.restOfLine()
+	if len(line) == 0 {
+		return
+	}
+	start, end := line[0].offset, line[len(line)-1].end
+	if line[0].is("import") {
+		for _, part := range pySplit(line[1:], ",") {
+			imp := &pyImport{start: start, end: end}
+			imp.module, imp.alias = pyImportTarget(part)
+			if len(imp.module) > 0 {
+				p.module.imports = append(p.module.imports, imp)
+			}
+		}
+		return
+	}
+	// from module import names
+	index := -1
+	for i, t := range line {
+		if t.is("import") {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return
+	}
+	var module strings.Builder
+	for _, t := range line[1:index] {
+		module.WriteString(t.text)
+	}
+	var names []pyToken
+	for _, t := range line[index+1:] {
+		if !t.is("(") && !t.is(")") {
+			names = append(names, t)
+		}
+	}
+	for _, part := range pySplit(names, ",") {
+		imp := &pyImport{module: module.String(), from: true, start: start, end: end}
+		imp.name, imp.alias = pyImportTarget(part)
+		if len(imp.name) > 0 {
+			p.module.imports = append(p.module.imports, imp)
+		}
+	}
+}
+
+// pyImportTarget 解析 a.b as c
+func pyImportTarget(tokens []pyToken) (string, string) {
+	var name strings.Builder
+	alias := ""
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].is("as") && i+1 < len(tokens) {
+			alias = tokens[i+1].text
+			break
+		}
+		name.WriteString(tokens[i].text)
+	}
+	return name.String(), alias
+}
+
+// pySplit 按顶层分隔符拆分词法单元
+func pySplit(tokens []pyToken, sep string) [][]pyToken {
+	var parts [][]pyToken
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch {
+		case t.is("(") || t.is("[") || t.is("{"):
+			depth++
+		case t.is(")") || t.is("]") || t.is("}"):
+			depth--
+		case t.is(sep) && depth == 0:
+			if i > start {
+				parts = append(parts, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if len(tokens) > start {
+		parts = append(parts, tokens[start:])
+	}
+	return parts
+}
+
+// parseClass 解析 class Name(Base, metaclass=M):
+func (p *pyParser) parseClass(outer *pyClass) {
+	start := p.next().offset
+	if p.cur().kind != pyName {
+		p.restOfLine()
+		return
+	}
+	c := &pyClass{pos: p.cur(), start: start, simpleName: p.next().text, outer: outer}
+	c.name = c.simpleName
+	if outer != nil {
+		c.name = outer.name + "." + c.simpleName
+	}
+	if p.cur().is("[") {
+		// PEP 695 类型参数
+		p.skipBalanced("[", "]")
+	}
+	if p.cur().is("(") {
+		for _, base := range pySplit(p.skipBalanced("(", ")"), ",") {
+			if len(base) > 1 && base[1].is("=") || base[0].is("*") {
+				continue
+			}
+			c.bases = append(c.bases, pyExprText(base))
+		}
+	}
+	if !p.accept(":") {
+		p.restOfLine()
+		return
+	}
+	p.module.classes = append(p.module.classes, c)
+	if p.cur().kind == pyNewline && p.peek(1).kind == pyIndent {
+		p.pos += 2
+		c.doc = p.parseDocString()
+		p.parseBlock(c, true)
+	} else {
+		p.restOfLine()
+	}
+	c.end = p.lastEnd()
+	c.collectInstanceFields()
+}
+
+// lastEnd 当前位置之前最后一个不是换行和缩进的词法单元结束的偏移
+func (p *pyParser) lastEnd() int {
+	for i := p.pos - 1; i >= 0; i-- {
+		if kind := p.tokens[i].kind; kind != pyNewline && kind != pyIndent && kind != pyDedent && kind != pyEOF {
+			return p.tokens[i].end
+		}
+	}
+	return 0
+}
+
+// skipBalanced 当前位置为 open，返回括号内的词法单元并跳到 close 之后
+func (p *pyParser) skipBalanced(open, close string) []pyToken {
+	start := p.pos + 1
+	depth := 0
+	for !p.eof() {
+		t := p.next()
+		if t.is(open) {
+			depth++
+		} else if t.is(close) {
+			depth--
+			if depth == 0 {
+				return p.tokens[start : p.pos-1]
+			}
+		}
+	}
+	return p.tokens[start:p.pos]
+}
+
+// parseFunction 解析 [async] def name(params) -> result: body
+func (p *pyParser) parseFunction(class *pyClass, decorators []string, decoratorStart *pyToken) *pyFunction {
+	startToken := p.cur()
+	if decoratorStart != nil {
+		startToken = *decoratorStart
+	}
+	p.accept("async")
+	p.next()
+	if p.cur().kind != pyName {
+		p.restOfLine()
+		return nil
+	}
+	fun := &pyFunction{pos: p.cur(), name: p.next().text, decorators: decorators, start: startToken.offset, owner: class}
+	if p.cur().is("[") {
+		p.skipBalanced("[", "]")
+	}
+	if !p.cur().is("(") {
+		p.restOfLine()
+		return nil
+	}
+	for _, param := range pySplit(p.skipBalanced("(", ")"), ",") {
+		for len(param) > 0 && (param[0].is("*") || param[0].is("/")) {
+			param = param[1:]
+		}
+		if len(param) == 0 || param[0].kind != pyName {
+			continue
+		}
+		pp := &pyParam{name: param[0].text}
+		if len(param) > 2 && param[1].is(":") {
+			end := len(param)
+			for i := 2; i < len(param); i++ {
+				if param[i].is("=") && pyDepth(param[2:i]) == 0 {
+					end = i
+					break
+				}
+			}
+			pp.typ = pyExprText(param[2:end])
+		}
+		fun.params = append(fun.params, pp)
+	}
+	if p.cur().is("-") && p.peek(1).is(">") {
+		p.pos += 2
+		start := p.pos
+		for !p.eof() && !p.cur().is(":") && p.cur().kind != pyNewline {
+			if p.cur().is("[") {
+				p.skipBalanced("[", "]")
+				continue
+			}
+			p.next()
+		}
+		fun.result = pyExprText(p.tokens[start:p.pos])
+	}
+	if !p.accept(":") {
+		p.restOfLine()
+		return nil
+	}
+	if p.cur().kind == pyNewline && p.peek(1).kind == pyIndent {
+		p.pos += 2
+		start := p.pos
+		depth := 1
+		for !p.eof() && depth > 0 {
+			switch p.next().kind {
+			case pyIndent:
+				depth++
+			case pyDedent:
+				depth--
+			}
+		}
+		fun.body = p.tokens[start:p.pos]
+		if len(fun.body) > 0 && fun.body[0].kind == pyString && len(fun.body) > 1 && fun.body[1].kind == pyNewline {
+			fun.doc = pyStringValue(fun.body[0].text)
+		}
+	} else {
+		fun.body = p.restOfLine()
+	}
+	fun.end = fun.pos.end
+	for i := len(fun.body) - 1; i >= 0; i-- {
+		if fun.body[i].kind != pyDedent && fun.body[i].kind != pyNewline {
+			fun.end = fun.body[i].end
+			break
+		}
+	}
+	if class != nil {
+		class.methods = append(class.methods, fun)
+	}
+	return fun
+}
+
+// collectInstanceFields 从方法体中收集 self.name [: type] = value 形式的实例属性
+func (c *pyClass) collectInstanceFields() {
+	seen := make(map[string]bool)
+	for _, f := range c.fields {
+		seen[f.name] = true
+	}
+	for _, m := range c.methods {
+		if m.isStatic() || len(m.params) == 0 {
+			continue
+		}
+		self := m.params[0].name
+		body := m.body
+		for i := 0; i+3 < len(body); i++ {
+			if !body[i].is(self) || !body[i+1].is(".") || body[i+2].kind != pyName {
+				continue
+			}
+			if i > 0 && !(body[i-1].kind == pyNewline || body[i-1].kind == pyIndent || body[i-1].kind == pyDedent) {
+				continue
+			}
+			name := body[i+2]
+			end := i + 3
+			for end < len(body) && body[end].kind != pyNewline {
+				end++
+			}
+			line := body[i+3 : end]
+			v := &pyVariable{name: name.text, pos: name, start: body[i].offset, end: body[end-1].end}
+			switch {
+			case len(line) > 1 && line[0].is(":"):
+				typEnd := len(line)
+				for j := 1; j < len(line); j++ {
+					if line[j].is("=") && pyDepth(line[1:j]) == 0 {
+						typEnd = j
+						v.value = line[j+1:]
+						break
+					}
+				}
+				v.typ = pyExprText(line[1:typEnd])
+			case len(line) > 1 && line[0].is("=") && !line[1].is("="):
+				v.value = line[1:]
+				// self.repo = repo 使用参数的类型注解
+				if len(v.value) == 1 {
+					for _, param := range m.params {
+						if param.name == v.value[0].text {
+							v.typ = param.typ
+						}
+					}
+				}
+			default:
+				continue
+			}
+			if seen[v.name] {
+				continue
+			}
+			seen[v.name] = true
+			c.fields = append(c.fields, v)
+		}
+	}
+}
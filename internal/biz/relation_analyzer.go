@@ -15,7 +15,16 @@ const (
 	Implement     = "Implement"     //实现
 	Call          = "Call"          //调用
 	Extends       = "Extends"       //继承
+	DispatchesTo  = "DispatchesTo"  //接口方法分派到实现方法
 	Imports       = "Import"
+	DependsOn     = "DependsOn" //包依赖，由包中文件的导入汇总
+	Tests         = "Tests"     //测试函数直接或间接调用非测试代码中的函数
+)
+
+// Call 关系的 Confidence 记录产生该关系的解析器
+const (
+	ConfidenceTypeChecked = 1.0 // go/types 类型检查解析
+	ConfidenceHeuristic   = 0.6 // AST 启发式解析
 )
 
 type Relation struct {
@@ -23,6 +32,8 @@ type Relation struct {
 	TargetID   string
 	Confidence float64
 	SourceID   string
+	// CallSite Call 关系在调用方源码中的位置
+	CallSite *Span
 }
 
 func (r *Relation) UnionKey() string {
@@ -56,7 +67,7 @@ func (ra *RelationAnalyzer) AnalyzeEntityRelations() ([]*Relation, error) {
 
 		// 分析方法关系
 		for _, fun := range e.GetMethods() {
-			if fun.Data == nil {
+			if !fun.hasBody() {
 				continue
 			}
 			funRelations := ra.analyzeFunctionRelations(fun, e)
@@ -88,15 +99,14 @@ func (ra *RelationAnalyzer) AnalyzeEntityRelations() ([]*Relation, error) {
 		funRelations := ra.analyzeFunctionRelations(fun, nil)
 		relations = append(relations, funRelations...)
 	}
+	// 分析导入关系
+	relations = append(relations, ra.pkg.GetProject().frontend.AnalyzeImports(ra.file)...)
 	return relations, nil
 }
 
 func (ra *RelationAnalyzer) analyzeFieldRelations(field *Field, entity *Entity) []*Relation {
-	if field.expr == nil {
-		return nil
-	}
 	var relations []*Relation
-	typeEntity := ra.resolveTypeEntity(field.expr)
+	typeEntity := ra.pkg.GetProject().frontend.ResolveFieldEntity(ra, field)
 	if typeEntity != nil && typeEntity.ID != entity.ID {
 		relations = append(relations, &Relation{
 			Type:       HasFields,
@@ -155,19 +165,7 @@ func (ra *RelationAnalyzer) resolveTypeEntity(expr ast.Expr) *Entity {
 
 // analyzeFunctionCalls 分析函数调用关系
 func (ra *RelationAnalyzer) analyzeFunctionCalls(fun *Function) []*Relation {
-	if fun.Data == nil {
-		return nil
-	}
-
-	visitor := &FunctionCallVisitor{
-		relations: make([]*Relation, 0),
-		function:  fun,
-		analyzer:  ra,
-		entities:  make(map[string]*Entity),
-	}
-	// 遍历AST
-	ast.Walk(visitor, fun.Data)
-	return visitor.relations
+	return ra.pkg.GetProject().frontend.AnalyzeCalls(ra, fun)
 }
 
 // FunctionCallVisitor 函数调用访问器
@@ -217,6 +215,7 @@ func (v *FunctionCallVisitor) Visit(node ast.Node) ast.Visitor {
 }
 
 func (v *FunctionCallVisitor) handleCallExpr(call *ast.CallExpr) {
+	defer v.recordCallSite(len(v.relations), call)
 	switch fun := call.Fun.(type) {
 	case *ast.Ident:
 		// 直接函数调用
@@ -225,7 +224,7 @@ func (v *FunctionCallVisitor) handleCallExpr(call *ast.CallExpr) {
 				Type:       Call,
 				SourceID:   v.function.ID,
 				TargetID:   function.ID,
-				Confidence: 1,
+				Confidence: ConfidenceHeuristic,
 			})
 		}
 
@@ -234,6 +233,17 @@ func (v *FunctionCallVisitor) handleCallExpr(call *ast.CallExpr) {
 	}
 }
 
+// recordCallSite 给处理 call 时新增的关系记录调用位置
+func (v *FunctionCallVisitor) recordCallSite(from int, call *ast.CallExpr) {
+	site := goCallSpan(v.function.file.fset, call)
+	if !site.IsValid() {
+		return
+	}
+	for _, relation := range v.relations[from:] {
+		relation.CallSite = &site
+	}
+}
+
 func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 	switch x := selector.X.(type) {
 	case *ast.Ident:
@@ -243,7 +253,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 			return
@@ -255,7 +265,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		} else {
@@ -266,7 +276,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 						Type:       Call,
 						SourceID:   v.function.ID,
 						TargetID:   function.ID,
-						Confidence: 1,
+						Confidence: ConfidenceHeuristic,
 					})
 				}
 			}
@@ -284,7 +294,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 							Type:       Call,
 							SourceID:   v.function.ID,
 							TargetID:   function.ID,
-							Confidence: 1,
+							Confidence: ConfidenceHeuristic,
 						})
 						return
 					}
@@ -297,7 +307,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 						Type:       Call,
 						SourceID:   v.function.ID,
 						TargetID:   function.ID,
-						Confidence: 1,
+						Confidence: ConfidenceHeuristic,
 					})
 					return
 				}
@@ -311,7 +321,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		}
@@ -323,7 +333,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		}
@@ -335,7 +345,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		}
@@ -347,7 +357,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		}
@@ -359,7 +369,7 @@ func (v *FunctionCallVisitor) handleSelectorExpr(selector *ast.SelectorExpr) {
 					Type:       Call,
 					SourceID:   v.function.ID,
 					TargetID:   function.ID,
-					Confidence: 1,
+					Confidence: ConfidenceHeuristic,
 				})
 			}
 		}
@@ -7,7 +7,17 @@ import (
 
 type ProjectRepo interface {
 	SaveProject(ctx context.Context, p *Project) error
-	QueryCallChain(ctx context.Context, id string) ([]*v1.CallRelationship, error)
+	// GetFileHashes 已保存的根包下所有文件的内容哈希
+	GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error)
+	// GetDependentFiles 有关系指向给定文件中节点的其他文件
+	GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error)
+	// QueryCallEdges 与 ids 中函数按 direction 相连的 relationTypes 关系，按 From 排序，最多 limit 条
+	// excludeTests 时另一端不能是测试文件中的函数
+	QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*CallEdge, error)
+	// QueryCallersChain 沿调用关系反向查找调用方
+	QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error)
+	// QueryFunctionTests 通过 Tests 关系指向函数的测试函数，按层数排序
+	QueryFunctionTests(ctx context.Context, id string) ([]*v1.FunctionTest, error)
 
 	// Repo management
 	CreateRepo(ctx context.Context, req *v1.CreateRepoReq) (string, error)
@@ -15,14 +25,51 @@ type ProjectRepo interface {
 	GetRepo(ctx context.Context, id string) (*v1.Repo, error)
 	DeleteRepo(ctx context.Context, id string) error
 
+	// Analysis jobs
+	SaveAnalysisJob(ctx context.Context, job *v1.AnalysisJob) error
+	GetAnalysisJob(ctx context.Context, id string) (*v1.AnalysisJob, error)
+	ListAnalysisJobs(ctx context.Context, repoId string, limit int) ([]*v1.AnalysisJob, error)
+	// FailUnfinishedAnalysisJobs 把仍为 Pending 或 Running 的任务标记为 Failed，返回修改的任务数
+	FailUnfinishedAnalysisJobs(ctx context.Context, reason string, finishedAt int64) (int64, error)
+
+	// Snapshots
+	SaveSnapshot(ctx context.Context, snapshot *v1.Snapshot) error
+	// ListSnapshots 按分析时间倒序返回仓库的快照
+	ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error)
+	// CopySnapshot 把快照 from 的所有节点和关系复制为快照 to，增量分析新提交时在上次的结果上修改
+	CopySnapshot(ctx context.Context, from, to string) error
+	// DeleteSnapshot 删除快照记录及其所有节点
+	DeleteSnapshot(ctx context.Context, snapshot *v1.Snapshot) error
+	// GetSnapshotGraph 快照中的包、文件、实体、函数及其关系
+	GetSnapshotGraph(ctx context.Context, snapshotId string) (*SnapshotGraph, error)
+	// SaveArchitectureViolations 替换快照的架构规则违规
+	SaveArchitectureViolations(ctx context.Context, snapshotId string, violations []*v1.ArchitectureViolation) error
+	// ListArchitectureViolations 按保存的顺序返回快照的架构规则违规
+	ListArchitectureViolations(ctx context.Context, snapshotId string) ([]*v1.ArchitectureViolation, error)
+	// SearchSymbols 名称匹配 query 的候选符号，带 fanIn，不计算得分
+	SearchSymbols(ctx context.Context, query *SymbolQuery) ([]*v1.Symbol, error)
+	// LookupSymbols 按 ID、名称或所在文件查找实体、函数和字段，带声明所在的文件和位置
+	LookupSymbols(ctx context.Context, lookup *SymbolLookup) ([]*v1.Symbol, error)
+	// QueryImpactEdges 通过 relationTypes 关系指向 ids 中节点的实体和函数，按被指向的节点排序，最多 limit 条
+	QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*ImpactEdge, error)
+	// GetFileSymbols 文件中声明的符号、调用位置和导入
+	GetFileSymbols(ctx context.Context, fileID string) (*FileSymbols, error)
+	// GetReferenceFiles 可能引用符号的文件：符号所在包的文件、导入该包的文件，调用该符号或其所属类型的方法的文件
+	GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error)
+
 	// Repo bindings and views
 	BindRepoRoot(ctx context.Context, repoId, rootPkgId string) error
 	GetRepoTree(ctx context.Context, id string) (packages []*v1.PackageNode, files []*v1.FileNode, err error)
+	// GetPackageDependencies 源包为 scope 或其子包的 DependsOn 关系及两端的包，scope 为包 ID 或快照 ID
+	GetPackageDependencies(ctx context.Context, scope string) (packages []*v1.PackageNode, dependencies []*v1.PackageDependency, err error)
 	GetFunctionByFileId(ctx context.Context, fileId string) (functions []*v1.Function, err error)
 	GetImplementByEntityId(ctx context.Context, entityID string) (entities []*v1.Entity, err error)
 }
 
 type IndexerRepo interface {
+	// SaveCodeChunk 保存仓库 partition 的代码块，先删除与这些代码块同路径的已有代码块，重新索引文件时替换
 	SaveCodeChunk(ctx context.Context, projectName, partition string, codeChunks []*CodeChunk) error
 	SearchCodeChunk(ctx context.Context, req *SearchCodeChunksReq) ([]*CodeChunk, error)
+	// DeleteCodeChunks 删除仓库 partition 的所有代码块
+	DeleteCodeChunks(ctx context.Context, projectName, partition string) error
 }
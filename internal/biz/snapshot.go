@@ -0,0 +1,145 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/conf"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SnapshotSep 分隔快照 ID 中的仓库 ID 和提交
+const SnapshotSep = "~"
+
+// SnapshotID 仓库在某个提交上的快照 ID，非 git 仓库只有一个快照，ID 为仓库 ID
+func SnapshotID(repoId, commit string) string {
+	if commit == "" {
+		return repoId
+	}
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	return repoId + SnapshotSep + commit
+}
+
+// SplitNodeID 拆分节点 ID 为所在的快照 ID 和快照内的路径
+func SplitNodeID(id string) (snapshot, path string) {
+	snapshot, path, _ = strings.Cut(id, PathSep)
+	return snapshot, path
+}
+
+// SnapshotRepoID 快照所属的仓库 ID
+func SnapshotRepoID(snapshot string) string {
+	repoId, _, _ := strings.Cut(snapshot, SnapshotSep)
+	return repoId
+}
+
+// SnapshotRetention 快照保留策略，每个仓库最新的快照总是保留
+type SnapshotRetention struct {
+	// 保留的快照数，0 为不限制
+	Keep int
+	// 保留时长，0 为不限制
+	MaxAge time.Duration
+}
+
+func NewSnapshotRetention(data *conf.Data) *SnapshotRetention {
+	return &SnapshotRetention{
+		Keep:   int(data.GetSnapshot().GetKeep()),
+		MaxAge: data.GetSnapshot().GetMaxAge().AsDuration(),
+	}
+}
+
+// expired 需要清理的快照，snapshots 按分析时间倒序
+func (r *SnapshotRetention) expired(snapshots []*v1.Snapshot, now time.Time) []*v1.Snapshot {
+	var expired []*v1.Snapshot
+	for i, s := range snapshots {
+		if i == 0 {
+			continue
+		}
+		if (r.Keep > 0 && i >= r.Keep) || (r.MaxAge > 0 && now.Sub(time.UnixMilli(s.AnalyzedAt)) > r.MaxAge) {
+			expired = append(expired, s)
+		}
+	}
+	return expired
+}
+
+// ListSnapshots 按分析时间倒序列出仓库的快照
+func (c *CodeWiki) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	return c.projectRepo.ListSnapshots(ctx, repoId)
+}
+
+// resolveSnapshot 按快照 ID 或提交（前缀）查找仓库的快照，为空时返回最新的快照，仓库还没有快照时返回 nil
+func (c *CodeWiki) resolveSnapshot(ctx context.Context, repoId, snapshot string) (*v1.Snapshot, error) {
+	snapshots, err := c.projectRepo.ListSnapshots(ctx, repoId)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == "" {
+		if len(snapshots) == 0 {
+			return nil, nil
+		}
+		return snapshots[0], nil
+	}
+	for _, s := range snapshots {
+		if s.Id == snapshot || (len(snapshot) >= 4 && strings.HasPrefix(s.Commit, snapshot)) {
+			return s, nil
+		}
+	}
+	return nil, v1.ErrorDataRecordNotFound("snapshot %s of repo %s not found", snapshot, repoId)
+}
+
+// snapshotNodeID 把节点 ID 换到 snapshot 对应的快照中，snapshot 为空时不变
+func (c *CodeWiki) snapshotNodeID(ctx context.Context, id, snapshot string) (string, error) {
+	current, path := SplitNodeID(id)
+	if snapshot == "" || path == "" {
+		return id, nil
+	}
+	s, err := c.resolveSnapshot(ctx, SnapshotRepoID(current), snapshot)
+	if err != nil {
+		return "", err
+	}
+	return geneID(s.Id, path), nil
+}
+
+// fileRevision 读取节点所在快照文件时使用的提交
+// 本地仓库的最新快照读取工作区，远端仓库和本地仓库的历史快照从 git 中读取分析时的版本
+func (c *CodeWiki) fileRevision(ctx context.Context, repo *v1.Repo, id string) (string, error) {
+	current, _ := SplitNodeID(id)
+	snapshots, err := c.projectRepo.ListSnapshots(ctx, repo.Id)
+	if err != nil {
+		return "", err
+	}
+	for i, s := range snapshots {
+		if s.Id == current && (IsRemote(repo) || i > 0) {
+			return s.Commit, nil
+		}
+	}
+	return "", nil
+}
+
+// saveSnapshot 记录分析完成的快照，并按保留策略清理旧快照
+func (c *CodeWiki) saveSnapshot(ctx context.Context, jobId string, project *Project) error {
+	err := c.projectRepo.SaveSnapshot(ctx, &v1.Snapshot{
+		Id:         project.SnapshotID(),
+		RepoId:     project.Repo.Id,
+		Commit:     project.Commit,
+		Ref:        project.Repo.Ref,
+		JobId:      jobId,
+		AnalyzedAt: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	snapshots, err := c.projectRepo.ListSnapshots(ctx, project.Repo.Id)
+	if err != nil {
+		return err
+	}
+	for _, s := range c.retention.expired(snapshots, time.Now()) {
+		if err = c.projectRepo.DeleteSnapshot(ctx, s); err != nil {
+			log.Context(ctx).Warnf("delete snapshot %s failure:%v", s.Id, err)
+		}
+	}
+	return nil
+}
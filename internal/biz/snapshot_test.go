@@ -0,0 +1,99 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRetention(t *testing.T) {
+	now := time.Now()
+	snapshots := []*v1.Snapshot{
+		{Id: "s0", AnalyzedAt: now.Add(-72 * time.Hour).UnixMilli()},
+		{Id: "s1", AnalyzedAt: now.Add(-time.Hour).UnixMilli()},
+		{Id: "s2", AnalyzedAt: now.Add(-48 * time.Hour).UnixMilli()},
+		{Id: "s3", AnalyzedAt: now.Add(-96 * time.Hour).UnixMilli()},
+	}
+	ids := func(snapshots []*v1.Snapshot) []string {
+		var ids []string
+		for _, s := range snapshots {
+			ids = append(ids, s.Id)
+		}
+		return ids
+	}
+	for _, c := range []struct {
+		retention SnapshotRetention
+		want      []string
+	}{
+		{SnapshotRetention{}, nil},
+		{SnapshotRetention{Keep: 2}, []string{"s2", "s3"}},
+		// 最新的快照即使过期也保留
+		{SnapshotRetention{MaxAge: 24 * time.Hour}, []string{"s2", "s3"}},
+		{SnapshotRetention{Keep: 3, MaxAge: 60 * time.Hour}, []string{"s3"}},
+	} {
+		if got := ids(c.retention.expired(snapshots, now)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%+v: expired = %v, want %v", c.retention, got, c.want)
+		}
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	remote, _, commits := newRemote(t)
+	repo := &v1.Repo{Id: "repo", RepoType: v1.RepoType_Git, Target: remote, Language: v1.Language_Golang}
+	projectRepo := newJobProjectRepo(repo)
+	c := newTestCodeWiki(t, projectRepo)
+	analyze := func(ref string) *v1.AnalysisJob {
+		t.Helper()
+		repo.Ref = ref
+		job, err := c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job = waitJob(t, c, job.Id); job.Status != v1.JobStatus_Succeeded {
+			t.Fatalf("job = %+v", job)
+		}
+		return job
+	}
+	old := analyze("v1")
+	latest := analyze("main")
+	if old.Snapshot != SnapshotID("repo", commits["v1"]) || latest.Snapshot != SnapshotID("repo", commits["main"]) {
+		t.Fatalf("snapshots = %s, %s", old.Snapshot, latest.Snapshot)
+	}
+	snapshots, err := c.ListSnapshots(context.Background(), "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 || snapshots[0].Id != latest.Snapshot || snapshots[1].Commit != commits["v1"] || snapshots[1].Ref != "v1" {
+		t.Fatalf("snapshots = %v", snapshots)
+	}
+
+	// 节点 ID 按快照换到其他提交
+	fileA := latest.Snapshot + "@remote@a.go"
+	id, err := c.snapshotNodeID(context.Background(), fileA, commits["v1"][:7])
+	if err != nil || id != old.Snapshot+"@remote@a.go" {
+		t.Errorf("snapshotNodeID = %s, %v", id, err)
+	}
+	if _, err = c.snapshotNodeID(context.Background(), fileA, "deadbeef"); err == nil {
+		t.Errorf("expected error for unknown snapshot")
+	}
+
+	// 文件内容从快照对应的提交中读取
+	content, err := c.ViewFileContent(context.Background(), &v1.ViewFileReq{RepoId: "repo", Id: latest.Snapshot + "@remote@b.go"})
+	if err != nil || content.Content != "package p\n\nfunc B() { A() }\n" {
+		t.Errorf("content = %v, %v", content, err)
+	}
+	if _, err = c.ViewFileContent(context.Background(), &v1.ViewFileReq{RepoId: "repo", Id: latest.Snapshot + "@remote@b.go", Snapshot: old.Snapshot}); err == nil {
+		t.Errorf("expected error reading b.go before it was added")
+	}
+
+	c.retention.Keep = 1
+	dev := analyze("dev")
+	if !reflect.DeepEqual(projectRepo.deleted, []string{latest.Snapshot, old.Snapshot}) {
+		t.Errorf("deleted = %v", projectRepo.deleted)
+	}
+	if snapshots, _ = c.ListSnapshots(context.Background(), "repo"); len(snapshots) != 1 || snapshots[0].Id != dev.Snapshot {
+		t.Errorf("snapshots = %v", snapshots)
+	}
+}
@@ -0,0 +1,212 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sourceIdent 源码中的一个标识符
+type sourceIdent struct {
+	name string
+	// qualifier a.b.c 中 c 的限定名称 [a b]，限定部分不全是标识符时为空
+	qualifier []string
+	// member 前面是 .，即访问某个值、类型或包的成员
+	member bool
+	// call 后面紧跟调用的括号
+	call bool
+	span Span
+}
+
+// sourceIdents 按顺序返回源码中的标识符，Go 源码取语法树中的标识符，其他语言按文本扫描
+func sourceIdents(src string, language v1.Language) []sourceIdent {
+	if language == v1.Language_Golang {
+		return goIdents(src)
+	}
+	return scanIdents(src, language == v1.Language_Python)
+}
+
+// goIdents 按位置顺序返回 Go 源码语法树中的标识符，语法错误时使用能解析出的部分
+func goIdents(src string) []sourceIdent {
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, "", src, parser.SkipObjectResolution)
+	if f == nil {
+		return nil
+	}
+	qualifiers := make(map[*ast.Ident][]string)
+	members := make(map[*ast.Ident]bool)
+	calls := make(map[*ast.Ident]bool)
+	var names []*ast.Ident
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			names = append(names, n)
+		case *ast.SelectorExpr:
+			members[n.Sel] = true
+			qualifiers[n.Sel] = goQualifier(n.X)
+		case *ast.CallExpr:
+			switch fun := n.Fun.(type) {
+			case *ast.Ident:
+				calls[fun] = true
+			case *ast.SelectorExpr:
+				calls[fun.Sel] = true
+			}
+		}
+		return true
+	})
+	sort.SliceStable(names, func(i, j int) bool { return names[i].Pos() < names[j].Pos() })
+	idents := make([]sourceIdent, 0, len(names))
+	for _, name := range names {
+		idents = append(idents, sourceIdent{
+			name:      name.Name,
+			qualifier: qualifiers[name],
+			member:    members[name],
+			call:      calls[name],
+			span:      goSpan(fset, name.Pos(), name.End()),
+		})
+	}
+	return idents
+}
+
+// goQualifier a.b 全由标识符组成时返回 [a b]，否则为空
+func goQualifier(x ast.Expr) []string {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return []string{x.Name}
+	case *ast.SelectorExpr:
+		if q := goQualifier(x.X); q != nil {
+			return append(q, x.Sel.Name)
+		}
+	}
+	return nil
+}
+
+// scanIdents 按顺序返回 Java 和 Python 源码中的标识符，跳过注释、字符串和数字
+// python 为 true 时按 Python 的注释和字符串语法，否则按 Java 的语法
+func scanIdents(src string, python bool) []sourceIdent {
+	lines := newLineIndex([]byte(src))
+	var idents []sourceIdent
+	// last 上一个记号是标识符时为它的下标；dot 上一个记号是 .，before 为 . 之前的标识符
+	last, before, dot := -1, -1, false
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case python && c == '#', !python && strings.HasPrefix(src[i:], "//"):
+			i = skipTo(src, i, "\n")
+			continue
+		case !python && strings.HasPrefix(src[i:], "/*"):
+			i = skipTo(src, i+2, "*/")
+			continue
+		case c == '"' || c == '\'' || c == '`':
+			i = skipString(src, i)
+		case c >= '0' && c <= '9':
+			for i < len(src) && (isIdentByte(src[i]) || src[i] == '.') {
+				i++
+			}
+		case c == '.' && !strings.HasPrefix(src[i:], ".."):
+			i++
+			before, dot, last = last, true, -1
+			continue
+		case c == '(':
+			if last >= 0 {
+				idents[last].call = true
+			}
+			i++
+		default:
+			r, size := utf8.DecodeRuneInString(src[i:])
+			if !isIdentStart(r) {
+				i += size
+				break
+			}
+			start := i
+			for i < len(src) {
+				r, size = utf8.DecodeRuneInString(src[i:])
+				if !isIdentStart(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+			}
+			// Python 的字符串前缀，例如 f"..."
+			if python && i < len(src) && (src[i] == '"' || src[i] == '\'') && isPyStringPrefix(src[start:i]) {
+				i = skipString(src, i)
+				break
+			}
+			ident := sourceIdent{name: src[start:i], member: dot, span: lines.span(start, i)}
+			if dot && before >= 0 {
+				prev := idents[before]
+				ident.qualifier = append(append([]string{}, prev.qualifier...), prev.name)
+			}
+			idents = append(idents, ident)
+			last, before, dot = len(idents)-1, -1, false
+			continue
+		}
+		last, before, dot = -1, -1, false
+	}
+	return idents
+}
+
+// identAt 包含给定位置的标识符
+func identAt(idents []sourceIdent, line, column int) (sourceIdent, bool) {
+	for _, ident := range idents {
+		s := ident.span
+		if s.StartLine == line && s.StartColumn <= column && column < s.EndColumn {
+			return ident, true
+		}
+		if s.StartLine > line {
+			break
+		}
+	}
+	return sourceIdent{}, false
+}
+
+// skipTo 返回 src[i:] 中 end 之后的偏移，没有 end 时返回 len(src)
+func skipTo(src string, i int, end string) int {
+	if n := strings.Index(src[i:], end); n >= 0 {
+		return i + n + len(end)
+	}
+	return len(src)
+}
+
+// skipString 跳过从 i 开始的字符串或字符字面量，返回其后的偏移
+func skipString(src string, i int) int {
+	quote := src[i : i+1]
+	if triple := strings.Repeat(quote, 3); quote != "`" && strings.HasPrefix(src[i:], triple) {
+		return skipTo(src, i+3, triple)
+	}
+	for j := i + 1; j < len(src); j++ {
+		switch {
+		case src[j] == '\\' && quote != "`":
+			j++
+		case src[j:j+1] == quote:
+			return j + 1
+		case src[j] == '\n' && quote != "`":
+			// 未结束的字符串只到行尾
+			return j
+		}
+	}
+	return len(src)
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isPyStringPrefix(s string) bool {
+	switch strings.ToLower(s) {
+	case "r", "b", "f", "u", "rb", "br", "fr", "rf":
+		return true
+	}
+	return false
+}
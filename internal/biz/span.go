@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+)
+
+// Span 源码中的起止位置，行列从 1 开始，列按字节计算，结束位置不包含在内
+type Span struct {
+	StartLine   int `json:"start_line"`
+	StartColumn int `json:"start_column"`
+	EndLine     int `json:"end_line"`
+	EndColumn   int `json:"end_column"`
+}
+
+// IsValid 是否记录了位置
+func (s Span) IsValid() bool {
+	return s.StartLine > 0
+}
+
+// goSpan go/ast 节点的位置
+func goSpan(fset *token.FileSet, start, end token.Pos) Span {
+	if fset == nil || !start.IsValid() || !end.IsValid() {
+		return Span{}
+	}
+	s, e := fset.Position(start), fset.Position(end)
+	return Span{StartLine: s.Line, StartColumn: s.Column, EndLine: e.Line, EndColumn: e.Column}
+}
+
+// goCallSpan 调用表达式从被调用的名称到右括号的位置，a.b.Method(x) 从 Method 开始
+func goCallSpan(fset *token.FileSet, call *ast.CallExpr) Span {
+	start := call.Fun.Pos()
+	if selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+		start = selector.Sel.Pos()
+	}
+	return goSpan(fset, start, call.Rparen+1)
+}
+
+// lineIndex 每行起始的字节偏移，把非 Go 语言前端记录的偏移换算为行列
+type lineIndex []int
+
+func newLineIndex(src []byte) lineIndex {
+	lines := lineIndex{0}
+	for i, b := range src {
+		if b == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	return lines
+}
+
+// position 偏移所在的行列
+func (li lineIndex) position(offset int) (line, column int) {
+	i := sort.Search(len(li), func(i int) bool { return li[i] > offset }) - 1
+	return i + 1, offset - li[i] + 1
+}
+
+// span 起止偏移对应的位置
+func (li lineIndex) span(start, end int) Span {
+	if len(li) == 0 || start < 0 || end < start {
+		return Span{}
+	}
+	var s Span
+	s.StartLine, s.StartColumn = li.position(start)
+	s.EndLine, s.EndColumn = li.position(end)
+	return s
+}
+
+// funcCall 函数体内的一次调用
+type funcCall struct {
+	callee *Function
+	site   Span
+}
+
+// callRelations 把调用转换为 Call 关系，同一被调用函数的多次调用由 Project.AddRelations 去重，保留第一处调用位置
+func callRelations(fun *Function, calls []funcCall, confidence float64) []*Relation {
+	var relations []*Relation
+	for _, call := range calls {
+		relation := &Relation{
+			Type:       Call,
+			SourceID:   fun.ID,
+			TargetID:   call.callee.ID,
+			Confidence: confidence,
+		}
+		if call.site.IsValid() {
+			site := call.site
+			relation.CallSite = &site
+		}
+		relations = append(relations, relation)
+	}
+	return relations
+}
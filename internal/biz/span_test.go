@@ -0,0 +1,150 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"testing"
+)
+
+// callSite 项目中 source 调用 target 的位置
+func callSite(project *Project, source, target string) *Span {
+	for _, r := range project.Relations {
+		if r.Type == Call && r.SourceID == source && r.TargetID == target {
+			return r.CallSite
+		}
+	}
+	return nil
+}
+
+func TestSpans(t *testing.T) {
+	for _, tt := range []struct {
+		language v1.Language
+		name     string
+		source   string
+		// 期望的位置，key 为 import、实体名、实体名.字段/方法名、函数名
+		want map[string]Span
+		// caller, callee 为函数名，call 为期望的调用位置
+		caller, callee string
+		call           Span
+	}{
+		{
+			language: v1.Language_Golang,
+			name:     "a.go",
+			source: `package p
+
+import "fmt"
+
+type T struct {
+	Name string
+}
+
+func (t *T) Hello() {
+	fmt.Println(t.Name)
+	Greet(
+		1)
+}
+
+func Greet(n int) {}
+`,
+			want: map[string]Span{
+				"fmt":     {3, 8, 3, 13},
+				"T":       {5, 6, 7, 2},
+				"T.Name":  {6, 2, 6, 13},
+				"T.Hello": {9, 1, 13, 2},
+				"Greet":   {15, 1, 15, 21},
+			},
+			caller: "T.Hello", callee: "Greet",
+			call: Span{11, 2, 12, 5},
+		},
+		{
+			language: v1.Language_Java,
+			name:     "demo/Greeter.java",
+			source: `package demo;
+
+import java.util.List;
+
+public class Greeter {
+    private String name = "x";
+
+    public void hello() {
+        greet(
+            1);
+    }
+
+    void greet(int n) {}
+}
+`,
+			want: map[string]Span{
+				"java.util.List": {3, 1, 3, 23},
+				"Greeter":        {5, 1, 14, 2},
+				"Greeter.name":   {6, 5, 6, 30},
+				"Greeter.hello":  {8, 5, 11, 6},
+				"Greeter.greet":  {13, 5, 13, 25},
+			},
+			caller: "Greeter.hello", callee: "Greeter.greet",
+			call: Span{9, 9, 10, 15},
+		},
+		{
+			language: v1.Language_Python,
+			name:     "greeter.py",
+			source: `import os
+
+
+class Greeter:
+    name = "x"
+
+    def hello(self):
+        self.greet(
+            1)
+
+    def greet(self, n):
+        pass
+
+
+def run():
+    pass
+`,
+			want: map[string]Span{
+				"os":            {1, 1, 1, 10},
+				"Greeter":       {4, 1, 12, 13},
+				"Greeter.name":  {5, 5, 5, 15},
+				"Greeter.hello": {7, 5, 9, 15},
+				"run":           {15, 1, 16, 9},
+			},
+			caller: "Greeter.hello", callee: "Greeter.greet",
+			call: Span{8, 14, 9, 15},
+		},
+	} {
+		t.Run(tt.language.String(), func(t *testing.T) {
+			project := analyzeSources(t, tt.language, map[string]string{tt.name: tt.source})
+			got := make(map[string]Span)
+			ids := make(map[string]string)
+			for _, file := range project.GetFiles() {
+				for _, imp := range file.GetImports() {
+					got[imp.Path] = imp.Span
+				}
+				for _, fun := range file.GetFunctions() {
+					got[fun.Name] = fun.Span
+					ids[fun.Name] = fun.ID
+				}
+				for _, e := range file.GetEntities() {
+					got[e.Name] = e.Span
+					for _, f := range e.GetFields() {
+						got[e.Name+"."+f.Name] = f.Span
+					}
+					for _, m := range e.GetMethods() {
+						got[e.Name+"."+m.Name] = m.Span
+						ids[e.Name+"."+m.Name] = m.ID
+					}
+				}
+			}
+			for key, want := range tt.want {
+				if got[key] != want {
+					t.Errorf("%s span = %+v, want %+v", key, got[key], want)
+				}
+			}
+			if site := callSite(project, ids[tt.caller], ids[tt.callee]); site == nil || *site != tt.call {
+				t.Errorf("call site %s -> %s = %+v, want %+v", tt.caller, tt.callee, site, tt.call)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultReferenceLimit = 200
+	// maxReferenceFiles 查找引用时最多扫描的文件数
+	maxReferenceFiles = 500
+	// maxSymbolCandidatesAt 跳转定义时最多返回的其他候选
+	maxSymbolCandidatesAt = 10
+)
+
+// SymbolLookup 按 ID、名称或所在文件查找快照中的实体、函数和字段，条件同时满足
+type SymbolLookup struct {
+	Snapshot string
+	IDs      []string
+	Names    []string
+	FileID   string
+}
+
+// FileSymbols 文件中声明的符号、记录的调用位置和导入，用于解析文件中的标识符
+type FileSymbols struct {
+	// Declarations 文件中声明的实体、函数和字段
+	Declarations []*v1.Symbol
+	Calls        []*FileCall
+	Imports      []*Import
+	// Packages 文件导入的仓库内的包
+	Packages []string
+}
+
+// FileCall 文件中的一处调用，同一函数多次调用同一函数时只记录第一处
+type FileCall struct {
+	Callee *v1.Symbol
+	Site   Span
+}
+
+// ResolveSymbolAt 解析文件中某个位置的标识符，返回它的定义
+// 依次按记录的调用位置、文件中的声明、导入的包和名称相同的符号解析，无法唯一确定时按所在文件、包和导入排序
+func (c *CodeWiki) ResolveSymbolAt(ctx context.Context, req *v1.ResolveSymbolAtReq) (*v1.ResolveSymbolAtResp, error) {
+	fileID, err := c.snapshotNodeID(ctx, req.FileId, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := c.codeRepository(ctx, req.RepoId, fileID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := cr.ReadFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	ident, ok := identAt(sourceIdents(content, cr.Language), int(req.Line), int(req.Column))
+	if !ok {
+		return &v1.ResolveSymbolAtResp{}, nil
+	}
+	resp := &v1.ResolveSymbolAtResp{Name: ident.name, Span: ident.span.proto()}
+	file, err := c.projectRepo.GetFileSymbols(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, _ := SplitNodeID(fileID)
+	candidates, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, Names: []string{ident.name}})
+	if err != nil {
+		return nil, err
+	}
+	symbols := newSymbolResolver(fileID, file, candidates).resolve(ident)
+	if len(symbols) > 0 {
+		resp.Definition = symbols[0]
+		resp.Candidates = symbols[1:min(len(symbols), maxSymbolCandidatesAt+1)]
+	}
+	return resp, nil
+}
+
+// FindReferences 查找符号在仓库中的引用
+// 扫描符号所在包、导入该包和调用该符号或其所属类型的方法的文件，名称相同且解析到该符号的标识符为引用
+func (c *CodeWiki) FindReferences(ctx context.Context, req *v1.FindReferencesReq) (*v1.FindReferencesResp, error) {
+	id, err := c.snapshotNodeID(ctx, req.Id, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, _ := SplitNodeID(id)
+	symbols, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, IDs: []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(symbols) == 0 {
+		return nil, v1.ErrorDataRecordNotFound("symbol %s not found", id)
+	}
+	def := symbols[0]
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultReferenceLimit
+	}
+	files, err := c.projectRepo.GetReferenceFiles(ctx, id, maxReferenceFiles+1)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.FindReferencesResp{Definition: def, Truncated: len(files) > maxReferenceFiles}
+	files = files[:min(len(files), maxReferenceFiles)]
+	candidates, err := c.projectRepo.LookupSymbols(ctx, &SymbolLookup{Snapshot: snapshot, Names: []string{def.Name}})
+	if err != nil {
+		return nil, err
+	}
+	cr, err := c.codeRepository(ctx, req.RepoId, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileID := range files {
+		content, err := cr.ReadFile(ctx, fileID)
+		if err != nil {
+			// 工作目录中的文件可能在分析后被删除
+			continue
+		}
+		var resolver *symbolResolver
+		declared := false
+		for _, ident := range sourceIdents(content, cr.Language) {
+			if ident.name != def.Name {
+				continue
+			}
+			if resolver == nil {
+				file, err := c.projectRepo.GetFileSymbols(ctx, fileID)
+				if err != nil {
+					return nil, err
+				}
+				resolver = newSymbolResolver(fileID, file, candidates)
+			}
+			resolved := resolver.resolve(ident)
+			if len(resolved) == 0 || resolved[0].Id != def.Id {
+				continue
+			}
+			kind := referenceKind(def, ident)
+			// 声明范围内第一次出现的名称是声明本身
+			if !declared && fileID == def.FileId && !ident.member && spanContains(def.Span, ident.span) {
+				declared = true
+				if !req.IncludeDeclaration {
+					continue
+				}
+				kind = v1.ReferenceKind_RefDeclaration
+			}
+			if len(resp.References) == limit {
+				resp.Truncated = true
+				break
+			}
+			ref := &v1.Reference{FileId: fileID, Span: ident.span.proto(), Kind: kind}
+			if enclosing := resolver.enclosing(ident.span); enclosing != nil {
+				ref.EnclosingId = enclosing.Id
+			}
+			resp.References = append(resp.References, ref)
+		}
+	}
+	sort.SliceStable(resp.References, func(i, j int) bool {
+		a, b := resp.References[i], resp.References[j]
+		if a.FileId != b.FileId {
+			return a.FileId < b.FileId
+		}
+		if a.Span.StartLine != b.Span.StartLine {
+			return a.Span.StartLine < b.Span.StartLine
+		}
+		return a.Span.StartColumn < b.Span.StartColumn
+	})
+	return resp, nil
+}
+
+// codeRepository 读取节点 id 所在快照源码的仓库
+func (c *CodeWiki) codeRepository(ctx context.Context, repoId, id string) (*CodeRepository, error) {
+	repo, err := c.projectRepo.GetRepo(ctx, repoId)
+	if err != nil {
+		return nil, err
+	}
+	cr := &CodeRepository{Repo: repo, Dir: c.workspace.RepoDir(repo)}
+	if cr.Commit, err = c.fileRevision(ctx, repo, id); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// referenceKind 按定义的类型和标识符的用法区分引用
+func referenceKind(def *v1.Symbol, ident sourceIdent) v1.ReferenceKind {
+	switch def.Kind {
+	case v1.SymbolKind_SymbolEntity:
+		return v1.ReferenceKind_RefType
+	case v1.SymbolKind_SymbolField:
+		return v1.ReferenceKind_RefField
+	case v1.SymbolKind_SymbolFunction:
+		if ident.call {
+			return v1.ReferenceKind_RefCall
+		}
+	}
+	return v1.ReferenceKind_RefOther
+}
+
+// symbolResolver 解析一个文件中的标识符
+type symbolResolver struct {
+	fileID string
+	pkgID  string
+	file   *FileSymbols
+	// imports 导入的名称 -> 导入的仓库内的包及包中的类型或模块
+	imports map[string]*importTarget
+	// packages 文件导入的仓库内的包
+	packages   map[string]bool
+	candidates map[string][]*v1.Symbol
+}
+
+// importTarget 导入的仓库内的包，导入包中的类型或模块时 member 为其名称
+type importTarget struct {
+	packages map[string]bool
+	member   string
+}
+
+func newSymbolResolver(fileID string, file *FileSymbols, candidates []*v1.Symbol) *symbolResolver {
+	r := &symbolResolver{
+		fileID:     fileID,
+		pkgID:      fileID[:max(strings.LastIndex(fileID, PathSep), 0)],
+		file:       file,
+		imports:    make(map[string]*importTarget),
+		packages:   make(map[string]bool),
+		candidates: make(map[string][]*v1.Symbol),
+	}
+	for _, s := range candidates {
+		r.candidates[s.Name] = append(r.candidates[s.Name], s)
+	}
+	for _, pkg := range file.Packages {
+		r.packages[pkg] = true
+	}
+	for _, imp := range file.Imports {
+		path := importPath(imp.Path)
+		// Java 的通配导入不引入名称
+		if path == "" || strings.HasSuffix(imp.Path, ".*") {
+			continue
+		}
+		segments := strings.Split(path, "/")
+		name := imp.Name
+		if name == "" {
+			name = segments[len(segments)-1]
+		}
+		target := &importTarget{packages: make(map[string]bool)}
+		for _, pkg := range file.Packages {
+			switch dir := packageDir(pkg); {
+			case pathMatches(path, dir):
+				target.packages[pkg] = true
+			case len(segments) > 1 && pathMatches(strings.Join(segments[:len(segments)-1], "/"), dir):
+				target.packages[pkg] = true
+				target.member = segments[len(segments)-1]
+			}
+		}
+		// 仓库外的导入也记录，限定名称是它时不在仓库中查找
+		r.imports[name] = target
+	}
+	return r
+}
+
+// resolve 标识符可能的定义，按可能性从高到低排序，第一个为定义
+func (r *symbolResolver) resolve(ident sourceIdent) []*v1.Symbol {
+	for _, call := range r.file.Calls {
+		if call.Callee.Name == ident.name && call.Site.StartLine == ident.span.StartLine && call.Site.StartColumn == ident.span.StartColumn {
+			return []*v1.Symbol{call.Callee}
+		}
+	}
+	if !ident.member {
+		if decl := r.innermost(ident.span, func(s *v1.Symbol) bool { return s.Name == ident.name }); decl != nil {
+			return []*v1.Symbol{decl}
+		}
+	}
+	var target *importTarget
+	if len(ident.qualifier) > 0 {
+		target = r.imports[ident.qualifier[0]]
+	}
+	type scored struct {
+		symbol *v1.Symbol
+		score  int
+	}
+	var ranked []scored
+	for _, s := range r.candidates[ident.name] {
+		pkg := symbolPackage(s)
+		member := isMemberSymbol(s)
+		score := 0
+		switch {
+		case target != nil:
+			// 限定名称是导入的包或包中的类型
+			if !target.packages[pkg] {
+				continue
+			}
+			score += 16
+			if target.member != "" && (strings.Contains(s.Id, ":"+target.member+".") || strings.Contains(s.Id, PathSep+target.member+".")) {
+				score += 4
+			} else if len(ident.qualifier) == 1 && member {
+				score -= 4
+			}
+		case ident.member:
+			// x.name 通常是字段或方法
+			if !member {
+				score -= 8
+			}
+		case member:
+			score -= 4
+		}
+		switch {
+		case s.FileId == r.fileID:
+			score += 8
+		case pkg == r.pkgID:
+			score += 4
+		case r.packages[pkg]:
+			score += 2
+		}
+		ranked = append(ranked, scored{symbol: s, score: score})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].symbol.Id < ranked[j].symbol.Id
+	})
+	symbols := make([]*v1.Symbol, 0, len(ranked))
+	for _, s := range ranked {
+		symbols = append(symbols, s.symbol)
+	}
+	return symbols
+}
+
+// enclosing 包含位置的最内层函数，不在函数中时为最内层的实体
+func (r *symbolResolver) enclosing(span Span) *v1.Symbol {
+	if fun := r.innermost(span, func(s *v1.Symbol) bool { return s.Kind == v1.SymbolKind_SymbolFunction }); fun != nil {
+		return fun
+	}
+	return r.innermost(span, func(s *v1.Symbol) bool { return s.Kind == v1.SymbolKind_SymbolEntity })
+}
+
+// innermost 文件中满足 match 且声明范围包含 span 的最内层声明
+func (r *symbolResolver) innermost(span Span, match func(s *v1.Symbol) bool) *v1.Symbol {
+	var found *v1.Symbol
+	for _, s := range r.file.Declarations {
+		if !match(s) || !spanContains(s.Span, span) {
+			continue
+		}
+		if found == nil || spanContains(found.Span, fromProtoSpan(s.Span)) {
+			found = s
+		}
+	}
+	return found
+}
+
+// importPath 把导入路径统一为 / 分隔，去掉 Go 导入路径的引号和 Python 相对导入的前缀
+func importPath(path string) string {
+	path = strings.Trim(path, "\"`")
+	path = strings.TrimSuffix(path, ".*")
+	if !strings.Contains(path, "/") {
+		path = strings.ReplaceAll(strings.TrimLeft(path, "."), ".", "/")
+	}
+	return strings.Trim(path, "/")
+}
+
+// packageDir 包相对于仓库根目录的路径
+func packageDir(pkgID string) string {
+	_, path := SplitNodeID(pkgID)
+	_, dir, _ := strings.Cut(path, PathSep)
+	return strings.ReplaceAll(dir, PathSep, "/")
+}
+
+// pathMatches 导入路径与包的路径一个是另一个的后缀
+func pathMatches(path, dir string) bool {
+	if path == "" || dir == "" {
+		return path == dir
+	}
+	return path == dir || strings.HasSuffix(path, "/"+dir) || strings.HasSuffix(dir, "/"+path)
+}
+
+// symbolPackage 符号所在的包
+func symbolPackage(s *v1.Symbol) string {
+	return s.FileId[:max(strings.LastIndex(s.FileId, PathSep), 0)]
+}
+
+// isMemberSymbol 是否为字段或方法
+func isMemberSymbol(s *v1.Symbol) bool {
+	switch s.Kind {
+	case v1.SymbolKind_SymbolField:
+		return true
+	case v1.SymbolKind_SymbolFunction:
+		return strings.Contains(s.Id[strings.LastIndex(s.Id, ":")+1:], ".")
+	}
+	return false
+}
+
+// spanContains 声明范围是否包含 span 的起始位置
+func spanContains(s *v1.SourceSpan, span Span) bool {
+	if s == nil || s.StartLine <= 0 {
+		return false
+	}
+	line, column := int32(span.StartLine), int32(span.StartColumn)
+	if line < s.StartLine || line == s.StartLine && column < s.StartColumn {
+		return false
+	}
+	return line < s.EndLine || line == s.EndLine && column < s.EndColumn
+}
+
+func (s Span) proto() *v1.SourceSpan {
+	return &v1.SourceSpan{StartLine: int32(s.StartLine), StartColumn: int32(s.StartColumn), EndLine: int32(s.EndLine), EndColumn: int32(s.EndColumn)}
+}
+
+func fromProtoSpan(s *v1.SourceSpan) Span {
+	return Span{StartLine: int(s.StartLine), StartColumn: int(s.StartColumn), EndLine: int(s.EndLine), EndColumn: int(s.EndColumn)}
+}
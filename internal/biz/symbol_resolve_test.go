@@ -0,0 +1,241 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// resolveRepo 由分析结果提供符号、调用位置和导入的仓储
+type resolveRepo struct {
+	discardProjectRepo
+	repo    *v1.Repo
+	project *Project
+	symbols []*v1.Symbol
+}
+
+func newResolveRepo(t *testing.T, files map[string]string) *resolveRepo {
+	t.Helper()
+	root := writeSources(t, files)
+	repo := &v1.Repo{Id: "repo", Path: root, Language: v1.Language_Golang}
+	project := NewProject(repo, nil)
+	if err := project.Analyze(context.Background(), root, discardProjectRepo{}); err != nil {
+		t.Fatal(err)
+	}
+	r := &resolveRepo{repo: repo, project: project}
+	for _, file := range project.GetFiles() {
+		for _, fun := range file.GetFunctions() {
+			r.symbols = append(r.symbols, &v1.Symbol{Id: fun.ID, Name: fun.Name, Kind: v1.SymbolKind_SymbolFunction, FileId: file.ID, Span: fun.Span.proto(), Exported: fun.Exported, Entrypoint: fun.Entrypoint(), Test: file.Test})
+		}
+		for _, e := range file.GetEntities() {
+			r.symbols = append(r.symbols, &v1.Symbol{Id: e.ID, Name: e.Name, Kind: v1.SymbolKind_SymbolEntity, FileId: file.ID, Span: e.Span.proto(), Exported: e.Exported, Test: file.Test})
+			for _, m := range e.GetMethods() {
+				r.symbols = append(r.symbols, &v1.Symbol{Id: m.ID, Name: m.Name, Kind: v1.SymbolKind_SymbolFunction, FileId: file.ID, Span: m.Span.proto(), Exported: m.Exported, Test: file.Test})
+			}
+			for _, f := range e.GetFields() {
+				r.symbols = append(r.symbols, &v1.Symbol{Id: e.ID + "_" + f.Name, Name: f.Name, Kind: v1.SymbolKind_SymbolField, ParentId: e.ID, FileId: file.ID, Span: f.Span.proto()})
+			}
+		}
+	}
+	return r
+}
+
+func (r *resolveRepo) GetRepo(ctx context.Context, id string) (*v1.Repo, error) {
+	return r.repo, nil
+}
+
+func (r *resolveRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	return []*v1.Snapshot{{Id: "repo"}}, nil
+}
+
+func (r *resolveRepo) LookupSymbols(ctx context.Context, lookup *SymbolLookup) ([]*v1.Symbol, error) {
+	var symbols []*v1.Symbol
+	for _, s := range r.symbols {
+		if len(lookup.IDs) > 0 && !slices.Contains(lookup.IDs, s.Id) || len(lookup.Names) > 0 && s.Name != lookup.Names[0] || lookup.FileID != "" && s.FileId != lookup.FileID {
+			continue
+		}
+		symbols = append(symbols, s)
+	}
+	return symbols, nil
+}
+
+func (r *resolveRepo) GetFileSymbols(ctx context.Context, fileID string) (*FileSymbols, error) {
+	file := &FileSymbols{}
+	file.Declarations, _ = r.LookupSymbols(ctx, &SymbolLookup{FileID: fileID})
+	for _, rel := range r.project.Relations {
+		switch {
+		case rel.Type == Call && rel.CallSite != nil && strings.HasPrefix(rel.SourceID, r.packageOf(fileID)):
+			callee, _ := r.LookupSymbols(ctx, &SymbolLookup{IDs: []string{rel.TargetID}})
+			file.Calls = append(file.Calls, &FileCall{Callee: callee[0], Site: *rel.CallSite})
+		case rel.Type == Imports && rel.SourceID == fileID:
+			file.Packages = append(file.Packages, rel.TargetID)
+		}
+	}
+	for _, f := range r.project.GetFiles() {
+		if f.ID == fileID {
+			file.Imports = f.GetImports()
+		}
+	}
+	return file, nil
+}
+
+func (r *resolveRepo) packageOf(fileID string) string {
+	return fileID[:strings.LastIndex(fileID, PathSep)] + ":"
+}
+
+func (r *resolveRepo) GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error) {
+	var files []string
+	for _, f := range r.project.GetFiles() {
+		files = append(files, f.ID)
+	}
+	return files, nil
+}
+
+func TestResolveSymbolAt(t *testing.T) {
+	repo := newResolveRepo(t, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store/store.go": `package store
+
+// Store 保存名称
+type Store struct {
+	Name string
+}
+
+func (s *Store) Save(name string) {
+	s.Name = name
+}
+
+func New() *Store {
+	return &Store{}
+}
+`,
+		"main.go": `package main
+
+import (
+	"fmt"
+
+	"example.com/app/store"
+)
+
+func main() {
+	s := store.New()
+	s.Save("a")
+	s.Save("b") // Save 的注释
+	fmt.Println(s.Name, "Save")
+	run(s)
+}
+
+func run(s *store.Store) {
+	s.Save("c")
+}
+`,
+	})
+	c := newTestCodeWiki(t, repo)
+	root := repo.project.Root.ID
+	mainFile, storeFile := root+"@main.go", root+"@store@store.go"
+	storeType := storeFile + ":Store"
+	save := root + "@store:Store.Save"
+	resolve := func(line, column int32) *v1.ResolveSymbolAtResp {
+		t.Helper()
+		resp, err := c.ResolveSymbolAt(context.Background(), &v1.ResolveSymbolAtReq{RepoId: "repo", FileId: mainFile, Line: line, Column: column})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	for _, tt := range []struct {
+		line, column int32
+		name, want   string
+	}{
+		{10, 13, "New", root + "@store:New"},
+		{11, 4, "Save", save},
+		{13, 17, "Name", storeType + "_Name"},
+		{13, 7, "Println", ""},
+		{17, 19, "Store", storeType},
+		{17, 6, "run", root + ":run"},
+		{12, 20, "", ""},
+	} {
+		resp := resolve(tt.line, tt.column)
+		var got string
+		if resp.Definition != nil {
+			got = resp.Definition.Id
+		}
+		if resp.Name != tt.name || got != tt.want {
+			t.Errorf("%d:%d = %s %s, want %s %s", tt.line, tt.column, resp.Name, got, tt.name, tt.want)
+		}
+	}
+	if resp := resolve(10, 13); resp.Span.StartColumn != 13 || resp.Span.EndColumn != 16 || resp.Definition.FileId != storeFile {
+		t.Errorf("span = %v, definition = %v", resp.Span, resp.Definition)
+	}
+
+	// 注释和字符串中的名称不是引用
+	refs, err := c.FindReferences(context.Background(), &v1.FindReferencesReq{RepoId: "repo", Id: save, IncludeDeclaration: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ref := range refs.References {
+		got = append(got, ref.FileId[len(root):]+" "+ref.Kind.String()+" "+ref.EnclosingId[len(root):])
+	}
+	want := []string{
+		"@main.go RefCall :main",
+		"@main.go RefCall :main",
+		"@main.go RefCall :run",
+		"@store@store.go RefDeclaration @store:Store.Save",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("references = %v, want %v", got, want)
+	}
+	if refs.References[0].Span.StartLine != 11 || refs.References[2].Span.StartLine != 18 {
+		t.Errorf("references = %v", refs.References)
+	}
+
+	refs, err = c.FindReferences(context.Background(), &v1.FindReferencesReq{RepoId: "repo", Id: storeType + "_Name", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs.References) != 1 || !refs.Truncated || refs.References[0].Kind != v1.ReferenceKind_RefField {
+		t.Errorf("field references = %v, truncated = %v", refs.References, refs.Truncated)
+	}
+}
+
+func TestScanIdents(t *testing.T) {
+	format := func(idents []sourceIdent) []string {
+		var got []string
+		for _, ident := range idents {
+			s := strings.Join(append(ident.qualifier, ident.name), ".")
+			if ident.member {
+				s = "." + s
+			}
+			if ident.call {
+				s += "()"
+			}
+			got = append(got, s)
+		}
+		return got
+	}
+	idents := sourceIdents("package p\n\nfunc f() {\n\ta.b.c(x) // d\n\ty := `e` + \"f\" + 1.5\n\t(g).h()\n}\n", v1.Language_Golang)
+	if got, want := format(idents), []string{"p", "f", "a", ".a.b", ".a.b.c()", "x", "y", "g", ".h()"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("go idents = %v, want %v", got, want)
+	}
+	if span := idents[4].span; span != (Span{4, 6, 4, 7}) {
+		t.Errorf("span = %+v", span)
+	}
+
+	idents = sourceIdents("a.b.c(x) // d\nString y = \"f\" + 'g';\n(h).i", v1.Language_Java)
+	if got, want := format(idents), []string{"a", ".a.b", ".a.b.c()", "x", "String", "y", "h", ".i"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("java idents = %v, want %v", got, want)
+	}
+
+	idents = sourceIdents("# a\nb = f'c' + '''d\ne''' + self.g\n", v1.Language_Python)
+	var got []string
+	for _, ident := range idents {
+		got = append(got, strings.Join(append(ident.qualifier, ident.name), "."))
+	}
+	if want := []string{"b", "self", "self.g"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("python idents = %v, want %v", got, want)
+	}
+}
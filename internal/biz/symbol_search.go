@@ -0,0 +1,243 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultSymbolPageSize = 20
+	// maxSymbolCandidates 每次搜索最多从图数据库取出的候选符号数
+	maxSymbolCandidates = 1000
+	// fanInWeight 被引用次数在排序中的权重，取对数后只在匹配度相近时起作用
+	fanInWeight = 0.02
+	// maxFuzzyEdits 模糊匹配允许的最大编辑距离
+	maxFuzzyEdits = 2
+	// wordPrefixQuality 名称中的单词以 query 开头时的匹配度，更高的匹配度都是前缀匹配
+	wordPrefixQuality = 0.7
+)
+
+// symbolReferenceTypes 计入 fanIn 的关系，不包括包含和声明关系
+var symbolReferenceTypes = []string{Call, DispatchesTo, Implement, Extends, Imports}
+
+// SymbolQuery 符号候选的查询条件
+type SymbolQuery struct {
+	// Snapshot 快照 ID，只搜索该快照中的节点
+	Snapshot string
+	// Package 包 ID，不为空时只搜索该包及其子包
+	Package      string
+	Query        string
+	Mode         v1.SymbolMatchMode
+	Kinds        []v1.SymbolKind
+	ExportedOnly bool
+	// ExcludeTests 不返回测试文件以及其中声明的符号
+	ExcludeTests bool
+	// ReferenceTypes 计入 fanIn 的关系类型
+	ReferenceTypes []string
+	Limit          int
+}
+
+// SearchSymbols 按名称搜索快照中的符号，按匹配度和被引用次数排序后分页
+func (c *CodeWiki) SearchSymbols(ctx context.Context, req *v1.SearchSymbolsReq) (*v1.SearchSymbolsResp, error) {
+	var re *regexp.Regexp
+	if req.Mode == v1.SymbolMatchMode_MatchRegex {
+		var err error
+		if re, err = regexp.Compile(req.Query); err != nil {
+			return nil, v1.ErrorParamValidate("invalid regex %s: %v", req.Query, err)
+		}
+	}
+	offset := 0
+	if req.PageToken != "" {
+		n, err := strconv.Atoi(req.PageToken)
+		if err != nil || n < 0 {
+			return nil, v1.ErrorParamValidate("invalid page token %s", req.PageToken)
+		}
+		offset = n
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultSymbolPageSize
+	}
+	s, err := c.resolveSnapshot(ctx, req.Id, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return &v1.SearchSymbolsResp{}, nil
+	}
+	query := &SymbolQuery{
+		Snapshot:       s.Id,
+		Query:          req.Query,
+		Mode:           req.Mode,
+		Kinds:          req.Kinds,
+		ExportedOnly:   req.ExportedOnly,
+		ExcludeTests:   req.ExcludeTests,
+		ReferenceTypes: symbolReferenceTypes,
+		Limit:          maxSymbolCandidates,
+	}
+	if req.Package != "" {
+		if query.Package, err = c.snapshotNodeID(ctx, req.Package, s.Id); err != nil {
+			return nil, err
+		}
+	}
+	candidates, err := c.projectRepo.SearchSymbols(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	symbols := rankSymbols(candidates, req.Query, req.Mode, re)
+	resp := &v1.SearchSymbolsResp{Total: int32(len(symbols)), Truncated: len(candidates) >= query.Limit}
+	if offset < len(symbols) {
+		end := min(offset+pageSize, len(symbols))
+		resp.Symbols = symbols[offset:end]
+		if end < len(symbols) {
+			resp.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return resp, nil
+}
+
+// rankSymbols 计算候选的得分并排序，去掉名称实际不匹配的候选
+// 得分相同时名称短的在前，再按 ID 排序保证分页稳定
+func rankSymbols(candidates []*v1.Symbol, query string, mode v1.SymbolMatchMode, re *regexp.Regexp) []*v1.Symbol {
+	var symbols []*v1.Symbol
+	for _, s := range candidates {
+		quality := SymbolMatchQuality(s.Name, query, mode, re)
+		if quality == 0 {
+			continue
+		}
+		s.Score = quality + fanInWeight*math.Log1p(float64(s.FanIn))
+		symbols = append(symbols, s)
+	}
+	sort.SliceStable(symbols, func(i, j int) bool {
+		a, b := symbols[i], symbols[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		return a.Id < b.Id
+	})
+	return symbols
+}
+
+// SymbolMatchQuality 名称与 query 的匹配度，re 不为空时按正则匹配，不匹配为 0
+// 前缀匹配只保留名称或其中单词以 query 开头的候选
+func SymbolMatchQuality(name, query string, mode v1.SymbolMatchMode, re *regexp.Regexp) float64 {
+	if re != nil {
+		return regexQuality(name, re)
+	}
+	quality := matchQuality(name, query)
+	if mode == v1.SymbolMatchMode_MatchPrefix && quality < wordPrefixQuality {
+		return 0
+	}
+	return quality
+}
+
+// matchQuality 名称与 query 的匹配度，完全相同为 1，不匹配为 0
+func matchQuality(name, query string) float64 {
+	lname, lquery := strings.ToLower(name), strings.ToLower(query)
+	switch {
+	case name == query:
+		return 1
+	case lname == lquery:
+		return 0.95
+	case strings.HasPrefix(name, query):
+		return 0.85
+	case strings.HasPrefix(lname, lquery):
+		return 0.8
+	case wordPrefix(name, lquery):
+		return wordPrefixQuality
+	case strings.Contains(lname, lquery):
+		return 0.6
+	}
+	// 拼写错误：与整个名称或等长前缀的编辑距离
+	nr, qr := []rune(lname), []rune(lquery)
+	d := editDistance(nr, qr)
+	if len(nr) > len(qr) {
+		d = min(d, editDistance(nr[:len(qr)], qr))
+	}
+	if d <= maxFuzzyEdits && d < len(qr) {
+		return 0.5 - 0.1*float64(d)
+	}
+	return 0
+}
+
+// wordPrefix 名称中的某个单词以 lquery 开头，单词按驼峰和 _ . - / 分隔
+func wordPrefix(name, lquery string) bool {
+	runes := []rune(name)
+	for _, i := range wordStarts(runes) {
+		if i > 0 && strings.HasPrefix(strings.ToLower(string(runes[i:])), lquery) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordStarts 名称中每个单词的起始位置，第一个总是 0
+func wordStarts(runes []rune) []int {
+	starts := []int{0}
+	for i := 1; i < len(runes); i++ {
+		prev, r := runes[i-1], runes[i]
+		if unicode.IsUpper(r) && !unicode.IsUpper(prev) || strings.ContainsRune("_.-/", prev) {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+// SymbolWords 名称的索引词：小写的整个名称和从各单词开始的后缀，以空格分隔
+// 前缀查询这些词即可找到 wordPrefix 能匹配的名称，如 GetUserById 为 getuserbyid userbyid byid id
+func SymbolWords(name string) string {
+	runes := []rune(name)
+	words := []string{strings.ToLower(name)}
+	for _, i := range wordStarts(runes)[1:] {
+		if word := strings.Trim(strings.ToLower(string(runes[i:])), "_.-/"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// regexQuality 正则匹配名称的部分越长匹配度越高，匹配整个名称为 1
+func regexQuality(name string, re *regexp.Regexp) float64 {
+	loc := re.FindStringIndex(name)
+	if loc == nil || name == "" {
+		return 0
+	}
+	if loc[0] == 0 && loc[1] == len(name) {
+		return 1
+	}
+	quality := 0.5 + 0.4*float64(loc[1]-loc[0])/float64(len(name))
+	if loc[0] == 0 {
+		quality += 0.05
+	}
+	return quality
+}
+
+// editDistance 两个字符串的 Levenshtein 距离
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,161 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// symbolRepo 返回固定候选的符号仓储，记录最后一次查询条件
+type symbolRepo struct {
+	discardProjectRepo
+	symbols []*v1.Symbol
+	query   *SymbolQuery
+}
+
+func (r *symbolRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	return []*v1.Snapshot{{Id: "repo~new", Commit: "bbbbbbbb"}, {Id: "repo~old", Commit: "aaaaaaaa"}}, nil
+}
+
+func (r *symbolRepo) SearchSymbols(ctx context.Context, query *SymbolQuery) ([]*v1.Symbol, error) {
+	r.query = query
+	symbols := make([]*v1.Symbol, 0, len(r.symbols))
+	for _, s := range r.symbols {
+		symbols = append(symbols, proto.Clone(s).(*v1.Symbol))
+	}
+	return symbols, nil
+}
+
+func TestSearchSymbols(t *testing.T) {
+	repo := &symbolRepo{symbols: []*v1.Symbol{
+		{Id: "repo~new@p:getRepo", Name: "getRepo", Kind: v1.SymbolKind_SymbolFunction},
+		{Id: "repo~new@p:GetRepoTree", Name: "GetRepoTree", Kind: v1.SymbolKind_SymbolFunction, FanIn: 3},
+		{Id: "repo~new@p:GetRepo", Name: "GetRepo", Kind: v1.SymbolKind_SymbolFunction, FanIn: 1},
+		{Id: "repo~new@p:ListRepos", Name: "ListRepos", Kind: v1.SymbolKind_SymbolFunction},
+		{Id: "repo~new@p@repo.go", Name: "repo.go", Kind: v1.SymbolKind_SymbolFile},
+		{Id: "repo~new@p:GetRpo", Name: "GetRpo", Kind: v1.SymbolKind_SymbolFunction},
+		{Id: "repo~new@p:Close", Name: "Close", Kind: v1.SymbolKind_SymbolFunction, FanIn: 100},
+	}}
+	c := newTestCodeWiki(t, repo)
+	search := func(req *v1.SearchSymbolsReq) (*v1.SearchSymbolsResp, []string) {
+		t.Helper()
+		req.Id = "repo"
+		resp, err := c.SearchSymbols(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, s := range resp.Symbols {
+			names = append(names, s.Name)
+		}
+		return resp, names
+	}
+
+	// 完全相同优先，其次区分大小写的前缀、单词前缀；拼写错误和不匹配的候选被去掉
+	resp, names := search(&v1.SearchSymbolsReq{Query: "GetRepo"})
+	if want := []string{"GetRepo", "getRepo", "GetRepoTree"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("prefix = %v, want %v", names, want)
+	}
+	if repo.query.Snapshot != "repo~new" || repo.query.Limit != maxSymbolCandidates || resp.NextPageToken != "" {
+		t.Errorf("query = %+v, resp = %+v", repo.query, resp)
+	}
+	if _, names = search(&v1.SearchSymbolsReq{Query: "repo"}); !reflect.DeepEqual(names, []string{"repo.go", "GetRepoTree", "GetRepo", "getRepo", "ListRepos"}) {
+		t.Errorf("word prefix = %v", names)
+	}
+
+	// 模糊匹配保留拼写错误
+	if _, names = search(&v1.SearchSymbolsReq{Query: "GetRepo", Mode: v1.SymbolMatchMode_MatchFuzzy}); !reflect.DeepEqual(names, []string{"GetRepo", "getRepo", "GetRepoTree", "GetRpo"}) {
+		t.Errorf("fuzzy = %v", names)
+	}
+
+	// 匹配度相同时被引用次数多的在前
+	repo.symbols = append(repo.symbols, &v1.Symbol{Id: "repo~new@q:GetRepo", Name: "GetRepo", FanIn: 5})
+	if resp, _ = search(&v1.SearchSymbolsReq{Query: "GetRepo"}); resp.Symbols[0].Id != "repo~new@q:GetRepo" || resp.Symbols[0].Score <= resp.Symbols[1].Score {
+		t.Errorf("fan-in ranking = %v", resp.Symbols)
+	}
+
+	// 正则匹配整个名称的得分最高
+	if _, names = search(&v1.SearchSymbolsReq{Query: "^Get.*o$", Mode: v1.SymbolMatchMode_MatchRegex}); !reflect.DeepEqual(names, []string{"GetRepo", "GetRepo", "GetRpo"}) {
+		t.Errorf("regex = %v", names)
+	}
+	if _, err := c.SearchSymbols(context.Background(), &v1.SearchSymbolsReq{Id: "repo", Query: "(", Mode: v1.SymbolMatchMode_MatchRegex}); err == nil {
+		t.Errorf("expected error for invalid regex")
+	}
+
+	// 分页
+	var pages [][]string
+	req := &v1.SearchSymbolsReq{Query: "Re", Mode: v1.SymbolMatchMode_MatchRegex, PageSize: 3}
+	for {
+		resp, names = search(req)
+		pages = append(pages, names)
+		if resp.Total != 5 {
+			t.Errorf("total = %d", resp.Total)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(pages) != 2 || len(pages[0]) != 3 || len(pages[1]) != 2 {
+		t.Errorf("pages = %v", pages)
+	}
+
+	// 包 ID 换到搜索的快照中
+	search(&v1.SearchSymbolsReq{Query: "x", Package: "repo~old@p", Snapshot: "bbbb", Kinds: []v1.SymbolKind{v1.SymbolKind_SymbolEntity}})
+	if repo.query.Package != "repo~new@p" || !reflect.DeepEqual(repo.query.Kinds, []v1.SymbolKind{v1.SymbolKind_SymbolEntity}) {
+		t.Errorf("query = %+v", repo.query)
+	}
+}
+
+func TestMatchQuality(t *testing.T) {
+	for _, tt := range []struct {
+		name, query string
+		want        float64
+	}{
+		{"Save", "Save", 1},
+		{"save", "Save", 0.95},
+		{"SaveProject", "Save", 0.85},
+		{"saveProject", "Save", 0.8},
+		{"batchSaveFile", "save", wordPrefixQuality},
+		{"batch_save", "save", wordPrefixQuality},
+		{"autosave", "save", 0.6},
+		{"Svae", "save", 0.3},
+		{"Load", "save", 0},
+	} {
+		if got := matchQuality(tt.name, tt.query); got != tt.want {
+			t.Errorf("matchQuality(%q, %q) = %v, want %v", tt.name, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSymbolWords(t *testing.T) {
+	for name, want := range map[string]string{
+		"GetUserById":   "getuserbyid userbyid byid id",
+		"batch_save":    "batch_save save",
+		"HTTPServer":    "httpserver",
+		"store.go":      "store.go go",
+		"_":             "_",
+		"parseAPIInput": "parseapiinput apiinput",
+	} {
+		if got := SymbolWords(name); got != want {
+			t.Errorf("SymbolWords(%q) = %q, want %q", name, got, want)
+		}
+	}
+	// 前缀匹配能找到的名称，总有一个索引词以 query 开头
+	for _, tt := range [][2]string{{"GetUserById", "user"}, {"GetUserById", "userby"}, {"batchSaveFile", "save"}, {"SaveProject", "Save"}} {
+		if SymbolMatchQuality(tt[0], tt[1], v1.SymbolMatchMode_MatchPrefix, nil) == 0 {
+			t.Fatalf("%s does not match %s", tt[0], tt[1])
+		}
+		found := false
+		for _, word := range strings.Fields(SymbolWords(tt[0])) {
+			found = found || strings.HasPrefix(word, strings.ToLower(tt[1]))
+		}
+		if !found {
+			t.Errorf("no word of %s starts with %s", tt[0], tt[1])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"sort"
+)
+
+// maxTestDepth 测试函数沿调用关系关联非测试代码的最大层数
+const maxTestDepth = 10
+
+// TestLink 测试函数 Test 经过 Depth 层调用到达非测试代码中的函数 Target
+type TestLink struct {
+	Test   string
+	Target string
+	Depth  int
+}
+
+// LinkTests 从每个测试函数出发沿调用和分派关系广度优先查找非测试代码中的函数
+// testCode 为测试文件中声明的函数，只作为中转，不作为 Tests 关系的目标
+// 结果按测试函数、层数、目标排序
+func LinkTests(calls []*Relation, tests []string, testCode map[string]bool) []*TestLink {
+	callees := make(map[string][]string)
+	for _, rel := range calls {
+		if callRelationTypes[rel.Type] {
+			callees[rel.SourceID] = append(callees[rel.SourceID], rel.TargetID)
+		}
+	}
+	var links []*TestLink
+	for _, test := range tests {
+		visited := map[string]bool{test: true}
+		level := []string{test}
+		for depth := 1; depth <= maxTestDepth && len(level) > 0; depth++ {
+			var next []string
+			for _, id := range level {
+				for _, callee := range callees[id] {
+					if visited[callee] {
+						continue
+					}
+					visited[callee] = true
+					next = append(next, callee)
+					if !testCode[callee] {
+						links = append(links, &TestLink{Test: test, Target: callee, Depth: depth})
+					}
+				}
+			}
+			level = next
+		}
+	}
+	sort.Slice(links, func(i, j int) bool {
+		a, b := links[i], links[j]
+		if a.Test != b.Test {
+			return a.Test < b.Test
+		}
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		return a.Target < b.Target
+	})
+	return links
+}
+
+// GetFunctionTests 通过 Tests 关系直接或间接调用函数的测试函数
+func (c *CodeWiki) GetFunctionTests(ctx context.Context, req *v1.GetFunctionTestsReq) (*v1.GetFunctionTestsResp, error) {
+	id, err := c.snapshotNodeID(ctx, req.Id, req.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	tests, err := c.projectRepo.QueryFunctionTests(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, _ := SplitNodeID(id)
+	return &v1.GetFunctionTestsResp{Snapshot: snapshot, Tests: tests}, nil
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// projectFunctions 项目中所有文件声明的函数和方法
+func projectFunctions(project *Project) []*Function {
+	var functions []*Function
+	for _, file := range project.GetFiles() {
+		functions = append(functions, file.GetFunctions()...)
+		for _, e := range file.GetEntities() {
+			functions = append(functions, e.GetMethods()...)
+		}
+	}
+	return functions
+}
+
+func TestTestEntrypoint(t *testing.T) {
+	for _, tt := range []struct {
+		language v1.Language
+		files    map[string]string
+		want     []string
+	}{
+		{v1.Language_Golang, map[string]string{
+			"go.mod":   "module example.com/app\n\ngo 1.22\n",
+			"store.go": "package app\n\nfunc Save() {}\n\nfunc TestLike() {}\n",
+			"store_test.go": `package app
+
+import "testing"
+
+type fixture struct{}
+
+func (f fixture) TestMethod(t *testing.T) {}
+
+func TestSave(t *testing.T) { Save() }
+
+func BenchmarkSave(b *testing.B) {}
+
+func Example() {}
+
+func Testing() {}
+
+func helper() {}
+`,
+		}, []string{"BenchmarkSave test", "Example test", "Save ", "TestLike ", "TestMethod -", "TestSave test", "Testing -", "helper -"}},
+		{v1.Language_Java, map[string]string{
+			"src/main/java/app/Store.java": "package app;\n\npublic class Store {\n    public void testConnection() {}\n}\n",
+			"src/test/java/app/StoreTest.java": `package app;
+
+import org.junit.jupiter.api.Test;
+
+public class StoreTest {
+    @BeforeEach
+    public void setUp() {}
+
+    @org.junit.jupiter.api.Test
+    void saves() {}
+
+    @ParameterizedTest
+    @ValueSource(strings = {"a"})
+    void savesAll(String name) {}
+
+    public void testLegacy() {}
+}
+`,
+		}, []string{"saves test", "savesAll test", "setUp -", "testConnection ", "testLegacy test"}},
+		{v1.Language_Python, map[string]string{
+			"app/store.py": "def test_connection():\n    pass\n",
+			"tests/test_store.py": `from app.store import test_connection
+
+
+def test_save():
+    test_connection()
+
+
+def helper():
+    pass
+
+
+class TestStore:
+    def test_load(self):
+        pass
+`,
+		}, []string{"helper -", "test_connection ", "test_load test", "test_save test"}},
+	} {
+		project := analyzeSources(t, tt.language, tt.files)
+		var got []string
+		for _, f := range projectFunctions(project) {
+			entrypoint := f.Entrypoint()
+			if f.InTestFile() && entrypoint == "" {
+				entrypoint = "-"
+			}
+			got = append(got, f.Name+" "+entrypoint)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s functions = %v, want %v", tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestLinkTests(t *testing.T) {
+	project := analyzeSources(t, v1.Language_Golang, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store/store.go": `package store
+
+type Store struct{}
+
+func (s *Store) Save(name string) { validate(name) }
+
+func validate(name string) {}
+
+func New() *Store { return &Store{} }
+`,
+		"store/store_test.go": `package store
+
+import "testing"
+
+func TestSave(t *testing.T) {
+	newFixture().Save("a")
+}
+
+func newFixture() *Store { return New() }
+
+func TestNothing(t *testing.T) {}
+`,
+	})
+	var tests []string
+	testCode := make(map[string]bool)
+	for _, f := range projectFunctions(project) {
+		if f.Entrypoint() == EntrypointTest {
+			tests = append(tests, f.ID)
+		}
+		testCode[f.ID] = f.InTestFile()
+	}
+	sort.Strings(tests)
+	var got []string
+	for _, link := range LinkTests(project.Relations, tests, testCode) {
+		got = append(got, fmt.Sprintf("%s -> %s %d", strings.TrimPrefix(link.Test, project.Root.ID), strings.TrimPrefix(link.Target, project.Root.ID), link.Depth))
+	}
+	// 经过测试辅助函数 newFixture 到达 New，newFixture 本身不是目标
+	want := []string{
+		"@store:TestSave -> @store:Store.Save 1",
+		"@store:TestSave -> @store:New 2",
+		"@store:TestSave -> @store:validate 2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("links = %v, want %v", got, want)
+	}
+
+	// 测试函数经过接口分派到达实现
+	calls := []*Relation{
+		{Type: Call, SourceID: "TestRun", TargetID: "Runner.Run"},
+		{Type: DispatchesTo, SourceID: "Runner.Run", TargetID: "impl.Run"},
+		{Type: Contains, SourceID: "impl.Run", TargetID: "other"},
+	}
+	links := LinkTests(calls, []string{"TestRun"}, map[string]bool{"TestRun": true})
+	if len(links) != 2 || links[1].Target != "impl.Run" || links[1].Depth != 2 {
+		t.Errorf("dispatch links = %+v", links)
+	}
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// TypeResolver 基于 go/types 的调用解析器，按文件提供类型信息，类型检查失败的文件不参与解析
+type TypeResolver struct {
+	fset  *token.FileSet
+	decls map[string]*typedDecl // 函数名声明位置 -> 类型检查后的函数声明
+	funcs map[string]*Function  // 函数名声明位置 -> 项目中的函数
+}
+
+type typedDecl struct {
+	decl *ast.FuncDecl
+	info *types.Info
+}
+
+// NewTypeResolver 离线加载 rootPath 下的所有包（使用 vendor 目录或本地模块缓存）并完成类型检查
+func NewTypeResolver(ctx context.Context, rootPath string) (*TypeResolver, error) {
+	dir, err := filepath.Abs(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	tr := &TypeResolver{
+		fset:  token.NewFileSet(),
+		decls: make(map[string]*typedDecl),
+		funcs: make(map[string]*Function),
+	}
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+			packages.NeedTypes | packages.NeedTypesInfo | packages.NeedModule |
+			packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+		Env:  offlineEnv(dir),
+		Fset: tr.fset,
+	}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("load packages err:%w", err)
+	}
+	for _, pkg := range pkgs {
+		if pkg.TypesInfo == nil {
+			continue
+		}
+		failed, whole := failedFiles(pkg)
+		if whole {
+			continue
+		}
+		for _, f := range pkg.Syntax {
+			filename := tr.fset.Position(f.Pos()).Filename
+			if failed[filename] {
+				continue
+			}
+			for _, d := range f.Decls {
+				if decl, ok := d.(*ast.FuncDecl); ok && decl.Body != nil {
+					tr.decls[positionKey(tr.fset.Position(decl.Name.Pos()))] = &typedDecl{decl: decl, info: pkg.TypesInfo}
+				}
+			}
+		}
+	}
+	return tr, nil
+}
+
+// offlineEnv 禁止访问网络，优先使用 vendor 目录
+func offlineEnv(dir string) []string {
+	env := append(os.Environ(), "GOPROXY=off", "GOWORK=off")
+	if modPath, err := FindGoModPath(dir); err == nil {
+		if info, err := os.Stat(filepath.Join(filepath.Dir(modPath), "vendor")); err == nil && info.IsDir() {
+			return append(env, "GOFLAGS=-mod=vendor")
+		}
+	}
+	return append(env, "GOFLAGS=-mod=mod")
+}
+
+// failedFiles 返回存在错误的文件，错误无法定位到文件时整个包都视为失败
+func failedFiles(pkg *packages.Package) (map[string]bool, bool) {
+	failed := make(map[string]bool)
+	for _, e := range pkg.Errors {
+		if e.Kind == packages.ListError {
+			return nil, true
+		}
+		pos := e.Pos
+		if index := strings.Index(pos, ".go:"); index > 0 {
+			pos = pos[:index+len(".go")]
+		}
+		if !strings.HasSuffix(pos, ".go") {
+			return nil, true
+		}
+		if abs, err := filepath.Abs(pos); err == nil {
+			pos = abs
+		}
+		failed[pos] = true
+	}
+	return failed, false
+}
+
+// Bind 建立类型检查结果与项目函数之间的映射
+func (tr *TypeResolver) Bind(project *Project) {
+	for _, file := range project.GetFiles() {
+		functions := append([]*Function{}, file.GetFunctions()...)
+		for _, entity := range file.GetEntities() {
+			functions = append(functions, entity.GetMethods()...)
+		}
+		for _, fun := range functions {
+			if key := fun.positionKey(); len(key) > 0 {
+				tr.funcs[key] = fun
+			}
+		}
+	}
+}
+
+// ResolveCalls 解析函数体内的调用，ok 为 false 表示该函数所在文件未通过类型检查
+func (tr *TypeResolver) ResolveCalls(fun *Function) (calls []funcCall, ok bool) {
+	td, ok := tr.decls[fun.positionKey()]
+	if !ok {
+		return nil, false
+	}
+	ast.Inspect(td.decl.Body, func(node ast.Node) bool {
+		call, isCall := node.(*ast.CallExpr)
+		if !isCall {
+			return true
+		}
+		obj, isFunc := typeutil.Callee(td.info, call).(*types.Func)
+		if !isFunc {
+			return true
+		}
+		// 泛型实例化的函数/方法回到原始声明
+		if callee := tr.funcs[positionKey(tr.fset.Position(obj.Origin().Pos()))]; callee != nil {
+			calls = append(calls, funcCall{callee: callee, site: goCallSpan(tr.fset, call)})
+		}
+		return true
+	})
+	return calls, true
+}
+
+func positionKey(pos token.Position) string {
+	if !pos.IsValid() {
+		return ""
+	}
+	filename := pos.Filename
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
+	}
+	return fmt.Sprintf("%s:%d:%d", filename, pos.Line, pos.Column)
+}
@@ -0,0 +1,92 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"strings"
+	"testing"
+)
+
+// callConfidence 调用关系的可信度，关系不存在时为 0
+func callConfidence(project *Project, source, target string) float64 {
+	for _, r := range project.Relations {
+		if r.Type == Call && r.SourceID == source && r.TargetID == target {
+			return r.Confidence
+		}
+	}
+	return 0
+}
+
+func TestTypeResolver(t *testing.T) {
+	root := writeSources(t, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store/store.go": `package store
+
+type Store struct{}
+
+func (s *Store) Save() {}
+
+type Box[T any] struct{ v T }
+
+func (b *Box[T]) Get() T { return b.v }
+
+func Map[T any](v T) T { return v }
+`,
+		"main.go": `package main
+
+import "example.com/app/store"
+
+func run() {
+	s := &store.Store{}
+	s.Save()
+	b := &store.Box[int]{}
+	b.Get()
+	store.Map("a")
+}
+`,
+		"broken/broken.go": `package broken
+
+func helper() {}
+
+func caller() {
+	helper()
+	var n int = "a"
+	_ = n
+}
+`,
+		"broken/ok.go": `package broken
+
+func good() { helper() }
+`,
+	})
+	project := NewProject(&v1.Repo{Id: "repo", Language: v1.Language_Golang, CallResolver: v1.CallResolver_TypeChecked}, nil)
+	if err := project.Analyze(context.Background(), root, discardProjectRepo{}); err != nil {
+		t.Fatal(err)
+	}
+	if project.typeResolver == nil {
+		t.Fatal("type resolver not loaded")
+	}
+	id := project.Root.ID
+	for _, tt := range []struct {
+		source, target string
+		want           float64
+	}{
+		// 局部变量上的方法调用、泛型方法和泛型函数都回到原始声明
+		{":run", "@store:Store.Save", ConfidenceTypeChecked},
+		{":run", "@store:Box.Get", ConfidenceTypeChecked},
+		{":run", "@store:Map", ConfidenceTypeChecked},
+		// 类型检查失败的文件回退到启发式解析，同包的其他文件不受影响
+		{"@broken:caller", "@broken:helper", ConfidenceHeuristic},
+		{"@broken:good", "@broken:helper", ConfidenceTypeChecked},
+	} {
+		if got := callConfidence(project, id+tt.source, id+tt.target); got != tt.want {
+			var calls []string
+			for _, r := range project.Relations {
+				if r.Type == Call {
+					calls = append(calls, strings.TrimPrefix(r.SourceID, id)+"->"+strings.TrimPrefix(r.TargetID, id))
+				}
+			}
+			t.Errorf("%s -> %s confidence = %v, want %v, calls = %v", tt.source, tt.target, got, tt.want, calls)
+		}
+	}
+}
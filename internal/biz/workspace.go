@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"bytes"
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/conf"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// commitPattern 完整或缩写的提交哈希
+var commitPattern = regexp.MustCompile(`^[0-9a-fA-F]{4,64}$`)
+
+// Workspace 远端仓库的本地克隆目录，每个仓库一个子目录，再次分析时只拉取增量
+// 同一仓库的分析任务和 API 兼容性检查通过 lock 串行使用克隆目录
+type Workspace struct {
+	dir string
+	// locks 仓库 ID -> 容量为 1 的通道，写入即持有锁
+	locks sync.Map
+}
+
+func NewWorkspace(data *conf.Data) *Workspace {
+	dir := data.GetWorkspace().GetDir()
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "codewiki")
+	}
+	return &Workspace{dir: dir}
+}
+
+// IsRemote 仓库是否需要克隆到工作目录后分析
+func IsRemote(repo *v1.Repo) bool {
+	return repo.RepoType == v1.RepoType_Github || repo.RepoType == v1.RepoType_Git
+}
+
+// RepoDir 仓库源码所在目录，目录名是包 ID 的一部分
+func (w *Workspace) RepoDir(repo *v1.Repo) string {
+	if !IsRemote(repo) {
+		if len(repo.Path) > 0 {
+			return repo.Path
+		}
+		return repo.Target
+	}
+	return filepath.Join(w.dir, repo.Id, remoteName(repo.Target))
+}
+
+// lock 等待独占远端仓库的克隆目录，返回释放函数，ctx 结束时放弃等待
+// 本地仓库不会被修改，不需要加锁
+func (w *Workspace) lock(ctx context.Context, repo *v1.Repo) (func(), error) {
+	if !IsRemote(repo) {
+		return func() {}, nil
+	}
+	v, _ := w.locks.LoadOrStore(repo.Id, make(chan struct{}, 1))
+	ch := v.(chan struct{})
+	select {
+	case ch <- struct{}{}:
+		return func() { <-ch }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// remoteName 由仓库地址得到目录名，如 https://github.com/a/b.git 和 git@github.com:a/b.git 都是 b
+func remoteName(target string) string {
+	target = strings.TrimRight(target, "/")
+	if i := strings.LastIndexAny(target, "/:\\"); i >= 0 {
+		target = target[i+1:]
+	}
+	name := strings.TrimSuffix(target, ".git")
+	if name == "" || name == "." || name == ".." || strings.Contains(name, PathSep) {
+		return "src"
+	}
+	return name
+}
+
+// Checkout 克隆或拉取远端仓库并检出 repo.Ref，返回源码目录和检出的提交
+// 本地仓库不做修改，位于 git 仓库中时返回当前提交
+func (w *Workspace) Checkout(ctx context.Context, repo *v1.Repo) (dir, commit string, err error) {
+	dir = w.RepoDir(repo)
+	if !IsRemote(repo) {
+		commit, _ = git(ctx, dir, nil, "rev-parse", "HEAD")
+		return dir, commit, nil
+	}
+	unlock, err := w.lock(ctx, repo)
+	if err != nil {
+		return "", "", err
+	}
+	defer unlock()
+	if err = w.fetch(ctx, repo, dir); err != nil {
+		return "", "", err
+	}
+	if commit, err = resolveRef(ctx, dir, gitAuthEnv(repo), repo.Ref); err != nil {
+		return "", "", err
+	}
+	if _, err = git(ctx, dir, nil, "switch", "--quiet", "--force", "--detach", "--end-of-options", commit); err != nil {
+		return "", "", err
+	}
+	// 删除上次检出遗留的未跟踪文件，避免被当作源码分析
+	if _, err = git(ctx, dir, nil, "clean", "-ffdxq"); err != nil {
+		return "", "", err
+	}
+	return dir, commit, nil
+}
+
+// fetch 在 dir 中初始化或更新远端仓库的所有分支和标签，不改变工作区
+func (w *Workspace) fetch(ctx context.Context, repo *v1.Repo, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); os.IsNotExist(err) {
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		if _, err = git(ctx, dir, nil, "init", "--quiet"); err != nil {
+			return err
+		}
+		if _, err = git(ctx, dir, nil, "remote", "add", "--", "origin", repo.Target); err != nil {
+			return err
+		}
+	} else if _, err = git(ctx, dir, nil, "remote", "set-url", "--", "origin", repo.Target); err != nil {
+		return err
+	}
+	_, err := git(ctx, dir, gitAuthEnv(repo), "fetch", "--quiet", "--force", "--prune", "--prune-tags", "--tags", "origin")
+	return err
+}
+
+// Remove 删除远端仓库的克隆
+func (w *Workspace) Remove(ctx context.Context, repo *v1.Repo) error {
+	if !IsRemote(repo) {
+		return nil
+	}
+	unlock, err := w.lock(ctx, repo)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return os.RemoveAll(filepath.Join(w.dir, repo.Id))
+}
+
+// resolveRef 依次按远端分支、标签、提交解析 ref，为空时使用远端默认分支
+func resolveRef(ctx context.Context, dir string, env []string, ref string) (string, error) {
+	if ref == "" {
+		if _, err := git(ctx, dir, env, "remote", "set-head", "origin", "--auto"); err != nil {
+			return "", err
+		}
+		ref = "HEAD"
+	} else if err := validateRef(ctx, ref); err != nil {
+		return "", err
+	}
+	for _, name := range []string{"refs/remotes/origin/" + ref, "refs/tags/" + ref, ref} {
+		if commit, err := git(ctx, dir, nil, "rev-parse", "--verify", "--quiet", "--end-of-options", name+"^{commit}"); err == nil {
+			return commit, nil
+		}
+	}
+	// 不在任何分支和标签上的提交需要单独拉取
+	if _, err := git(ctx, dir, env, "fetch", "--quiet", "--end-of-options", "origin", ref); err == nil {
+		return git(ctx, dir, nil, "rev-parse", "--verify", "--quiet", "--end-of-options", "FETCH_HEAD^{commit}")
+	}
+	return "", v1.ErrorParamValidate("ref %s not found", ref)
+}
+
+// validateRef ref 只能是提交哈希或合法的分支、标签名，以 - 开头的 ref 会被 git 当作选项
+func validateRef(ctx context.Context, ref string) error {
+	if strings.HasPrefix(ref, "-") {
+		return v1.ErrorParamValidate("invalid ref %s", ref)
+	}
+	if commitPattern.MatchString(ref) {
+		return nil
+	}
+	if _, err := git(ctx, "", nil, "check-ref-format", "--allow-onelevel", ref); err != nil {
+		return v1.ErrorParamValidate("invalid ref %s", ref)
+	}
+	return nil
+}
+
+// gitAuthEnv 通过环境变量传入令牌，令牌不会写入仓库配置或出现在命令行参数中
+func gitAuthEnv(repo *v1.Repo) []string {
+	if repo.Token == "" {
+		return nil
+	}
+	auth := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + repo.Token))
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=http.extraHeader",
+		"GIT_CONFIG_VALUE_0=Authorization: Basic " + auth,
+	}
+}
+
+func git(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	out, err := gitOutput(ctx, dir, env, args...)
+	return strings.TrimSpace(string(out)), err
+}
+
+func gitOutput(ctx context.Context, dir string, env []string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	cmd.Env = append(cmd.Env, env...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s failure:%v %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
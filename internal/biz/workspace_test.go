@@ -0,0 +1,187 @@
+package biz
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/conf"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestWorkspace(t *testing.T) *Workspace {
+	return NewWorkspace(&conf.Data{Workspace: &conf.Data_Workspace{Dir: t.TempDir()}})
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	out, err := git(context.Background(), dir, nil, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// commitFiles 在 work 中提交文件并推送到远端，返回提交
+func commitFiles(t *testing.T, work string, files map[string]string) string {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(work, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runGit(t, work, "add", "-A")
+	runGit(t, work, "commit", "--quiet", "-m", "update")
+	runGit(t, work, "push", "--quiet", "--all", "origin")
+	runGit(t, work, "push", "--quiet", "--tags", "origin")
+	return runGit(t, work, "rev-parse", "HEAD")
+}
+
+// newRemote 本地裸仓库作为远端，main 有两个提交，v1 标签指向第一个，dev 分支多一个文件
+func newRemote(t *testing.T) (remote, work string, commits map[string]string) {
+	remote = filepath.Join(t.TempDir(), "remote.git")
+	work = t.TempDir()
+	runGit(t, t.TempDir(), "init", "--quiet", "--bare", "--initial-branch=main", remote)
+	runGit(t, work, "init", "--quiet", "--initial-branch=main")
+	runGit(t, work, "remote", "add", "origin", remote)
+	commits = make(map[string]string)
+	commits["v1"] = commitFiles(t, work, map[string]string{"a.go": "package p\n\nfunc A() {}\n"})
+	runGit(t, work, "tag", "v1")
+	commits["main"] = commitFiles(t, work, map[string]string{"b.go": "package p\n\nfunc B() { A() }\n"})
+	runGit(t, work, "checkout", "--quiet", "-b", "dev")
+	commits["dev"] = commitFiles(t, work, map[string]string{"c.go": "package p\n\nfunc C() {}\n"})
+	runGit(t, work, "checkout", "--quiet", "main")
+	return remote, work, commits
+}
+
+func TestWorkspaceCheckout(t *testing.T) {
+	remote, work, commits := newRemote(t)
+	w := newTestWorkspace(t)
+	repo := &v1.Repo{Id: "repo", RepoType: v1.RepoType_Git, Target: remote, Token: "secret"}
+
+	checkout := func(ref, commit string, present, absent []string) {
+		t.Helper()
+		repo.Ref = ref
+		dir, got, err := w.Checkout(context.Background(), repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != commit {
+			t.Errorf("ref %q: commit = %s, want %s", ref, got, commit)
+		}
+		if filepath.Base(dir) != "remote" {
+			t.Errorf("dir = %s", dir)
+		}
+		for _, name := range present {
+			if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+				t.Errorf("ref %q: %v", ref, err)
+			}
+		}
+		for _, name := range absent {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				t.Errorf("ref %q: unexpected %s", ref, name)
+			}
+		}
+	}
+	checkout("", commits["main"], []string{"a.go", "b.go"}, []string{"c.go"})
+	checkout("v1", commits["v1"], []string{"a.go"}, []string{"b.go", "c.go"})
+	checkout("dev", commits["dev"], []string{"a.go", "b.go", "c.go"}, nil)
+	checkout(commits["v1"], commits["v1"], []string{"a.go"}, []string{"b.go"})
+
+	// 已克隆的仓库再次检出时拉取新提交
+	head := commitFiles(t, work, map[string]string{"d.go": "package p\n\nfunc D() {}\n"})
+	checkout("main", head, []string{"d.go"}, []string{"c.go"})
+
+	repo.Ref = "missing"
+	if _, _, err := w.Checkout(context.Background(), repo); err == nil {
+		t.Errorf("expected error for missing ref")
+	}
+	// 以 - 开头的 ref 不能作为选项传给 git
+	pwned := filepath.Join(t.TempDir(), "pwned")
+	for _, ref := range []string{"--upload-pack=touch " + pwned + "; git-upload-pack", "a b", "main~1"} {
+		repo.Ref = ref
+		if _, _, err := w.Checkout(context.Background(), repo); !v1.IsParamValidate(err) {
+			t.Errorf("ref %q: err = %v", ref, err)
+		}
+	}
+	if _, err := os.Stat(pwned); !os.IsNotExist(err) {
+		t.Errorf("ref executed command: %v", err)
+	}
+	config, err := os.ReadFile(filepath.Join(w.RepoDir(repo), ".git", "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(config), "secret") || strings.Contains(string(config), "extraHeader") {
+		t.Errorf("token persisted in git config:\n%s", config)
+	}
+
+	if err = w.Remove(context.Background(), repo); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(w.RepoDir(repo)); !os.IsNotExist(err) {
+		t.Errorf("workspace not removed: %v", err)
+	}
+}
+
+func TestWorkspaceLock(t *testing.T) {
+	w := newTestWorkspace(t)
+	repo := &v1.Repo{Id: "repo", RepoType: v1.RepoType_Git, Target: "https://example.com/a.git"}
+	unlock, err := w.lock(context.Background(), repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 持有锁时同一仓库的检出和删除等待到超时
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, _, err = w.Checkout(ctx, repo); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("checkout while locked: err = %v", err)
+	}
+	if err = w.Remove(ctx, repo); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("remove while locked: err = %v", err)
+	}
+	other, err := w.lock(context.Background(), &v1.Repo{Id: "other", RepoType: v1.RepoType_Git})
+	if err != nil {
+		t.Fatalf("lock other repo: %v", err)
+	}
+	other()
+	unlock()
+	if err = w.Remove(context.Background(), repo); err != nil {
+		t.Errorf("remove after unlock: %v", err)
+	}
+}
+
+func TestAnalyzeRemoteRepo(t *testing.T) {
+	remote, _, commits := newRemote(t)
+	projectRepo := newJobProjectRepo(&v1.Repo{Id: "repo", RepoType: v1.RepoType_Git, Target: remote, Ref: "v1", Language: v1.Language_Golang})
+	c := newTestCodeWiki(t, projectRepo)
+	job, err := c.AnalyzeRepo(context.Background(), &v1.AnalyzeRepoReq{Id: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job = waitJob(t, c, job.Id)
+	if job.Status != v1.JobStatus_Succeeded || job.Commit != commits["v1"] {
+		t.Fatalf("job = %+v", job)
+	}
+	if want := []string{SnapshotID("repo", commits["v1"]) + "@remote@a.go"}; len(job.Summary.Added) != 1 || job.Summary.Added[0] != want[0] {
+		t.Errorf("added = %v, want %v", job.Summary.Added, want)
+	}
+}
+
+func TestRemoteName(t *testing.T) {
+	for target, want := range map[string]string{
+		"https://github.com/a/b.git":  "b",
+		"https://github.com/a/b/":     "b",
+		"git@github.com:a/b.git":      "b",
+		"git@host:b.git":              "b",
+		"/srv/git/project.git":        "project",
+		"https://host/a/name@tag.git": "src",
+	} {
+		if got := remoteName(target); got != want {
+			t.Errorf("remoteName(%q) = %q, want %q", target, got, want)
+		}
+	}
+}
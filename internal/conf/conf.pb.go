@@ -133,6 +133,10 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
 	Llm           *Data_LLM              `protobuf:"bytes,4,opt,name=llm,proto3" json:"llm,omitempty"`
 	Embedding     *Data_Embedding        `protobuf:"bytes,5,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	Workspace     *Data_Workspace        `protobuf:"bytes,6,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Snapshot      *Data_Snapshot         `protobuf:"bytes,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Graph         *Data_Graph            `protobuf:"bytes,8,opt,name=graph,proto3" json:"graph,omitempty"`
+	VectorStore   *Data_VectorStore      `protobuf:"bytes,9,opt,name=vectorStore,proto3" json:"vectorStore,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,6 +206,34 @@ func (x *Data) GetEmbedding() *Data_Embedding {
 	return nil
 }
 
+func (x *Data) GetWorkspace() *Data_Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *Data) GetSnapshot() *Data_Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Data) GetGraph() *Data_Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *Data) GetVectorStore() *Data_VectorStore {
+	if x != nil {
+		return x.VectorStore
+	}
+	return nil
+}
+
 type PoolConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PoolSize      int32                  `protobuf:"varint,1,opt,name=poolSize,proto3" json:"poolSize,omitempty"`
@@ -436,8 +468,8 @@ func (x *Data_Neo4J) GetPassword() string {
 
 type Data_Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // mysql（默认）或 sqlite
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // mysql 为 DSN，sqlite 为数据库文件路径
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -622,6 +654,306 @@ func (x *Data_Embedding) GetDimension() int32 {
 	return 0
 }
 
+type Data_Workspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dir           string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"` // 远端仓库的克隆目录，默认为系统临时目录下的 codewiki
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Workspace) Reset() {
+	*x = Data_Workspace{}
+	mi := &file_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Workspace) ProtoMessage() {}
+
+func (x *Data_Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Workspace.ProtoReflect.Descriptor instead.
+func (*Data_Workspace) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_Workspace) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Data_Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keep          int32                  `protobuf:"varint,1,opt,name=keep,proto3" json:"keep,omitempty"`    // 每个仓库保留的快照数，0 为不限制
+	MaxAge        *durationpb.Duration   `protobuf:"bytes,2,opt,name=maxAge,proto3" json:"maxAge,omitempty"` // 超过该时长的快照被清理，为空时不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Snapshot) Reset() {
+	*x = Data_Snapshot{}
+	mi := &file_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Snapshot) ProtoMessage() {}
+
+func (x *Data_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Snapshot.ProtoReflect.Descriptor instead.
+func (*Data_Snapshot) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Snapshot) GetKeep() int32 {
+	if x != nil {
+		return x.Keep
+	}
+	return 0
+}
+
+func (x *Data_Snapshot) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+type Data_Graph struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // 代码图存储：neo4j（默认）或 memory
+	Dir           string                 `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`       // memory 存储的数据目录，为空时只保存在内存中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Graph) Reset() {
+	*x = Data_Graph{}
+	mi := &file_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Graph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Graph) ProtoMessage() {}
+
+func (x *Data_Graph) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Graph.ProtoReflect.Descriptor instead.
+func (*Data_Graph) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_Graph) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Graph) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Data_VectorStore struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Driver        string                   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // 代码块向量存储：milvus（默认）、qdrant 或 local
+	Target        string                   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // milvus 或 qdrant 的 host:port，milvus 为空时使用 embedding.apiURL，qdrant 为 gRPC 端口，默认 6334
+	ApiKey        string                   `protobuf:"bytes,3,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	Collection    string                   `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"` // qdrant 的 collection，为空时为 code_chunks，所有仓库共用
+	Dir           string                   `protobuf:"bytes,5,opt,name=dir,proto3" json:"dir,omitempty"`               // local 存储的数据目录，为空时只保存在内存中
+	Milvus        *Data_VectorStore_Milvus `protobuf:"bytes,6,opt,name=milvus,proto3" json:"milvus,omitempty"`         // milvus 新建 collection 时的 schema 和索引参数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_VectorStore) Reset() {
+	*x = Data_VectorStore{}
+	mi := &file_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_VectorStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_VectorStore) ProtoMessage() {}
+
+func (x *Data_VectorStore) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_VectorStore.ProtoReflect.Descriptor instead.
+func (*Data_VectorStore) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_VectorStore) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_VectorStore) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Data_VectorStore) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *Data_VectorStore) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *Data_VectorStore) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Data_VectorStore) GetMilvus() *Data_VectorStore_Milvus {
+	if x != nil {
+		return x.Milvus
+	}
+	return nil
+}
+
+type Data_VectorStore_Milvus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IndexType     string                 `protobuf:"bytes,1,opt,name=indexType,proto3" json:"indexType,omitempty"`                                                                               // 向量索引类型，默认 AUTOINDEX，可为 HNSW、IVF_FLAT 等
+	MetricType    string                 `protobuf:"bytes,2,opt,name=metricType,proto3" json:"metricType,omitempty"`                                                                             // 相似度度量，默认 COSINE
+	IndexParams   map[string]string      `protobuf:"bytes,3,rep,name=indexParams,proto3" json:"indexParams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 索引参数，如 HNSW 的 M、efConstruction，IVF_FLAT 的 nlist
+	MaxLength     int32                  `protobuf:"varint,4,opt,name=maxLength,proto3" json:"maxLength,omitempty"`                                                                              // 文本字段的最大长度，默认 65535，超过时截断
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_VectorStore_Milvus) Reset() {
+	*x = Data_VectorStore_Milvus{}
+	mi := &file_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_VectorStore_Milvus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_VectorStore_Milvus) ProtoMessage() {}
+
+func (x *Data_VectorStore_Milvus) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_VectorStore_Milvus.ProtoReflect.Descriptor instead.
+func (*Data_VectorStore_Milvus) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 7, 0}
+}
+
+func (x *Data_VectorStore_Milvus) GetIndexType() string {
+	if x != nil {
+		return x.IndexType
+	}
+	return ""
+}
+
+func (x *Data_VectorStore_Milvus) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
+	return ""
+}
+
+func (x *Data_VectorStore_Milvus) GetIndexParams() map[string]string {
+	if x != nil {
+		return x.IndexParams
+	}
+	return nil
+}
+
+func (x *Data_VectorStore_Milvus) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
 var File_conf_proto protoreflect.FileDescriptor
 
 const file_conf_proto_rawDesc = "" +
@@ -642,7 +974,7 @@ const file_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x99\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe3\v\n" +
 	"\x04Data\x12,\n" +
 	"\x05neo4j\x18\x01 \x01(\v2\x16.kratos.api.Data.Neo4jR\x05neo4j\x126\n" +
 	"\n" +
@@ -650,7 +982,11 @@ const file_conf_proto_rawDesc = "" +
 	"poolConfig\x125\n" +
 	"\bdatabase\x18\x03 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12&\n" +
 	"\x03llm\x18\x04 \x01(\v2\x14.kratos.api.Data.LLMR\x03llm\x128\n" +
-	"\tembedding\x18\x05 \x01(\v2\x1a.kratos.api.Data.EmbeddingR\tembedding\x1aW\n" +
+	"\tembedding\x18\x05 \x01(\v2\x1a.kratos.api.Data.EmbeddingR\tembedding\x128\n" +
+	"\tworkspace\x18\x06 \x01(\v2\x1a.kratos.api.Data.WorkspaceR\tworkspace\x125\n" +
+	"\bsnapshot\x18\a \x01(\v2\x19.kratos.api.Data.SnapshotR\bsnapshot\x12,\n" +
+	"\x05graph\x18\b \x01(\v2\x16.kratos.api.Data.GraphR\x05graph\x12>\n" +
+	"\vvectorStore\x18\t \x01(\v2\x1c.kratos.api.Data.VectorStoreR\vvectorStore\x1aW\n" +
 	"\x05Neo4j\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -667,7 +1003,34 @@ const file_conf_proto_rawDesc = "" +
 	"\x06apiURL\x18\x01 \x01(\tR\x06apiURL\x12\x16\n" +
 	"\x06apiKey\x18\x02 \x01(\tR\x06apiKey\x12\x1c\n" +
 	"\tmodelName\x18\x03 \x01(\tR\tmodelName\x12\x1c\n" +
-	"\tdimension\x18\x04 \x01(\x05R\tdimension\"D\n" +
+	"\tdimension\x18\x04 \x01(\x05R\tdimension\x1a\x1d\n" +
+	"\tWorkspace\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x1aQ\n" +
+	"\bSnapshot\x12\x12\n" +
+	"\x04keep\x18\x01 \x01(\x05R\x04keep\x121\n" +
+	"\x06maxAge\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x1a1\n" +
+	"\x05Graph\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x10\n" +
+	"\x03dir\x18\x02 \x01(\tR\x03dir\x1a\xc3\x03\n" +
+	"\vVectorStore\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x16\n" +
+	"\x06apiKey\x18\x03 \x01(\tR\x06apiKey\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x04 \x01(\tR\n" +
+	"collection\x12\x10\n" +
+	"\x03dir\x18\x05 \x01(\tR\x03dir\x12;\n" +
+	"\x06milvus\x18\x06 \x01(\v2#.kratos.api.Data.VectorStore.MilvusR\x06milvus\x1a\xfc\x01\n" +
+	"\x06Milvus\x12\x1c\n" +
+	"\tindexType\x18\x01 \x01(\tR\tindexType\x12\x1e\n" +
+	"\n" +
+	"metricType\x18\x02 \x01(\tR\n" +
+	"metricType\x12V\n" +
+	"\vindexParams\x18\x03 \x03(\v24.kratos.api.Data.VectorStore.Milvus.IndexParamsEntryR\vindexParams\x12\x1c\n" +
+	"\tmaxLength\x18\x04 \x01(\x05R\tmaxLength\x1a>\n" +
+	"\x10IndexParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
 	"\n" +
 	"PoolConfig\x12\x1a\n" +
 	"\bpoolSize\x18\x01 \x01(\x05R\bpoolSize\x12\x1a\n" +
@@ -685,19 +1048,25 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*PoolConfig)(nil),          // 3: kratos.api.PoolConfig
-	(*Server_HTTP)(nil),         // 4: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 5: kratos.api.Server.GRPC
-	(*Data_Neo4J)(nil),          // 6: kratos.api.Data.Neo4j
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_LLM)(nil),            // 8: kratos.api.Data.LLM
-	(*Data_Embedding)(nil),      // 9: kratos.api.Data.Embedding
-	(*durationpb.Duration)(nil), // 10: google.protobuf.Duration
+	(*Bootstrap)(nil),               // 0: kratos.api.Bootstrap
+	(*Server)(nil),                  // 1: kratos.api.Server
+	(*Data)(nil),                    // 2: kratos.api.Data
+	(*PoolConfig)(nil),              // 3: kratos.api.PoolConfig
+	(*Server_HTTP)(nil),             // 4: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),             // 5: kratos.api.Server.GRPC
+	(*Data_Neo4J)(nil),              // 6: kratos.api.Data.Neo4j
+	(*Data_Database)(nil),           // 7: kratos.api.Data.Database
+	(*Data_LLM)(nil),                // 8: kratos.api.Data.LLM
+	(*Data_Embedding)(nil),          // 9: kratos.api.Data.Embedding
+	(*Data_Workspace)(nil),          // 10: kratos.api.Data.Workspace
+	(*Data_Snapshot)(nil),           // 11: kratos.api.Data.Snapshot
+	(*Data_Graph)(nil),              // 12: kratos.api.Data.Graph
+	(*Data_VectorStore)(nil),        // 13: kratos.api.Data.VectorStore
+	(*Data_VectorStore_Milvus)(nil), // 14: kratos.api.Data.VectorStore.Milvus
+	nil,                             // 15: kratos.api.Data.VectorStore.Milvus.IndexParamsEntry
+	(*durationpb.Duration)(nil),     // 16: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -709,13 +1078,20 @@ var file_conf_proto_depIdxs = []int32{
 	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
 	8,  // 7: kratos.api.Data.llm:type_name -> kratos.api.Data.LLM
 	9,  // 8: kratos.api.Data.embedding:type_name -> kratos.api.Data.Embedding
-	10, // 9: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	10, // 10: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	10, // 9: kratos.api.Data.workspace:type_name -> kratos.api.Data.Workspace
+	11, // 10: kratos.api.Data.snapshot:type_name -> kratos.api.Data.Snapshot
+	12, // 11: kratos.api.Data.graph:type_name -> kratos.api.Data.Graph
+	13, // 12: kratos.api.Data.vectorStore:type_name -> kratos.api.Data.VectorStore
+	16, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	16, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	16, // 15: kratos.api.Data.Snapshot.maxAge:type_name -> google.protobuf.Duration
+	14, // 16: kratos.api.Data.VectorStore.milvus:type_name -> kratos.api.Data.VectorStore.Milvus
+	15, // 17: kratos.api.Data.VectorStore.Milvus.indexParams:type_name -> kratos.api.Data.VectorStore.Milvus.IndexParamsEntry
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -729,7 +1105,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -5,28 +5,37 @@ import (
 	"codewiki/internal/data/repo"
 	"context"
 	"fmt"
+	"github.com/glebarez/sqlite"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-	"github.com/qdrant/go-client/qdrant"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"os"
+	"path/filepath"
 	"time"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDriverWithContext, NewGormDB, repo.NewCompositeRepo, repo.NewMilvus)
+var ProviderSet = wire.NewSet(NewData, NewDriverWithContext, NewGormDB, repo.NewGraphStore, repo.NewCompositeRepo, repo.NewIndexerRepo)
 
 // Data .
 type Data struct {
-	neo4jDriver  neo4j.DriverWithContext
-	gormDB       *gorm.DB
-	pointsClient *qdrant.PointsClient
+	neo4jDriver neo4j.DriverWithContext
+	gormDB      *gorm.DB
 }
 
-// NewData .
+// NewData 只在代码图存储为 Neo4j 时连接 Neo4j
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
-
+	cleanup := func() {
+		log.NewHelper(logger).Info("closing the data resources")
+	}
+	if driver := c.GetGraph().GetDriver(); driver != "" && driver != repo.GraphDriverNeo4j {
+		return &Data{}, cleanup, nil
+	}
+	if c.Neo4J == nil {
+		return nil, nil, fmt.Errorf("neo4j config is nil")
+	}
 	neo4jDriver, err := neo4j.NewDriverWithContext(c.Neo4J.Target,
 		neo4j.BasicAuth(c.Neo4J.Username, c.Neo4J.Password, ""),
 		func(c *neo4j.Config) {
@@ -41,26 +50,58 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	if err = neo4jDriver.VerifyConnectivity(context.Background()); err != nil {
 		return nil, nil, err
 	}
-	cleanup := func() {
+	cleanup = func() {
 		neo4jDriver.Close(context.Background())
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	return &Data{neo4jDriver: neo4jDriver}, cleanup, nil
 }
 
+// NewDriverWithContext 代码图存储不是 Neo4j 时为 nil
 func NewDriverWithContext(data *Data) neo4j.DriverWithContext {
 	return data.neo4jDriver
 }
 
-// NewGormDB initializes MySQL GORM connection if configured
+// 仓库元数据数据库的驱动
+const (
+	DatabaseDriverMySQL  = "mysql"
+	DatabaseDriverSQLite = "sqlite"
+)
+
+// NewGormDB initializes the GORM connection of database.driver: mysql (default) or sqlite
 func NewGormDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
 	if c.Database == nil {
 		return nil, fmt.Errorf("database config is nil")
 	}
-	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
+	switch c.Database.Driver {
+	case "", DatabaseDriverMySQL:
+		return gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
+	case DatabaseDriverSQLite:
+		return openSQLite(c.Database.Source)
+	}
+	return nil, fmt.Errorf("unknown database driver %s", c.Database.Driver)
+}
+
+// openSQLite 打开 SQLite 数据库文件，不存在时创建
+// SQLite 同时只允许一个写入，因此只使用一个连接，避免并发的分析任务写入时报 database is locked
+func openSQLite(path string) (*gorm.DB, error) {
+	if path == "" {
+		return nil, fmt.Errorf("sqlite database path is empty")
+	}
+	if path != ":memory:" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, err
 	}
+	sqlDB.SetMaxOpenConns(1)
 	return db, nil
 }
 
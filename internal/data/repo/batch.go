@@ -11,20 +11,20 @@ import (
 func batchSavePackage(ctx context.Context, session neo4j.SessionWithContext, pkgs []*biz.Package) error {
 	query := `
        UNWIND $batch AS pkg
-		CREATE (p:Package {
-			id: pkg.id,
-			name: pkg.name,
-			parent_id: pkg.parent_id,
-			path: pkg.path
-		})`
+		MERGE (p:Package {id: pkg.id})
+		SET p.name = pkg.name,
+			p.name_words = pkg.name_words,
+			p.name_grams = pkg.name_grams,
+			p.parent_id = pkg.parent_id,
+			p.path = pkg.path`
 	var params []map[string]any
 	for _, pkg := range pkgs {
-		params = append(params, map[string]any{
+		params = append(params, withSymbolWords(pkg.Name, map[string]any{
 			"id":        pkg.ID,
 			"name":      pkg.Name,
 			"path":      pkg.Path,
 			"parent_id": pkg.ParentID,
-		})
+		}))
 	}
 
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -63,18 +63,22 @@ func batchSavePackageRelations(ctx context.Context, session neo4j.SessionWithCon
 func batchSaveFile(ctx context.Context, session neo4j.SessionWithContext, files []*biz.File) error {
 	query := `
         UNWIND $batch AS file
-		CREATE (f:File {
-			id: file.id,
-			name: file.name,
-			pkg_id: file.pkg_id
-		})`
+		MERGE (f:File {id: file.id})
+		SET f.name = file.name,
+			f.name_words = file.name_words,
+			f.name_grams = file.name_grams,
+			f.pkg_id = file.pkg_id,
+			f.hash = file.hash,
+			f.test = file.test`
 	var params []map[string]any
 	for _, file := range files {
-		params = append(params, map[string]any{
+		params = append(params, withSymbolWords(file.Name, map[string]any{
 			"id":     file.ID,
 			"name":   file.Name,
 			"pkg_id": file.PkgID,
-		})
+			"hash":   file.Hash,
+			"test":   file.Test,
+		}))
 	}
 
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -87,24 +91,33 @@ func batchSaveFile(ctx context.Context, session neo4j.SessionWithContext, files
 	return err
 
 }
-func batchSaveEntity(ctx context.Context, session neo4j.SessionWithContext, entities []*biz.Entity) error {
+
+// batchSaveEntity 保存实体，tests 为测试文件及其中实体的 ID
+func batchSaveEntity(ctx context.Context, session neo4j.SessionWithContext, entities []*biz.Entity, tests map[string]bool) error {
 
 	query := fmt.Sprintf(`
    UNWIND $batch AS ent
-	CREATE (e:Entity {
-		id: ent.id,
-		type: ent.type,
-		name: ent.name,
-		file_id: ent.file_id,
-		pkg_id: ent.pkg_id,
-		definition: ent.definition,
-		comment: ent.comment,
-		document: ent.document
-	})
+	MERGE (e:Entity {id: ent.id})
+	SET e.type = ent.type,
+		e.name = ent.name,
+		e.name_words = ent.name_words,
+		e.name_grams = ent.name_grams,
+		e.file_id = ent.file_id,
+		e.pkg_id = ent.pkg_id,
+		e.definition = ent.definition,
+		e.comment = ent.comment,
+		e.document = ent.document,
+		e.exported = ent.exported,
+		e.hash = ent.hash,
+		e.test = ent.test,
+		e.start_line = ent.start_line,
+		e.start_column = ent.start_column,
+		e.end_line = ent.end_line,
+		e.end_column = ent.end_column
 	`)
 	var params []map[string]any
 	for _, e := range entities {
-		params = append(params, map[string]interface{}{
+		params = append(params, withSymbolWords(e.Name, withSpan(e.Span, map[string]interface{}{
 			"id":         e.ID,
 			"name":       e.Name,
 			"type":       e.Type,
@@ -113,7 +126,10 @@ func batchSaveEntity(ctx context.Context, session neo4j.SessionWithContext, enti
 			"definition": e.Definition,
 			"comment":    e.Comment,
 			"document":   e.Document,
-		})
+			"exported":   e.Exported,
+			"hash":       e.DefinitionHash(),
+			"test":       tests[e.FileID],
+		})))
 	}
 
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -130,31 +146,43 @@ func batchSaveEntity(ctx context.Context, session neo4j.SessionWithContext, enti
 func batchSaveFunction(ctx context.Context, session neo4j.SessionWithContext, functions []*biz.Function) error {
 	query := `
         UNWIND $batch AS fn
-		CREATE (f:Function {
-			id: fn.id,
-			name: fn.name,
-			document: fn.document,
-			comment: fn.comment,
-			pkg_id: fn.pkg_id,
-			scope: fn.scope,
-			receiver: fn.receiver,
-			ent_id: fn.ent_id,
-            file_id: fn.file_id
-		})
+		MERGE (f:Function {id: fn.id})
+		SET f.name = fn.name,
+			f.name_words = fn.name_words,
+			f.name_grams = fn.name_grams,
+			f.document = fn.document,
+			f.comment = fn.comment,
+			f.pkg_id = fn.pkg_id,
+			f.scope = fn.scope,
+			f.receiver = fn.receiver,
+			f.ent_id = fn.ent_id,
+			f.file_id = fn.file_id,
+			f.exported = fn.exported,
+			f.entrypoint = fn.entrypoint,
+			f.hash = fn.hash,
+			f.test = fn.test,
+			f.start_line = fn.start_line,
+			f.start_column = fn.start_column,
+			f.end_line = fn.end_line,
+			f.end_column = fn.end_column
 		`
 	var params []map[string]any
 	for _, f := range functions {
-		params = append(params, map[string]interface{}{
-			"id":       f.ID,
-			"name":     f.Name,
-			"document": f.Document,
-			"comment":  f.Comment,
-			"pkg_id":   f.PkgID,
-			"scope":    f.Scope,
-			"receiver": f.Receiver,
-			"ent_id":   f.EntId,
-			"file_id":  f.FileId,
-		})
+		params = append(params, withSymbolWords(f.Name, withSpan(f.Span, map[string]interface{}{
+			"id":         f.ID,
+			"name":       f.Name,
+			"document":   f.Document,
+			"comment":    f.Comment,
+			"pkg_id":     f.PkgID,
+			"scope":      f.Scope,
+			"receiver":   f.Receiver,
+			"ent_id":     f.EntId,
+			"file_id":    f.FileId,
+			"exported":   f.Exported,
+			"entrypoint": f.Entrypoint(),
+			"hash":       f.SourceHash(),
+			"test":       f.InTestFile(),
+		})))
 	}
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		if _, err := tx.Run(ctx, query, map[string]interface{}{"batch": params}); err != nil {
@@ -167,29 +195,31 @@ func batchSaveFunction(ctx context.Context, session neo4j.SessionWithContext, fu
 
 }
 
-func batchSaveField(ctx context.Context, session neo4j.SessionWithContext, fields []*biz.Field) error {
+// batchSaveField 保存字段，tests 为测试文件及其中实体的 ID
+func batchSaveField(ctx context.Context, session neo4j.SessionWithContext, fields []*biz.Field, tests map[string]bool) error {
 	query := `
         UNWIND $batch AS fd
-		CREATE (f:Field {
-                id: fd.id,
-				name: fd.name,
-				type: fd.type,
-                entity_id: fd.entity_id
-		})
+		MERGE (f:Field {id: fd.id})
+		SET f.name = fd.name,
+			f.name_words = fd.name_words,
+			f.name_grams = fd.name_grams,
+			f.type = fd.type,
+			f.entity_id = fd.entity_id,
+			f.test = fd.test,
+			f.start_line = fd.start_line,
+			f.start_column = fd.start_column,
+			f.end_line = fd.end_line,
+			f.end_column = fd.end_column
 		`
-	// 生成唯一ID，例如 entityID + fieldName
 	var params []map[string]any
 	for _, f := range fields {
-		fieldID := fmt.Sprintf("%s_%s", f.StructID, f.Name)
-		if len(f.Name) == 0 {
-			fieldID = fmt.Sprintf("%s_%s", f.StructID, f.ObjType)
-		}
-		params = append(params, map[string]interface{}{
-			"id":        fieldID,
+		params = append(params, withSymbolWords(f.Name, withSpan(f.Span, map[string]interface{}{
+			"id":        fieldID(f),
 			"name":      f.Name,
 			"type":      f.ObjType,
 			"entity_id": f.StructID,
-		})
+			"test":      tests[f.StructID],
+		})))
 	}
 
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -203,6 +233,30 @@ func batchSaveField(ctx context.Context, session neo4j.SessionWithContext, field
 
 }
 
+// withSpan 把源码位置加入节点或关系的属性，未记录位置时为 0
+func withSpan(span biz.Span, params map[string]any) map[string]any {
+	params["start_line"] = span.StartLine
+	params["start_column"] = span.StartColumn
+	params["end_line"] = span.EndLine
+	params["end_column"] = span.EndColumn
+	return params
+}
+
+// withSymbolWords 把名称的全文索引词加入节点的属性
+func withSymbolWords(name string, params map[string]any) map[string]any {
+	params["name_words"] = biz.SymbolWords(name)
+	params["name_grams"] = symbolGrams(name)
+	return params
+}
+
+// fieldID 生成唯一ID，例如 entityID + fieldName
+func fieldID(f *biz.Field) string {
+	if len(f.Name) == 0 {
+		return fmt.Sprintf("%s_%s", f.StructID, f.ObjType)
+	}
+	return fmt.Sprintf("%s_%s", f.StructID, f.Name)
+}
+
 func batchSaveImport(ctx context.Context, session neo4j.SessionWithContext, imports []*biz.Import) error {
 
 	query := `
@@ -210,16 +264,20 @@ func batchSaveImport(ctx context.Context, session neo4j.SessionWithContext, impo
 		CREATE (i:Import {
 			path: imp.path,
 			name: imp.name,
-            file_id: imp.file_id
+            file_id: imp.file_id,
+			start_line: imp.start_line,
+			start_column: imp.start_column,
+			end_line: imp.end_line,
+			end_column: imp.end_column
 		})
 		`
 	var params []map[string]any
 	for _, i := range imports {
-		params = append(params, map[string]interface{}{
+		params = append(params, withSpan(i.Span, map[string]interface{}{
 			"path":    i.Path,
 			"name":    i.Name,
 			"file_id": i.FileId,
-		})
+		}))
 
 	}
 
@@ -237,12 +295,16 @@ func batchSaveRelation(ctx context.Context, neo4jDriver neo4j.DriverWithContext,
 	// 将 Relation 结构体转换为 Neo4j 支持的格式
 	relMaps := make(map[string][]map[string]interface{})
 	for _, rel := range relations {
-		relMaps[rel.Type] = append(relMaps[rel.Type], map[string]interface{}{
+		params := map[string]interface{}{
 			"type":       rel.Type,
 			"sourceID":   rel.SourceID,
 			"targetID":   rel.TargetID,
 			"confidence": rel.Confidence,
-		})
+		}
+		if rel.CallSite != nil {
+			withSpan(*rel.CallSite, params)
+		}
+		relMaps[rel.Type] = append(relMaps[rel.Type], params)
 	}
 	ctx, _ = context.WithTimeout(ctx, 10*time.Minute)
 	session := neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
@@ -275,56 +337,164 @@ func getCreateQueryCypher(Type string) string {
 		return `
         UNWIND $rels AS rel
         MATCH (p:Package {id: rel.sourceID}), (e:Entity {id: rel.targetID})
-        CREATE (p)-[:DeclareEntity]->(e)
+        MERGE (p)-[:DeclareEntity]->(e)
         `
 	case biz.ContainsFile:
 		return `
         UNWIND $rels AS rel
         MATCH (p:Package {id: rel.sourceID}), (f:File {id: rel.targetID})
-        CREATE (p)-[:ContainsFile]->(f)
+        MERGE (p)-[:ContainsFile]->(f)
         `
 	case biz.DeclareFunc:
 		return `
         UNWIND $rels AS rel
         MATCH (f:File {id: rel.sourceID}), (fn:Function {id: rel.targetID})
-        CREATE (f)-[:DeclareFunc]->(fn)
+        MERGE (f)-[:DeclareFunc]->(fn)
         `
 	case biz.HasMethod:
 		return `
         UNWIND $rels AS rel
         MATCH (e:Entity {id: rel.sourceID}), (f:Function {id: rel.targetID})
-        CREATE (e)-[:HasMethod]->(f)
+        MERGE (e)-[:HasMethod]->(f)
         `
 	case biz.HasFields:
 		return `
         UNWIND $rels AS rel
-        MATCH (e:Entity {id: rel.sourceID}), (fd:Field {id: rel.targetID})
-        CREATE (e)-[:HasFields]->(fd)
+        MATCH (e:Entity {id: rel.sourceID}), (t:Entity {id: rel.targetID})
+        MERGE (e)-[:HasFields]->(t)
         `
 	case biz.Implement:
 		return `
         UNWIND $rels AS rel
         MATCH (e1:Entity {id: rel.sourceID}), (e2:Entity {id: rel.targetID})
-        CREATE (e1)-[:Implement]->(e2)
+        MERGE (e1)-[:Implement]->(e2)
         `
 	case biz.Call:
 		return `
         UNWIND $rels AS rel
         MATCH (f1:Function {id: rel.sourceID}), (f2:Function {id: rel.targetID})
-        CREATE (f1)-[:Call]->(f2)
+        MERGE (f1)-[c:Call]->(f2)
+        SET c.start_line = rel.start_line,
+            c.start_column = rel.start_column,
+            c.end_line = rel.end_line,
+            c.end_column = rel.end_column
+        `
+	case biz.DispatchesTo:
+		return `
+        UNWIND $rels AS rel
+        MATCH (f1:Function {id: rel.sourceID}), (f2:Function {id: rel.targetID})
+        MERGE (f1)-[:DispatchesTo]->(f2)
+        `
+	case biz.Imports:
+		return `
+        UNWIND $rels AS rel
+        MATCH (f:File {id: rel.sourceID}), (p:Package {id: rel.targetID})
+        MERGE (f)-[:Import]->(p)
         `
 	case biz.Contains:
 		return `
         UNWIND $rels AS rel
         MATCH (p1:Package {id: rel.sourceID}), (p2:Package {id: rel.targetID})
-        CREATE (p1)-[:Contains]->(p2)
+        MERGE (p1)-[:Contains]->(p2)
         `
 	case biz.Extends:
 		return `
         UNWIND $rels AS rel
         MATCH (e1:Entity {id: rel.sourceID}), (e2:Entity {id: rel.targetID})
-        CREATE (e1)-[:Extends]->(e2)
+        MERGE (e1)-[:Extends]->(e2)
         `
 	}
 	return ""
 }
+
+func runWrite(ctx context.Context, session neo4j.SessionWithContext, query string, params map[string]any) error {
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		if _, err := tx.Run(ctx, query, params); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// deleteStaleNodes 删除上次分析后已不存在的包、文件和符号，以及重新分析文件的导入和出边
+func deleteStaleNodes(ctx context.Context, session neo4j.SessionWithContext, project *biz.Project) error {
+	if project.Changes != nil && len(project.Changes.Removed) > 0 {
+		removed := project.Changes.Removed
+		if err := runWrite(ctx, session, `
+        MATCH (n) WHERE (n:Entity OR n:Function OR n:Import) AND n.file_id IN $files
+        DETACH DELETE n`, map[string]any{"files": removed}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        UNWIND $files AS file
+        MATCH (fd:Field) WHERE fd.entity_id STARTS WITH file + ':'
+        DETACH DELETE fd`, map[string]any{"files": removed}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        MATCH (f:File) WHERE f.id IN $files
+        DETACH DELETE f`, map[string]any{"files": removed}); err != nil {
+			return err
+		}
+	}
+
+	// 重新分析的文件：删除已移除的符号，导入和出边随后重新创建
+	var fileIDs []string
+	var params []map[string]any
+	for _, file := range project.GetAnalyzedFiles() {
+		var keep, fields []string
+		for _, entity := range file.GetEntities() {
+			keep = append(keep, entity.ID)
+			for _, method := range entity.GetMethods() {
+				keep = append(keep, method.ID)
+			}
+			for _, field := range entity.GetFields() {
+				fields = append(fields, fieldID(field))
+			}
+		}
+		for _, fun := range file.GetFunctions() {
+			keep = append(keep, fun.ID)
+		}
+		fileIDs = append(fileIDs, file.ID)
+		params = append(params, map[string]any{"id": file.ID, "keep": keep, "fields": fields})
+	}
+	if len(fileIDs) > 0 {
+		if err := runWrite(ctx, session, `
+        UNWIND $files AS file
+        MATCH (n) WHERE (n:Entity OR n:Function) AND n.file_id = file.id AND NOT n.id IN file.keep
+        DETACH DELETE n`, map[string]any{"files": params}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        UNWIND $files AS file
+        MATCH (fd:Field) WHERE fd.entity_id STARTS WITH file.id + ':' AND NOT fd.id IN file.fields
+        DETACH DELETE fd`, map[string]any{"files": params}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        MATCH (i:Import) WHERE i.file_id IN $files
+        DETACH DELETE i`, map[string]any{"files": fileIDs}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        MATCH (n)-[r]->() WHERE (n:Entity OR n:Function) AND n.file_id IN $files
+        DELETE r`, map[string]any{"files": fileIDs}); err != nil {
+			return err
+		}
+		if err := runWrite(ctx, session, `
+        MATCH (f:File)-[r]->() WHERE f.id IN $files
+        DELETE r`, map[string]any{"files": fileIDs}); err != nil {
+			return err
+		}
+	}
+
+	// 删除已不存在的包
+	var pkgIDs []string
+	for _, pkg := range project.GetPackages() {
+		pkgIDs = append(pkgIDs, pkg.ID)
+	}
+	return runWrite(ctx, session, `
+        MATCH (p:Package) WHERE p.id STARTS WITH $prefix AND NOT p.id IN $ids
+        DETACH DELETE p`, map[string]any{"prefix": project.Root.ID + "@", "ids": pkgIDs})
+}
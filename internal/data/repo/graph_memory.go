@@ -0,0 +1,1023 @@
+package repo
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/biz"
+	"context"
+	"encoding/gob"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 图中节点的标签，与 Neo4j 的节点标签相同
+const (
+	labelPackage  = "Package"
+	labelFile     = "File"
+	labelEntity   = "Entity"
+	labelFunction = "Function"
+	labelField    = "Field"
+)
+
+// graphFileName memory 存储在数据目录中的文件
+const graphFileName = "graph.gob"
+
+// relationLabels 各类关系的源、目标节点标签，端点不存在或标签不符时不创建关系，与 getCreateQueryCypher 对应
+var relationLabels = map[string][2]string{
+	biz.DeclareEntity: {labelPackage, labelEntity},
+	biz.ContainsFile:  {labelPackage, labelFile},
+	biz.DeclareFunc:   {labelFile, labelFunction},
+	biz.HasMethod:     {labelEntity, labelFunction},
+	biz.HasFields:     {labelEntity, labelEntity},
+	biz.Implement:     {labelEntity, labelEntity},
+	biz.Call:          {labelFunction, labelFunction},
+	biz.DispatchesTo:  {labelFunction, labelFunction},
+	biz.Imports:       {labelFile, labelPackage},
+	biz.Contains:      {labelPackage, labelPackage},
+	biz.Extends:       {labelEntity, labelEntity},
+}
+
+// memNode 包、文件、实体、函数或字段节点，属性与 Neo4j 中的节点属性对应
+type memNode struct {
+	ID    string
+	Label string
+	Name  string
+	// ParentID 包的上级包，根包为快照 ID
+	ParentID string
+	Path     string
+	// Commit 根包分析的提交
+	Commit string
+	PkgID  string
+	FileID string
+	// EntID 函数的 ent_id：所在文件，接口方法为所属接口
+	EntID string
+	// EntityID 字段所属的实体
+	EntityID   string
+	Receiver   string
+	Scope      int64
+	Hash       string
+	Exported   bool
+	Entrypoint string
+	Test       bool
+	Span       biz.Span
+}
+
+// memEdgeKey 两个节点之间同一类型的关系只有一条
+type memEdgeKey struct {
+	Type, From, To string
+}
+
+// memEdge 节点之间的关系，Span 为 Call 的调用位置，Count 为 DependsOn 汇总的文件数，Depth 为 Tests 的调用层数
+type memEdge struct {
+	Type, From, To string
+	Span           biz.Span
+	Count          int
+	Depth          int
+}
+
+func (e *memEdge) key() memEdgeKey {
+	return memEdgeKey{Type: e.Type, From: e.From, To: e.To}
+}
+
+// memGraphData memory 存储持久化的内容
+type memGraphData struct {
+	Nodes map[string]*memNode
+	Edges map[memEdgeKey]*memEdge
+	// Imports 文件的导入语句
+	Imports map[string][]*biz.Import
+	// Repos 仓库及绑定的根包
+	Repos map[string]string
+}
+
+// memoryGraph 纯 Go 的内嵌代码图存储，dir 不为空时每次写入后保存到 dir 中的 graph.gob
+type memoryGraph struct {
+	mu   sync.RWMutex
+	file string
+	data memGraphData
+	// out、in 节点的出边和入边
+	out map[string]map[memEdgeKey]*memEdge
+	in  map[string]map[memEdgeKey]*memEdge
+}
+
+// NewMemoryGraphStore 创建内嵌代码图存储，dir 为空时只保存在内存中，否则从 dir 中加载已保存的图
+func NewMemoryGraphStore(dir string) (GraphStore, error) {
+	g := &memoryGraph{data: memGraphData{
+		Nodes:   make(map[string]*memNode),
+		Edges:   make(map[memEdgeKey]*memEdge),
+		Imports: make(map[string][]*biz.Import),
+		Repos:   make(map[string]string),
+	}}
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		g.file = filepath.Join(dir, graphFileName)
+		if err := g.load(); err != nil {
+			return nil, err
+		}
+	}
+	g.out = make(map[string]map[memEdgeKey]*memEdge)
+	g.in = make(map[string]map[memEdgeKey]*memEdge)
+	for _, e := range g.data.Edges {
+		g.index(e)
+	}
+	return g, nil
+}
+
+// load 读取已保存的图，文件不存在时为空图
+func (g *memoryGraph) load() error {
+	f, err := os.Open(g.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return gob.NewDecoder(f).Decode(&g.data)
+}
+
+// persist 写入临时文件后替换，写入中途失败不会损坏已保存的图
+func (g *memoryGraph) persist() error {
+	if g.file == "" {
+		return nil
+	}
+	f, err := os.CreateTemp(filepath.Dir(g.file), graphFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if err = gob.NewEncoder(f).Encode(&g.data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), g.file)
+}
+
+func (g *memoryGraph) index(e *memEdge) {
+	if g.out[e.From] == nil {
+		g.out[e.From] = make(map[memEdgeKey]*memEdge)
+	}
+	if g.in[e.To] == nil {
+		g.in[e.To] = make(map[memEdgeKey]*memEdge)
+	}
+	g.out[e.From][e.key()] = e
+	g.in[e.To][e.key()] = e
+}
+
+// mergeEdge 创建关系，已存在时返回已有的关系
+func (g *memoryGraph) mergeEdge(relationType, from, to string) *memEdge {
+	e := &memEdge{Type: relationType, From: from, To: to}
+	if existing, ok := g.data.Edges[e.key()]; ok {
+		return existing
+	}
+	g.data.Edges[e.key()] = e
+	g.index(e)
+	return e
+}
+
+func (g *memoryGraph) deleteEdge(e *memEdge) {
+	delete(g.data.Edges, e.key())
+	delete(g.out[e.From], e.key())
+	delete(g.in[e.To], e.key())
+}
+
+// deleteNode 删除节点及其所有关系
+func (g *memoryGraph) deleteNode(id string) {
+	for _, e := range g.out[id] {
+		g.deleteEdge(e)
+	}
+	for _, e := range g.in[id] {
+		g.deleteEdge(e)
+	}
+	delete(g.out, id)
+	delete(g.in, id)
+	delete(g.data.Nodes, id)
+}
+
+// deleteNodes 删除满足条件的节点
+func (g *memoryGraph) deleteNodes(match func(n *memNode) bool) {
+	for id, n := range g.data.Nodes {
+		if match(n) {
+			g.deleteNode(id)
+		}
+	}
+}
+
+// mergeNode 按 ID 查找节点，不存在时创建
+func (g *memoryGraph) mergeNode(id, label string) *memNode {
+	n, ok := g.data.Nodes[id]
+	if !ok {
+		n = &memNode{ID: id}
+		g.data.Nodes[id] = n
+	}
+	n.Label = label
+	return n
+}
+
+// node 指定标签的节点，不存在或标签不符时返回 nil
+func (g *memoryGraph) node(id string, labels ...string) *memNode {
+	n := g.data.Nodes[id]
+	if n == nil || !slices.Contains(labels, n.Label) {
+		return nil
+	}
+	return n
+}
+
+// sortedEdges 类型在 types 中的关系，types 为空时为所有关系，按源、目标、类型排序
+func sortedEdges(edges map[memEdgeKey]*memEdge, types ...string) []*memEdge {
+	var result []*memEdge
+	for _, e := range edges {
+		if len(types) == 0 || slices.Contains(types, e.Type) {
+			result = append(result, e)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+	return result
+}
+
+// sortedNodes 满足条件的节点，按 ID 排序
+func (g *memoryGraph) sortedNodes(match func(n *memNode) bool) []*memNode {
+	var nodes []*memNode
+	for _, n := range g.data.Nodes {
+		if match(n) {
+			nodes = append(nodes, n)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+func (g *memoryGraph) SaveProject(ctx context.Context, project *biz.Project) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.deleteStaleNodes(project)
+	for _, pkg := range project.GetPackages() {
+		n := g.mergeNode(pkg.ID, labelPackage)
+		n.Name, n.ParentID, n.Path = pkg.Name, pkg.ParentID, pkg.Path
+	}
+	if root := g.node(project.Root.ID, labelPackage); root != nil {
+		root.Commit = project.Commit
+	}
+	// 只保存重新分析的文件，其余节点保持不变
+	files := project.GetAnalyzedFiles()
+	tests := getTestNodes(files)
+	for _, file := range files {
+		n := g.mergeNode(file.ID, labelFile)
+		n.Name, n.PkgID, n.Hash, n.Test = file.Name, file.PkgID, file.Hash, file.Test
+		g.data.Imports[file.ID] = file.GetImports()
+	}
+	entities := getEntities(files)
+	for _, e := range entities {
+		n := g.mergeNode(e.ID, labelEntity)
+		n.Name, n.FileID, n.PkgID, n.Hash = e.Name, e.FileID, e.PkgID, e.DefinitionHash()
+		n.Exported, n.Test, n.Span = e.Exported, tests[e.FileID], e.Span
+	}
+	for _, f := range getFunctions(files) {
+		n := g.mergeNode(f.ID, labelFunction)
+		n.Name, n.PkgID, n.FileID, n.EntID, n.Receiver = f.Name, f.PkgID, f.FileId, f.EntId, f.Receiver
+		n.Scope, n.Hash, n.Exported, n.Entrypoint = int64(f.Scope), f.SourceHash(), f.Exported, f.Entrypoint()
+		n.Test, n.Span = f.InTestFile(), f.Span
+	}
+	for _, f := range getFields(entities) {
+		n := g.mergeNode(fieldID(f), labelField)
+		n.Name, n.EntityID, n.Test, n.Span = f.Name, f.StructID, tests[f.StructID], f.Span
+	}
+	for _, rel := range project.Relations {
+		labels, ok := relationLabels[rel.Type]
+		if !ok || g.node(rel.SourceID, labels[0]) == nil || g.node(rel.TargetID, labels[1]) == nil {
+			continue
+		}
+		e := g.mergeEdge(rel.Type, rel.SourceID, rel.TargetID)
+		if rel.Type == biz.Call {
+			e.Span = biz.Span{}
+			if rel.CallSite != nil {
+				e.Span = *rel.CallSite
+			}
+		}
+	}
+	g.savePackageDependencies(project.Root.ID)
+	g.saveTestRelations(project.Root.ID)
+	return g.persist()
+}
+
+// deleteStaleNodes 删除上次分析后已不存在的包、文件和符号，以及重新分析文件的导入和出边，与 Neo4j 的 deleteStaleNodes 对应
+func (g *memoryGraph) deleteStaleNodes(project *biz.Project) {
+	if project.Changes != nil && len(project.Changes.Removed) > 0 {
+		removed := project.Changes.Removed
+		g.deleteNodes(func(n *memNode) bool {
+			switch n.Label {
+			case labelEntity, labelFunction:
+				return slices.Contains(removed, n.FileID)
+			case labelField:
+				return slices.ContainsFunc(removed, func(file string) bool { return strings.HasPrefix(n.EntityID, file+":") })
+			case labelFile:
+				return slices.Contains(removed, n.ID)
+			}
+			return false
+		})
+		for _, file := range removed {
+			delete(g.data.Imports, file)
+		}
+	}
+
+	// 重新分析的文件：删除已移除的符号，导入和出边随后重新创建
+	for _, file := range project.GetAnalyzedFiles() {
+		keep := make(map[string]bool)
+		for _, entity := range file.GetEntities() {
+			keep[entity.ID] = true
+			for _, method := range entity.GetMethods() {
+				keep[method.ID] = true
+			}
+			for _, field := range entity.GetFields() {
+				keep[fieldID(field)] = true
+			}
+		}
+		for _, fun := range file.GetFunctions() {
+			keep[fun.ID] = true
+		}
+		var symbols []string
+		g.deleteNodes(func(n *memNode) bool {
+			switch n.Label {
+			case labelEntity, labelFunction:
+				if n.FileID != file.ID {
+					return false
+				}
+				symbols = append(symbols, n.ID)
+				return !keep[n.ID]
+			case labelField:
+				return strings.HasPrefix(n.EntityID, file.ID+":") && !keep[n.ID]
+			}
+			return false
+		})
+		delete(g.data.Imports, file.ID)
+		for _, id := range append(symbols, file.ID) {
+			for _, e := range g.out[id] {
+				g.deleteEdge(e)
+			}
+		}
+	}
+
+	// 删除已不存在的包
+	pkgs := make(map[string]bool)
+	for _, pkg := range project.GetPackages() {
+		pkgs[pkg.ID] = true
+	}
+	prefix := project.Root.ID + biz.PathSep
+	g.deleteNodes(func(n *memNode) bool {
+		return n.Label == labelPackage && strings.HasPrefix(n.ID, prefix) && !pkgs[n.ID]
+	})
+}
+
+// inRoot 节点是根包或其下的包
+func inRoot(id, rootPkgId string) bool {
+	return id == rootPkgId || strings.HasPrefix(id, rootPkgId+biz.PathSep)
+}
+
+// savePackageDependencies 按文件的导入重新汇总根包下包之间的 DependsOn 关系
+func (g *memoryGraph) savePackageDependencies(rootPkgId string) {
+	packages := g.sortedNodes(func(n *memNode) bool { return n.Label == labelPackage && inRoot(n.ID, rootPkgId) })
+	for _, p := range packages {
+		for _, e := range sortedEdges(g.out[p.ID], biz.DependsOn) {
+			g.deleteEdge(e)
+		}
+	}
+	for _, p := range packages {
+		counts := make(map[string]int)
+		for _, contains := range sortedEdges(g.out[p.ID], biz.ContainsFile) {
+			if g.node(contains.To, labelFile) == nil {
+				continue
+			}
+			for _, imp := range sortedEdges(g.out[contains.To], biz.Imports) {
+				if imp.To != p.ID && g.node(imp.To, labelPackage) != nil {
+					counts[imp.To]++
+				}
+			}
+		}
+		for q, count := range counts {
+			g.mergeEdge(biz.DependsOn, p.ID, q).Count = count
+		}
+	}
+}
+
+// saveTestRelations 按调用关系重新计算根包下测试函数到非测试代码的 Tests 关系
+func (g *memoryGraph) saveTestRelations(rootPkgId string) {
+	prefix := rootPkgId + biz.PathSep
+	var calls []*biz.Relation
+	var tests []string
+	testCode := make(map[string]bool)
+	functions := g.sortedNodes(func(n *memNode) bool { return n.Label == labelFunction && strings.HasPrefix(n.ID, prefix) })
+	for _, a := range functions {
+		for _, e := range sortedEdges(g.out[a.ID], biz.Tests) {
+			g.deleteEdge(e)
+		}
+		for _, e := range sortedEdges(g.out[a.ID], biz.Call, biz.DispatchesTo) {
+			b := g.node(e.To, labelFunction)
+			if b == nil {
+				continue
+			}
+			calls = append(calls, &biz.Relation{Type: e.Type, SourceID: a.ID, TargetID: b.ID})
+			testCode[b.ID] = testCode[b.ID] || b.Test
+		}
+		if a.Entrypoint == biz.EntrypointTest {
+			tests = append(tests, a.ID)
+		}
+		testCode[a.ID] = testCode[a.ID] || a.Test
+	}
+	for _, link := range biz.LinkTests(calls, tests, testCode) {
+		g.mergeEdge(biz.Tests, link.Test, link.Target).Depth = link.Depth
+	}
+}
+
+func (g *memoryGraph) GetPackageDependencies(ctx context.Context, scope string) ([]*v1.PackageNode, []*v1.PackageDependency, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var packages []*v1.PackageNode
+	var dependencies []*v1.PackageDependency
+	seen := make(map[string]bool)
+	for _, p := range g.sortedNodes(func(n *memNode) bool { return n.Label == labelPackage && inRoot(n.ID, scope) }) {
+		for _, e := range sortedEdges(g.out[p.ID], biz.DependsOn) {
+			q := g.node(e.To, labelPackage)
+			if q == nil {
+				continue
+			}
+			for _, n := range []*memNode{p, q} {
+				if !seen[n.ID] {
+					seen[n.ID] = true
+					packages = append(packages, &v1.PackageNode{Id: n.ID, Name: n.Name, ParentId: n.ParentID})
+				}
+			}
+			dependencies = append(dependencies, &v1.PackageDependency{Source: p.ID, Target: q.ID, Count: int32(e.Count)})
+		}
+	}
+	return packages, dependencies, nil
+}
+
+func (g *memoryGraph) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	hashes := make(map[string]string)
+	prefix := rootPkgId + biz.PathSep
+	for id, n := range g.data.Nodes {
+		if n.Label == labelFile && strings.HasPrefix(id, prefix) {
+			hashes[id] = n.Hash
+		}
+	}
+	return hashes, nil
+}
+
+func (g *memoryGraph) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var files []string
+	seen := make(map[string]bool)
+	for _, t := range g.sortedNodes(func(n *memNode) bool {
+		return (n.Label == labelEntity || n.Label == labelFunction) && slices.Contains(fileIds, n.FileID)
+	}) {
+		for _, e := range sortedEdges(g.in[t.ID]) {
+			s := g.node(e.From, labelEntity, labelFunction)
+			if s == nil || slices.Contains(fileIds, s.FileID) || seen[s.FileID] {
+				continue
+			}
+			seen[s.FileID] = true
+			files = append(files, s.FileID)
+		}
+	}
+	return files, nil
+}
+
+// CreateRepo 记录仓库，根包在分析后通过 BindRepoRoot 绑定
+func (g *memoryGraph) CreateRepo(ctx context.Context, req *RepoModel) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.data.Repos[req.ID] = ""
+	return req.ID, g.persist()
+}
+
+func (g *memoryGraph) BindRepoRoot(ctx context.Context, repoId, rootPkgId string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.data.Repos[repoId]; !ok || g.node(rootPkgId, labelPackage) == nil {
+		return nil
+	}
+	g.data.Repos[repoId] = rootPkgId
+	return g.persist()
+}
+
+// DeleteRepo 删除仓库及其所有快照中的节点
+func (g *memoryGraph) DeleteRepo(ctx context.Context, id string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.data.Repos, id)
+	for _, prefix := range []string{id + biz.SnapshotSep, id + biz.PathSep} {
+		g.deleteSnapshot(prefix)
+	}
+	return g.persist()
+}
+
+// DeleteSnapshot 删除 ID 以快照 ID 为前缀的所有节点
+func (g *memoryGraph) DeleteSnapshot(ctx context.Context, id string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.deleteSnapshot(id + biz.PathSep)
+	return g.persist()
+}
+
+// CopySnapshot 复制快照 from 的节点、关系和导入，节点 ID 及指向其他节点的属性换成快照 to 的前缀
+// 与 Neo4j 一样，DependsOn 和 Tests 在保存时重新计算，不复制
+func (g *memoryGraph) CopySnapshot(ctx context.Context, from, to string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	prefix := from + biz.PathSep
+	rename := func(id string) string {
+		if id == from || strings.HasPrefix(id, prefix) {
+			return to + strings.TrimPrefix(id, from)
+		}
+		return id
+	}
+	for _, n := range g.sortedNodes(func(n *memNode) bool { return strings.HasPrefix(n.ID, prefix) }) {
+		c := *n
+		c.ID, c.ParentID, c.PkgID, c.FileID = rename(n.ID), rename(n.ParentID), rename(n.PkgID), rename(n.FileID)
+		c.EntID, c.EntityID = rename(n.EntID), rename(n.EntityID)
+		g.data.Nodes[c.ID] = &c
+	}
+	for _, e := range sortedEdges(g.data.Edges) {
+		if _, ok := relationLabels[e.Type]; !ok || !strings.HasPrefix(e.From, prefix) || !strings.HasPrefix(e.To, prefix) {
+			continue
+		}
+		c := *e
+		c.From, c.To = rename(e.From), rename(e.To)
+		g.data.Edges[c.key()] = &c
+		g.index(&c)
+	}
+	copied := make(map[string][]*biz.Import)
+	for file, imports := range g.data.Imports {
+		if !strings.HasPrefix(file, prefix) {
+			continue
+		}
+		for _, imp := range imports {
+			c := *imp
+			c.FileId = rename(imp.FileId)
+			copied[rename(file)] = append(copied[rename(file)], &c)
+		}
+	}
+	for file, imports := range copied {
+		g.data.Imports[file] = imports
+	}
+	return g.persist()
+}
+
+// deleteSnapshot 删除 ID 以 prefix 开头的节点和文件的导入
+func (g *memoryGraph) deleteSnapshot(prefix string) {
+	for file := range g.data.Imports {
+		if strings.HasPrefix(file, prefix) {
+			delete(g.data.Imports, file)
+		}
+	}
+	g.deleteNodes(func(n *memNode) bool { return strings.HasPrefix(n.ID, prefix) })
+}
+
+func (g *memoryGraph) GetSnapshotGraph(ctx context.Context, snapshotId string) (*biz.SnapshotGraph, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	prefix := snapshotId + biz.PathSep
+	graph := &biz.SnapshotGraph{}
+	for _, n := range g.sortedNodes(func(n *memNode) bool { return strings.HasPrefix(n.ID, prefix) }) {
+		node := &biz.GraphNode{ID: n.ID, Name: n.Name, Exported: true}
+		switch n.Label {
+		case labelPackage:
+			node.ParentID = n.ParentID
+			graph.Packages = append(graph.Packages, node)
+		case labelFile:
+			node.ParentID, node.Content = n.PkgID, n.Hash
+			graph.Files = append(graph.Files, node)
+		case labelEntity:
+			node.ParentID, node.Content, node.Exported = n.FileID, n.Hash, n.Exported
+			graph.Entities = append(graph.Entities, node)
+		case labelFunction:
+			node.ParentID, node.Content, node.Exported = n.FileID, n.Hash, n.Exported
+			graph.Functions = append(graph.Functions, node)
+		default:
+			continue
+		}
+		for _, e := range sortedEdges(g.out[n.ID]) {
+			graph.Relations = append(graph.Relations, &biz.Relation{Type: e.Type, SourceID: e.From, TargetID: e.To})
+		}
+	}
+	return graph, nil
+}
+
+// symbol 按 symbolReturn 的规则转换符号，字段的文件和是否测试取所属的实体
+func (g *memoryGraph) symbol(n *memNode) *v1.Symbol {
+	s := &v1.Symbol{
+		Id:         n.ID,
+		Name:       n.Name,
+		Kind:       v1.SymbolKind(v1.SymbolKind_value["Symbol"+n.Label]),
+		Exported:   true,
+		FileId:     n.FileID,
+		Span:       memSpan(n.Span),
+		Entrypoint: n.Entrypoint,
+		Test:       n.Test,
+	}
+	switch n.Label {
+	case labelPackage:
+		s.ParentId = n.ParentID
+	case labelFile:
+		s.ParentId = n.PkgID
+	case labelEntity, labelFunction:
+		s.ParentId, s.Exported = n.PkgID, n.Exported
+	case labelField:
+		s.ParentId = n.EntityID
+		if owner := g.node(n.EntityID, labelEntity); owner != nil {
+			s.FileId = owner.FileID
+		}
+	}
+	return s
+}
+
+// memSpan 转换源码位置，未记录位置时返回 nil
+func memSpan(span biz.Span) *v1.SourceSpan {
+	if span.StartLine <= 0 {
+		return nil
+	}
+	return &v1.SourceSpan{StartLine: int32(span.StartLine), StartColumn: int32(span.StartColumn), EndLine: int32(span.EndLine), EndColumn: int32(span.EndColumn)}
+}
+
+// SearchSymbols 扫描快照中的节点，按名称匹配度排序后取前 limit 个
+func (g *memoryGraph) SearchSymbols(ctx context.Context, query *biz.SymbolQuery) ([]*v1.Symbol, error) {
+	var re *regexp.Regexp
+	if query.Mode == v1.SymbolMatchMode_MatchRegex {
+		var err error
+		if re, err = regexp.Compile(query.Query); err != nil {
+			return nil, err
+		}
+	}
+	labels := make([]string, 0, len(query.Kinds))
+	for _, kind := range query.Kinds {
+		labels = append(labels, strings.TrimPrefix(kind.String(), "Symbol"))
+	}
+	if len(labels) == 0 {
+		labels = []string{labelPackage, labelFile, labelEntity, labelFunction, labelField}
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	prefix := query.Snapshot + biz.PathSep
+	type candidate struct {
+		symbol  *v1.Symbol
+		quality float64
+	}
+	var candidates []candidate
+	for _, n := range g.data.Nodes {
+		if !strings.HasPrefix(n.ID, prefix) || !slices.Contains(labels, n.Label) {
+			continue
+		}
+		if query.Package != "" && n.ID != query.Package && !strings.HasPrefix(n.ID, query.Package+biz.PathSep) && !strings.HasPrefix(n.ID, query.Package+":") {
+			continue
+		}
+		quality := biz.SymbolMatchQuality(n.Name, query.Query, query.Mode, re)
+		if quality == 0 {
+			continue
+		}
+		s := g.symbol(n)
+		if query.ExportedOnly && !s.Exported || query.ExcludeTests && s.Test {
+			continue
+		}
+		candidates = append(candidates, candidate{symbol: s, quality: quality})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.quality != b.quality {
+			return a.quality > b.quality
+		}
+		return a.symbol.Id < b.symbol.Id
+	})
+	symbols := make([]*v1.Symbol, 0, min(len(candidates), query.Limit))
+	for _, c := range candidates[:min(len(candidates), query.Limit)] {
+		c.symbol.FanIn = int32(len(sortedEdges(g.in[c.symbol.Id], query.ReferenceTypes...)))
+		symbols = append(symbols, c.symbol)
+	}
+	return symbols, nil
+}
+
+func (g *memoryGraph) LookupSymbols(ctx context.Context, lookup *biz.SymbolLookup) ([]*v1.Symbol, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.lookupSymbols(lookup), nil
+}
+
+// lookupSymbols 按 ID、名称或所在文件查找快照中的实体、函数和字段，按 ID 排序
+func (g *memoryGraph) lookupSymbols(lookup *biz.SymbolLookup) []*v1.Symbol {
+	prefix := lookup.Snapshot + biz.PathSep
+	var symbols []*v1.Symbol
+	for _, n := range g.sortedNodes(func(n *memNode) bool {
+		return (n.Label == labelEntity || n.Label == labelFunction || n.Label == labelField) && strings.HasPrefix(n.ID, prefix) &&
+			(len(lookup.IDs) == 0 || slices.Contains(lookup.IDs, n.ID)) &&
+			(len(lookup.Names) == 0 || slices.Contains(lookup.Names, n.Name))
+	}) {
+		s := g.symbol(n)
+		if lookup.FileID == "" || s.FileId == lookup.FileID {
+			symbols = append(symbols, s)
+		}
+	}
+	return symbols
+}
+
+func (g *memoryGraph) QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*biz.ImpactEdge, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var edges []*biz.ImpactEdge
+	for _, id := range slices.Sorted(slices.Values(ids)) {
+		for _, e := range sortedEdges(g.in[id], relationTypes...) {
+			if n := g.node(e.From, labelEntity, labelFunction); n != nil {
+				edges = append(edges, &biz.ImpactEdge{To: id, Relation: e.Type, Source: g.symbol(n)})
+			}
+		}
+	}
+	return edges[:min(len(edges), limit)], nil
+}
+
+func (g *memoryGraph) GetFileSymbols(ctx context.Context, fileID string) (*biz.FileSymbols, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	snapshot, _ := biz.SplitNodeID(fileID)
+	file := &biz.FileSymbols{Declarations: g.lookupSymbols(&biz.SymbolLookup{Snapshot: snapshot, FileID: fileID})}
+	for _, caller := range g.sortedNodes(func(n *memNode) bool { return n.Label == labelFunction && n.FileID == fileID }) {
+		for _, e := range sortedEdges(g.out[caller.ID], biz.Call) {
+			if callee := g.node(e.To, labelFunction); callee != nil && e.Span.StartLine > 0 {
+				file.Calls = append(file.Calls, &biz.FileCall{Callee: g.symbol(callee), Site: e.Span})
+			}
+		}
+	}
+	for _, imp := range g.data.Imports[fileID] {
+		file.Imports = append(file.Imports, &biz.Import{FileId: fileID, Path: imp.Path, Name: imp.Name})
+	}
+	if g.node(fileID, labelFile) != nil {
+		for _, e := range sortedEdges(g.out[fileID], biz.Imports) {
+			if g.node(e.To, labelPackage) != nil {
+				file.Packages = append(file.Packages, e.To)
+			}
+		}
+	}
+	return file, nil
+}
+
+// GetReferenceFiles 可能引用符号的文件，字段和方法还包括调用所属类型其他方法的文件
+func (g *memoryGraph) GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	n := g.node(id, labelEntity, labelFunction, labelField)
+	if n == nil {
+		return []string{}, nil
+	}
+	owner := g.node(n.EntityID, labelEntity)
+	if owner == nil {
+		for _, e := range sortedEdges(g.in[id], biz.HasMethod) {
+			if owner = g.node(e.From, labelEntity); owner != nil {
+				break
+			}
+		}
+	}
+	pkg := n.PkgID
+	if pkg == "" && owner != nil {
+		pkg = owner.PkgID
+	}
+	files := make(map[string]bool)
+	if pkg != "" {
+		for _, f := range g.data.Nodes {
+			if f.Label == labelFile && f.PkgID == pkg {
+				files[f.ID] = true
+			}
+		}
+		for _, e := range g.in[pkg] {
+			if e.Type == biz.Imports && g.node(e.From, labelFile) != nil {
+				files[e.From] = true
+			}
+		}
+	}
+	callees := []string{id}
+	if owner != nil {
+		for _, e := range g.out[owner.ID] {
+			if e.Type == biz.HasMethod && g.node(e.To, labelFunction) != nil {
+				callees = append(callees, e.To)
+			}
+		}
+	}
+	for _, callee := range callees {
+		for _, e := range sortedEdges(g.in[callee], biz.Call, biz.DispatchesTo) {
+			if caller := g.node(e.From, labelFunction); caller != nil && caller.FileID != "" {
+				files[caller.FileID] = true
+			}
+		}
+	}
+	result := slices.Sorted(func(yield func(string) bool) {
+		for file := range files {
+			if !yield(file) {
+				return
+			}
+		}
+	})
+	return result[:min(len(result), limit)], nil
+}
+
+func (g *memoryGraph) GetRepoTree(ctx context.Context, id string) (packages []*v1.PackageNode, files []*v1.FileNode, err error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	roots := g.sortedNodes(func(n *memNode) bool { return n.Label == labelPackage && n.ParentID == id })
+	seen := make(map[string]bool)
+	for _, root := range roots {
+		queue := []*memNode{root}
+		seen[root.ID] = true
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			packages = append(packages, &v1.PackageNode{Id: p.ID, Name: p.Name, ParentId: p.ParentID})
+			for _, e := range sortedEdges(g.out[p.ID], biz.Contains) {
+				if child := g.node(e.To, labelPackage); child != nil && !seen[child.ID] {
+					seen[child.ID] = true
+					queue = append(queue, child)
+				}
+			}
+		}
+	}
+	seenFiles := make(map[string]bool)
+	for _, root := range roots {
+		for _, p := range g.sortedNodes(func(n *memNode) bool { return n.Label == labelPackage && strings.HasPrefix(n.ID, root.ID) }) {
+			for _, e := range sortedEdges(g.out[p.ID], biz.ContainsFile) {
+				if f := g.node(e.To, labelFile); f != nil && !seenFiles[f.ID] {
+					seenFiles[f.ID] = true
+					files = append(files, &v1.FileNode{Id: f.ID, Name: f.Name, PkgId: f.PkgID})
+				}
+			}
+		}
+	}
+	return packages, files, nil
+}
+
+// callRelationship 调用关系，与 callRelationshipReturn 的列对应
+func (g *memoryGraph) callRelationship(e *memEdge) *v1.CallRelationship {
+	caller, callee := g.data.Nodes[e.From], g.data.Nodes[e.To]
+	return &v1.CallRelationship{
+		CallerId:       caller.ID,
+		CallerName:     caller.Name,
+		CalleeId:       callee.ID,
+		CalleeName:     callee.Name,
+		CalleeFileId:   callee.FileID,
+		CallerFileId:   caller.FileID,
+		CalleeScope:    callee.Scope,
+		CallerScope:    caller.Scope,
+		CalleeEntityId: callee.EntID,
+		CallerEntityId: caller.EntID,
+		Relation:       e.Type,
+		CallSite:       memSpan(e.Span),
+	}
+}
+
+// QueryCallEdges 查询与 ids 中函数直接相连的调用关系，只展开一层
+func (g *memoryGraph) QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*biz.CallEdge, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var edges []*biz.CallEdge
+	for _, id := range slices.Sorted(slices.Values(ids)) {
+		if g.node(id, labelFunction) == nil {
+			continue
+		}
+		var from []*biz.CallEdge
+		add := func(e *memEdge, to string) {
+			if b := g.node(to, labelFunction); b != nil && !(excludeTests && b.Test) {
+				from = append(from, &biz.CallEdge{From: id, To: to, Relationship: g.callRelationship(e)})
+			}
+		}
+		if direction != v1.CallDirection_CallIncoming {
+			for _, e := range sortedEdges(g.out[id], relationTypes...) {
+				add(e, e.To)
+			}
+		}
+		if direction != v1.CallDirection_CallOutgoing {
+			for _, e := range sortedEdges(g.in[id], relationTypes...) {
+				add(e, e.From)
+			}
+		}
+		sort.SliceStable(from, func(i, j int) bool { return from[i].To < from[j].To })
+		edges = append(edges, from...)
+	}
+	return edges[:min(len(edges), limit)], nil
+}
+
+// QueryCallersChain 反向广度优先查找调用方，路径中间的函数是 stopAt 中的入口时不再继续向上
+func (g *memoryGraph) QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error) {
+	relTypes := []string{biz.Call}
+	if req.FollowDispatch {
+		relTypes = append(relTypes, biz.DispatchesTo)
+	}
+	var entrypoints []string
+	var exported bool
+	for _, kind := range req.StopAt {
+		switch kind {
+		case v1.EntrypointKind_EntrypointMain:
+			entrypoints = append(entrypoints, biz.EntrypointMain)
+		case v1.EntrypointKind_EntrypointHTTP:
+			entrypoints = append(entrypoints, biz.EntrypointHTTP)
+		case v1.EntrypointKind_EntrypointExported:
+			exported = true
+		case v1.EntrypointKind_EntrypointTest:
+			entrypoints = append(entrypoints, biz.EntrypointTest)
+		}
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	target := g.node(req.Id, labelFunction)
+	if target == nil || req.ExcludeTests && target.Test {
+		return nil, nil
+	}
+	var relationships []*v1.CallRelationship
+	seen := make(map[string]bool)
+	visited := map[string]bool{target.ID: true}
+	level := []string{target.ID}
+	for depth := 1; depth <= int(req.MaxDepth) && len(level) > 0; depth++ {
+		var next []string
+		for _, id := range level {
+			for _, e := range sortedEdges(g.in[id], relTypes...) {
+				caller := g.node(e.From, labelFunction)
+				if caller == nil || req.ExcludeTests && caller.Test {
+					continue
+				}
+				if key := e.From + "->" + e.To; !seen[key] {
+					seen[key] = true
+					relationships = append(relationships, g.callRelationship(e))
+				}
+				// 入口函数只作为路径的起点
+				stop := slices.Contains(entrypoints, caller.Entrypoint) || exported && caller.Exported
+				if !visited[caller.ID] && !stop {
+					visited[caller.ID] = true
+					next = append(next, caller.ID)
+				}
+			}
+		}
+		level = next
+	}
+	return relationships, nil
+}
+
+// QueryFunctionTests 通过 Tests 关系指向函数的测试函数，按层数、ID 排序
+func (g *memoryGraph) QueryFunctionTests(ctx context.Context, id string) ([]*v1.FunctionTest, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	tests := []*v1.FunctionTest{}
+	for _, e := range sortedEdges(g.in[id], biz.Tests) {
+		if n := g.node(e.From, labelFunction); n != nil {
+			tests = append(tests, &v1.FunctionTest{Symbol: g.symbol(n), Depth: int32(e.Depth)})
+		}
+	}
+	sort.SliceStable(tests, func(i, j int) bool { return tests[i].Depth < tests[j].Depth })
+	return tests, nil
+}
+
+func (g *memoryGraph) GetFunctionByFileId(ctx context.Context, fileId string) ([]*v1.Function, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var functions []*v1.Function
+	for _, n := range g.sortedNodes(func(n *memNode) bool { return n.Label == labelFunction && n.EntID == fileId }) {
+		functions = append(functions, &v1.Function{Id: n.ID, Name: n.Name, Receiver: n.Receiver, FileId: n.EntID, Span: memSpan(n.Span)})
+	}
+	return functions, nil
+}
+
+func (g *memoryGraph) GetImplementByEntityId(ctx context.Context, entityID string) ([]*v1.Entity, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var entities []*v1.Entity
+	if g.node(entityID, labelEntity) == nil {
+		return entities, nil
+	}
+	for _, e := range sortedEdges(g.in[entityID], biz.Implement) {
+		impl := g.node(e.From, labelEntity)
+		if impl == nil {
+			continue
+		}
+		entity := &v1.Entity{Id: impl.ID, Name: impl.Name, FileId: impl.FileID}
+		for _, m := range sortedEdges(g.out[impl.ID], biz.HasMethod) {
+			if f := g.node(m.To, labelFunction); f != nil {
+				entity.Functions = append(entity.Functions, &v1.Function{Id: f.ID, FileId: f.EntID, Name: f.Name, Receiver: f.Receiver})
+			}
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
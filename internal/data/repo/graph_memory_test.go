@@ -0,0 +1,205 @@
+package repo
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/biz"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// graphProjectRepo 分析时只用到文件哈希、依赖文件和保存，其余方法不实现
+type graphProjectRepo struct {
+	biz.ProjectRepo
+	store GraphStore
+}
+
+func (r graphProjectRepo) SaveProject(ctx context.Context, project *biz.Project) error {
+	return r.store.SaveProject(ctx, project)
+}
+
+func (r graphProjectRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	return r.store.GetFileHashes(ctx, rootPkgId)
+}
+
+func (r graphProjectRepo) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	return r.store.GetDependentFiles(ctx, fileIds)
+}
+
+// analyzeInto 把源码写入 root 后分析并保存到 store
+func analyzeInto(t *testing.T, store GraphStore, root string, files map[string]string) *biz.Project {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	project := biz.NewProject(&v1.Repo{Id: "repo", Language: v1.Language_Golang}, nil)
+	if err := project.Analyze(context.Background(), root, graphProjectRepo{store: store}); err != nil {
+		t.Fatal(err)
+	}
+	return project
+}
+
+func TestMemoryGraphStore(t *testing.T) {
+	ctx := context.Background()
+	dir, src := t.TempDir(), t.TempDir()
+	store, err := NewMemoryGraphStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	project := analyzeInto(t, store, src, map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"store/store.go": `package store
+
+type Store struct {
+	Name string
+}
+
+func (s *Store) Save(name string) {
+	s.Name = name
+}
+
+func New() *Store {
+	return &Store{}
+}
+`,
+		"store/store_test.go": `package store
+
+import "testing"
+
+func TestSave(t *testing.T) {
+	New().Save("a")
+}
+`,
+		"main.go": `package main
+
+import "example.com/app/store"
+
+func main() {
+	run(store.New())
+}
+
+func run(s *store.Store) {
+	s.Save("b")
+}
+`,
+	})
+	root := project.Root.ID
+	snapshot := project.Root.ParentID
+	save := root + "@store:Store.Save"
+
+	callers, err := store.QueryCallersChain(ctx, &v1.CallersChainReq{Id: save, MaxDepth: 5, ExcludeTests: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range callers {
+		got = append(got, strings.TrimPrefix(c.CallerId, root)+" -> "+strings.TrimPrefix(c.CalleeId, root))
+	}
+	if want := []string{":run -> @store:Store.Save", ":main -> :run"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("callers = %v, want %v", got, want)
+	}
+	tests, err := store.QueryFunctionTests(ctx, save)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != 1 || tests[0].Symbol.Name != "TestSave" || tests[0].Depth != 1 || !tests[0].Symbol.Test {
+		t.Errorf("tests = %v", tests)
+	}
+	symbols, err := store.SearchSymbols(ctx, &biz.SymbolQuery{Snapshot: snapshot, Query: "sav", Mode: v1.SymbolMatchMode_MatchPrefix,
+		ExcludeTests: true, ReferenceTypes: []string{biz.Call}, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 1 || symbols[0].Id != save || symbols[0].FanIn != 2 || symbols[0].FileId != root+"@store@store.go" {
+		t.Errorf("symbols = %v", symbols)
+	}
+	_, dependencies, err := store.GetPackageDependencies(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dependencies) != 1 || dependencies[0].Source != root || dependencies[0].Target != root+"@store" || dependencies[0].Count != 1 {
+		t.Errorf("dependencies = %v", dependencies)
+	}
+	packages, files, err := store.GetRepoTree(ctx, snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packages) != 2 || len(files) != 3 {
+		t.Errorf("packages = %v, files = %v", packages, files)
+	}
+
+	// 重新打开数据目录后读取已保存的图，删除测试文件并修改调用后重新分析
+	if store, err = NewMemoryGraphStore(dir); err != nil {
+		t.Fatal(err)
+	}
+	hashes, err := store.GetFileHashes(ctx, root)
+	if err != nil || len(hashes) != 3 {
+		t.Fatalf("hashes = %v, err = %v", hashes, err)
+	}
+	if err = os.Remove(filepath.Join(src, "store/store_test.go")); err != nil {
+		t.Fatal(err)
+	}
+	analyzeInto(t, store, src, map[string]string{
+		"main.go": "package main\n\nimport \"example.com/app/store\"\n\nfunc main() {\n\trun(store.New())\n}\n\nfunc run(s *store.Store) {}\n",
+	})
+	edges, err := store.QueryCallEdges(ctx, []string{save}, v1.CallDirection_CallIncoming, []string{biz.Call}, 10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tests, _ = store.QueryFunctionTests(ctx, save); len(edges) != 0 || len(tests) != 0 {
+		t.Errorf("edges = %v, tests = %v", edges, tests)
+	}
+	if hashes, _ = store.GetFileHashes(ctx, root); len(hashes) != 2 {
+		t.Errorf("hashes = %v", hashes)
+	}
+
+	if err = store.DeleteSnapshot(ctx, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if hashes, _ = store.GetFileHashes(ctx, root); len(hashes) != 0 {
+		t.Errorf("hashes after delete = %v", hashes)
+	}
+}
+
+func TestMemoryGraphCopySnapshot(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewMemoryGraphStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	project := analyzeInto(t, store, t.TempDir(), map[string]string{
+		"a.go": "package p\n\nimport \"fmt\"\n\nfunc A() { B() }\n\nfunc B() { fmt.Println() }\n",
+	})
+	from, to := project.SnapshotID(), "repo~222222222222"
+	if err = store.CopySnapshot(ctx, from, to); err != nil {
+		t.Fatal(err)
+	}
+	// 复制的节点、关系和导入都在新快照中，原快照不变
+	root := to + strings.TrimPrefix(project.Root.ID, from)
+	for _, r := range []string{project.Root.ID, root} {
+		hashes, _ := store.GetFileHashes(ctx, r)
+		edges, _ := store.QueryCallEdges(ctx, []string{r + ":B"}, v1.CallDirection_CallIncoming, []string{biz.Call}, 10, false)
+		if len(hashes) != 1 || len(edges) != 1 || edges[0].Relationship.CallerId != r+":A" {
+			t.Errorf("root %s: hashes = %v, edges = %v", r, hashes, edges)
+		}
+	}
+	symbols, err := store.GetFileSymbols(ctx, root+"@a.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols.Imports) != 1 || symbols.Imports[0].FileId != root+"@a.go" {
+		t.Errorf("imports = %v", symbols.Imports)
+	}
+	if packages, _, _ := store.GetRepoTree(ctx, to); len(packages) != 1 || packages[0].ParentId != to {
+		t.Errorf("packages = %v", packages)
+	}
+}
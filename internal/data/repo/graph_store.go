@@ -0,0 +1,65 @@
+package repo
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"codewiki/internal/biz"
+	"codewiki/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// 代码图存储的驱动
+const (
+	GraphDriverNeo4j  = "neo4j"
+	GraphDriverMemory = "memory"
+)
+
+// GraphStore 代码图存储，compositeRepo 的图操作都委托给它
+// projectRepo 为 Neo4j 实现，memoryGraph 为内嵌实现
+type GraphStore interface {
+	SaveProject(ctx context.Context, project *biz.Project) error
+	GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error)
+	GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error)
+	QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*biz.CallEdge, error)
+	QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error)
+	QueryFunctionTests(ctx context.Context, id string) ([]*v1.FunctionTest, error)
+	SearchSymbols(ctx context.Context, query *biz.SymbolQuery) ([]*v1.Symbol, error)
+	LookupSymbols(ctx context.Context, lookup *biz.SymbolLookup) ([]*v1.Symbol, error)
+	QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*biz.ImpactEdge, error)
+	GetFileSymbols(ctx context.Context, fileID string) (*biz.FileSymbols, error)
+	GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error)
+	GetSnapshotGraph(ctx context.Context, snapshotId string) (*biz.SnapshotGraph, error)
+	GetPackageDependencies(ctx context.Context, scope string) ([]*v1.PackageNode, []*v1.PackageDependency, error)
+	GetRepoTree(ctx context.Context, id string) ([]*v1.PackageNode, []*v1.FileNode, error)
+	GetFunctionByFileId(ctx context.Context, fileId string) ([]*v1.Function, error)
+	GetImplementByEntityId(ctx context.Context, entityID string) ([]*v1.Entity, error)
+	CreateRepo(ctx context.Context, req *RepoModel) (string, error)
+	BindRepoRoot(ctx context.Context, repoId, rootPkgId string) error
+	DeleteRepo(ctx context.Context, id string) error
+	DeleteSnapshot(ctx context.Context, id string) error
+	CopySnapshot(ctx context.Context, from, to string) error
+}
+
+// NewGraphStore 按 data.graph.driver 创建代码图存储，只有 Neo4j 需要 driver
+func NewGraphStore(c *conf.Data, driver neo4j.DriverWithContext) (GraphStore, error) {
+	switch c.GetGraph().GetDriver() {
+	case "", GraphDriverNeo4j:
+		return NewNeo4jGraphStore(driver)
+	case GraphDriverMemory:
+		return NewMemoryGraphStore(c.GetGraph().GetDir())
+	}
+	return nil, fmt.Errorf("unknown graph driver %s", c.GetGraph().GetDriver())
+}
+
+// NewNeo4jGraphStore 创建 Neo4j 代码图存储，启动时创建符号名称的全文索引
+func NewNeo4jGraphStore(driver neo4j.DriverWithContext) (GraphStore, error) {
+	if driver == nil {
+		return nil, errors.New("neo4j is not configured")
+	}
+	if err := ensureSymbolIndex(context.Background(), driver); err != nil {
+		return nil, err
+	}
+	return &projectRepo{neo4jDriver: driver}, nil
+}
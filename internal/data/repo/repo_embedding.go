@@ -4,32 +4,209 @@ import (
 	"codewiki/internal/biz"
 	"codewiki/internal/conf"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/index"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus/client/v2/column"
 	"github.com/milvus-io/milvus/client/v2/milvusclient"
 )
 
+const (
+	// defaultMilvusMaxLength 文本字段默认的最大长度
+	defaultMilvusMaxLength = 65535
+	// milvusScopeMaxLength scope 字段的最大长度
+	milvusScopeMaxLength = 64
+	// milvusDefaultPartition collection 自带的分区
+	milvusDefaultPartition = "_default"
+)
+
+// Milvus 每个项目一个 collection，名称为去掉 "-" 的项目名，仓库的代码块保存在以仓库 ID 命名的分区中
 type Milvus struct {
-	client     *milvusclient.Client
-	milvusAddr string
+	client    *milvusclient.Client
+	dimension int
+	options   *conf.Data_VectorStore_Milvus
+
+	mu sync.Mutex
+	// loaded 已确认存在并加载的分区，键为 collection/分区
+	loaded map[string]bool
 }
 
-func NewMilvus(data *conf.Data) biz.IndexerRepo {
-	if data.Embedding == nil && len(data.Embedding.ApiURL) > 0 {
-		return nil
+// NewMilvus 连接 vectorStore.target，为空时使用 embedding.apiURL
+func NewMilvus(data *conf.Data) (biz.IndexerRepo, error) {
+	address := data.GetVectorStore().GetTarget()
+	if address == "" {
+		address = data.GetEmbedding().GetApiURL()
+	}
+	if address == "" {
+		return nil, errors.New("milvus address is empty")
 	}
 	client, err := milvusclient.New(context.Background(), &milvusclient.ClientConfig{
-		Address: data.Embedding.ApiURL,
+		Address: address,
 	})
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	return &Milvus{
+		client:    client,
+		dimension: int(data.GetEmbedding().GetDimension()),
+		options:   data.GetVectorStore().GetMilvus(),
+		loaded:    make(map[string]bool),
+	}, nil
+}
+
+// milvusName collection 和分区名只能包含字母、数字和下划线，去掉 "-"（与已有的 collection 名一致），其他字符替换为下划线
+func milvusName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ReplaceAll(name, "-", "") {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	s := b.String()
+	if s == "" || s[0] >= '0' && s[0] <= '9' {
+		s = "_" + s
+	}
+	return s
+}
+
+// milvusPartition 仓库代码块所在的分区
+func milvusPartition(partition string) string {
+	return milvusName("repo_" + partition)
+}
+
+// milvusIndexParams 向量索引参数，默认为余弦距离的 AUTOINDEX
+func milvusIndexParams(options *conf.Data_VectorStore_Milvus) map[string]string {
+	params := map[string]string{
+		index.IndexTypeKey:  string(index.AUTOINDEX),
+		index.MetricTypeKey: string(entity.COSINE),
+	}
+	if options.GetIndexType() != "" {
+		params[index.IndexTypeKey] = options.GetIndexType()
+	}
+	if options.GetMetricType() != "" {
+		params[index.MetricTypeKey] = options.GetMetricType()
+	}
+	for key, value := range options.GetIndexParams() {
+		params[key] = value
 	}
-	return &Milvus{client: client}
+	return params
+}
+
+// truncate 截断到不超过 max 字节，不拆开 UTF-8 字符
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
+
+// milvusStringList 表达式中的字符串列表
+func milvusStringList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// milvusFilter 搜索时按范围和路径过滤的表达式
+func milvusFilter(req *biz.SearchCodeChunksReq) string {
+	var conditions []string
+	if req.Scope != "" {
+		conditions = append(conditions, "scope == "+strconv.Quote(string(req.Scope)))
+	}
+	if req.Path != "" {
+		conditions = append(conditions, "path == "+strconv.Quote(req.Path))
+	}
+	return strings.Join(conditions, " && ")
+}
+
+func (m *Milvus) maxLength() int {
+	if m.options.GetMaxLength() > 0 {
+		return int(m.options.GetMaxLength())
+	}
+	return defaultMilvusMaxLength
+}
+
+func (m *Milvus) schema(collection string, dimension int) *entity.Schema {
+	maxLength := int64(m.maxLength())
+	varchar := func(name string, maxLength int64) *entity.Field {
+		return entity.NewField().WithName(name).WithDataType(entity.FieldTypeVarChar).WithMaxLength(maxLength)
+	}
+	return entity.NewSchema().WithName(collection).
+		WithField(varchar("id", maxLength).WithIsPrimaryKey(true)).
+		WithField(varchar("path", maxLength)).
+		WithField(varchar("content", maxLength)).
+		WithField(varchar("document", maxLength)).
+		WithField(varchar("logic", maxLength)).
+		WithField(varchar("scope", milvusScopeMaxLength)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(int64(dimension)))
+}
+
+// ensurePartition 确认分区存在并加载，返回分区是否可用
+// dimension 大于 0 时按需创建 collection 和分区，未配置 embedding.dimension 时使用该维度
+func (m *Milvus) ensurePartition(ctx context.Context, collection, partition string, dimension int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := collection + "/" + partition
+	if m.loaded[key] {
+		return true, nil
+	}
+	create := dimension > 0
+	if m.dimension > 0 {
+		dimension = m.dimension
+	}
+	has, err := m.client.HasCollection(ctx, milvusclient.NewHasCollectionOption(collection))
+	if err != nil || !has && !create {
+		return false, err
+	}
+	if !has {
+		err = m.client.CreateCollection(ctx, milvusclient.NewCreateCollectionOption(collection, m.schema(collection, dimension)).
+			WithIndexOptions(milvusclient.NewCreateIndexOption(collection, "vector", index.NewGenericIndex("", milvusIndexParams(m.options)))))
+		if err != nil {
+			return false, err
+		}
+	}
+	if has, err = m.client.HasPartition(ctx, milvusclient.NewHasPartitionOption(collection, partition)); err != nil || !has && !create {
+		return false, err
+	}
+	if !has {
+		if err = m.client.CreatePartition(ctx, milvusclient.NewCreatePartitionOption(collection, partition)); err != nil {
+			return false, err
+		}
+	}
+	task, err := m.client.LoadPartitions(ctx, milvusclient.NewLoadPartitionsOption(collection, partition))
+	if err != nil {
+		return false, err
+	}
+	if err = task.Await(ctx); err != nil {
+		return false, err
+	}
+	m.loaded[key] = true
+	return true, nil
 }
 
 func (m *Milvus) SaveCodeChunk(ctx context.Context, projectName, partition string, codeChunks []*biz.CodeChunk) error {
+	if len(codeChunks) == 0 {
+		return nil
+	}
+	ctx = context.WithoutCancel(ctx)
+	collection, partitionName := milvusName(projectName), milvusPartition(partition)
+	dimension := len(codeChunks[0].CodeVector())
+	if _, err := m.ensurePartition(ctx, collection, partitionName, dimension); err != nil {
+		return err
+	}
 	var paths []string
 	var contents []string
 	var documents []string
@@ -37,25 +214,38 @@ func (m *Milvus) SaveCodeChunk(ctx context.Context, projectName, partition strin
 	var scopes []string
 	var ids []string
 	var codeVectors [][]float32
-	projectName = strings.ReplaceAll(projectName, "-", "")
+	maxLength := m.maxLength()
+	seen := make(map[string]bool)
+	var replaced []string
 	for _, codeChunk := range codeChunks {
-		paths = append(paths, codeChunk.Path)
-		contents = append(contents, codeChunk.Content)
-		documents = append(documents, codeChunk.Document)
-		logics = append(logics, codeChunk.Logic)
+		if !seen[codeChunk.Path] {
+			seen[codeChunk.Path] = true
+			replaced = append(replaced, codeChunk.Path)
+		}
+		paths = append(paths, truncate(codeChunk.Path, maxLength))
+		contents = append(contents, truncate(codeChunk.Content, maxLength))
+		documents = append(documents, truncate(codeChunk.Document, maxLength))
+		logics = append(logics, truncate(codeChunk.Logic, maxLength))
 		scopes = append(scopes, string(codeChunk.Scope))
-		ids = append(ids, codeChunk.Id)
+		ids = append(ids, truncate(codeChunk.Id, maxLength))
 		codeVectors = append(codeVectors, codeChunk.CodeVector())
 	}
+	// 删除这些文件已有的代码块，文件中已删除的函数不再残留
+	_, err := m.client.Delete(ctx, milvusclient.NewDeleteOption(collection).
+		WithPartition(partitionName).
+		WithExpr("path in "+milvusStringList(replaced)))
+	if err != nil {
+		return err
+	}
 	idColumn := column.NewColumnVarChar("id", ids)
 	pathColumn := column.NewColumnVarChar("path", paths)
 	contentColumn := column.NewColumnVarChar("content", contents)
 	documentColumn := column.NewColumnVarChar("document", documents)
 	logicColumn := column.NewColumnVarChar("logic", logics)
 	scopeColumn := column.NewColumnVarChar("scope", scopes)
-	codeVectorColumn := column.NewColumnFloatVector("vector", 1024, codeVectors)
+	codeVectorColumn := column.NewColumnFloatVector("vector", dimension, codeVectors)
 
-	_, err := m.client.Insert(context.WithoutCancel(ctx), milvusclient.NewColumnBasedInsertOption(projectName,
+	_, err = m.client.Insert(ctx, milvusclient.NewColumnBasedInsertOption(collection,
 		idColumn,
 		pathColumn,
 		contentColumn,
@@ -63,47 +253,91 @@ func (m *Milvus) SaveCodeChunk(ctx context.Context, projectName, partition strin
 		logicColumn,
 		scopeColumn,
 		codeVectorColumn,
-	))
+	).WithPartition(partitionName))
 	return err
 }
 
-// SearchCodeChunk 搜索代码块
+// SearchCodeChunk 搜索代码块，collection 或分区不存在时没有结果
 func (m *Milvus) SearchCodeChunk(ctx context.Context, req *biz.SearchCodeChunksReq) ([]*biz.CodeChunk, error) {
-	projectName := strings.ReplaceAll(req.ProjectName, "-", "")
-	resultSets, err := m.client.Search(context.WithoutCancel(ctx), milvusclient.NewSearchOption(
-		projectName,
+	ctx = context.WithoutCancel(ctx)
+	collection, partitionName := milvusName(req.ProjectName), milvusPartition(req.Partition)
+	if ok, err := m.ensurePartition(ctx, collection, partitionName, 0); err != nil || !ok {
+		return nil, err
+	}
+	option := milvusclient.NewSearchOption(
+		collection,
 		req.Limit,
 		[]entity.Vector{entity.FloatVector(req.QueryVector)},
 	).WithANNSField("vector").
+		WithPartitions(partitionName).
 		WithOutputFields(
 			"id",
 			"path",
 			"content",
 			"document",
 			"logic",
-			"scope"))
+			"scope")
+	if filter := milvusFilter(req); filter != "" {
+		option = option.WithFilter(filter)
+	}
+	resultSets, err := m.client.Search(ctx, option)
 	if err != nil {
 		return nil, err
 	}
 	var results []*biz.CodeChunk
 	for _, resultSet := range resultSets {
-
 		for index := 0; index < resultSet.ResultCount; index++ {
-			result := &biz.CodeChunk{}
-			if value, err := resultSet.GetColumn("content").GetAsString(index); err != nil {
-				return nil, err
-			} else {
-				result.Content = value
-			}
-			if value, err := resultSet.GetColumn("path").GetAsString(index); err != nil {
-				return nil, err
-			} else {
-				result.Path = value
+			values := make(map[string]string)
+			for _, field := range []string{"id", "path", "content", "document", "logic", "scope"} {
+				value, err := resultSet.GetColumn(field).GetAsString(index)
+				if err != nil {
+					return nil, err
+				}
+				values[field] = value
 			}
-
-			results = append(results, result)
+			results = append(results, &biz.CodeChunk{
+				Id:       values["id"],
+				Path:     values["path"],
+				Content:  values["content"],
+				Document: values["document"],
+				Logic:    values["logic"],
+				Scope:    biz.Scope(values["scope"]),
+			})
 		}
-
 	}
 	return results, nil
 }
+
+// DeleteCodeChunks 删除仓库的分区，collection 中不再有仓库的分区时一并删除
+func (m *Milvus) DeleteCodeChunks(ctx context.Context, projectName, partition string) error {
+	ctx = context.WithoutCancel(ctx)
+	collection, partitionName := milvusName(projectName), milvusPartition(partition)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.loaded, collection+"/"+partitionName)
+	has, err := m.client.HasCollection(ctx, milvusclient.NewHasCollectionOption(collection))
+	if err != nil || !has {
+		return err
+	}
+	if has, err = m.client.HasPartition(ctx, milvusclient.NewHasPartitionOption(collection, partitionName)); err != nil {
+		return err
+	}
+	if has {
+		if err = m.client.ReleasePartitions(ctx, milvusclient.NewReleasePartitionsOptions(collection, partitionName)); err != nil {
+			return err
+		}
+		if err = m.client.DropPartition(ctx, milvusclient.NewDropPartitionOption(collection, partitionName)); err != nil {
+			return fmt.Errorf("drop partition %s of %s: %w", partitionName, collection, err)
+		}
+	}
+	partitions, err := m.client.ListPartitions(ctx, milvusclient.NewListPartitionOption(collection))
+	if err != nil {
+		return err
+	}
+	for _, p := range partitions {
+		if p != milvusDefaultPartition {
+			return nil
+		}
+	}
+	return m.client.DropCollection(ctx, milvusclient.NewDropCollectionOption(collection))
+}
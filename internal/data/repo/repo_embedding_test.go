@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"codewiki/internal/biz"
+	"codewiki/internal/conf"
+	"testing"
+)
+
+func TestMilvusHelpers(t *testing.T) {
+	for name, want := range map[string]string{
+		"code-wiki": "codewiki",
+		"app.v2":    "app_v2",
+		"2fa":       "_2fa",
+	} {
+		if got := milvusName(name); got != want {
+			t.Errorf("milvusName(%s) = %s, want %s", name, got, want)
+		}
+	}
+	if got := milvusPartition("5f0c-9a"); got != "repo_5f0c9a" {
+		t.Errorf("partition = %s", got)
+	}
+
+	params := milvusIndexParams(nil)
+	if params["index_type"] != "AUTOINDEX" || params["metric_type"] != "COSINE" {
+		t.Errorf("default params = %v", params)
+	}
+	params = milvusIndexParams(&conf.Data_VectorStore_Milvus{IndexType: "HNSW", IndexParams: map[string]string{"M": "16"}})
+	if params["index_type"] != "HNSW" || params["metric_type"] != "COSINE" || params["M"] != "16" {
+		t.Errorf("hnsw params = %v", params)
+	}
+
+	if got := truncate("代码", 4); got != "代" {
+		t.Errorf("truncate = %q", got)
+	}
+	if got := milvusStringList([]string{"a.go", `b "c".go`}); got != `["a.go", "b \"c\".go"]` {
+		t.Errorf("list = %s", got)
+	}
+	if got := milvusFilter(&biz.SearchCodeChunksReq{Scope: biz.ChunkFunctionScope, Path: "a.go"}); got != `scope == "function" && path == "a.go"` {
+		t.Errorf("filter = %s", got)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"context"
 	"errors"
 	"github.com/google/uuid"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
 	"strings"
 )
@@ -17,54 +17,162 @@ type gormRepo struct {
 }
 
 type RepoModel struct {
-	ID          string      `gorm:"primaryKey;size:64"`
-	Name        string      `gorm:"size:128;not null"`
-	RepoType    int32       `gorm:"not null"`
-	Path        string      `gorm:"size:512"`
-	Target      string      `gorm:"size:1024;not null"`
-	Token       string      `gorm:"size:512"`
-	Description string      `gorm:"size:512"`
-	Language    v1.Language `gorm:"size:50"`
-	Excludes    string      `gorm:"text"`
+	ID           string          `gorm:"primaryKey;size:64"`
+	Name         string          `gorm:"size:128;not null"`
+	RepoType     int32           `gorm:"not null"`
+	Path         string          `gorm:"size:512"`
+	Target       string          `gorm:"size:1024;not null"`
+	Token        string          `gorm:"size:512"`
+	Description  string          `gorm:"size:512"`
+	Language     v1.Language     `gorm:"size:50"`
+	Excludes     string          `gorm:"text"`
+	CallResolver v1.CallResolver `gorm:"default:0"`
+	Ref          string          `gorm:"size:256"`
+	Rules        string          `gorm:"type:text"` // ArchitectureRules JSON
 }
 
 func (RepoModel) TableName() string {
 	return "t_repo"
 }
+
+// AnalysisJobModel 分析任务历史
+type AnalysisJobModel struct {
+	ID             string           `gorm:"primaryKey;size:64"`
+	RepoID         string           `gorm:"size:64;index"`
+	Status         v1.JobStatus     `gorm:"not null"`
+	Phase          v1.AnalysisPhase `gorm:"not null"`
+	Incremental    bool
+	TotalFiles     int32
+	ProcessedFiles int32
+	Error          string `gorm:"type:text"`
+	DurationMs     int64
+	CreatedAt      int64 `gorm:"autoCreateTime:milli;index"`
+	StartedAt      int64
+	FinishedAt     int64
+	Summary        string `gorm:"type:text"` // AnalyzeSummary JSON
+	Commit         string `gorm:"size:64"`
+	Snapshot       string `gorm:"size:128"`
+}
+
+func (AnalysisJobModel) TableName() string {
+	return "t_analysis_job"
+}
+
+// SnapshotModel 仓库在某个提交上的分析结果
+type SnapshotModel struct {
+	ID         string `gorm:"primaryKey;size:128"`
+	RepoID     string `gorm:"size:64;index"`
+	Commit     string `gorm:"size:64"`
+	Ref        string `gorm:"size:256"`
+	JobID      string `gorm:"size:64"`
+	AnalyzedAt int64  `gorm:"index"`
+}
+
+func (SnapshotModel) TableName() string {
+	return "t_snapshot"
+}
+
+// ArchitectureViolationModel 快照违反的架构规则
+type ArchitectureViolationModel struct {
+	ID         uint64                  `gorm:"primaryKey;autoIncrement"`
+	Snapshot   string                  `gorm:"size:128;index"`
+	Rule       string                  `gorm:"size:128"`
+	Kind       v1.ArchitectureRuleKind `gorm:"not null"`
+	Source     string                  `gorm:"size:1024"`
+	Target     string                  `gorm:"size:1024"`
+	SourcePath string                  `gorm:"size:1024"`
+	TargetPath string                  `gorm:"size:1024"`
+}
+
+func (ArchitectureViolationModel) TableName() string {
+	return "t_architecture_violation"
+}
+
 func autoMigrateRepo(db *gorm.DB) error {
 	if db == nil {
 		return nil
 	}
-	return db.AutoMigrate(&RepoModel{})
+	return db.AutoMigrate(&RepoModel{}, &AnalysisJobModel{}, &SnapshotModel{}, &ArchitectureViolationModel{})
 }
 
-// compositeRepo wires MySQL (for repos) + Neo4j (for code graph)
+// marshalRules 规则保存为 ArchitectureRules JSON，没有规则时为空
+func marshalRules(rules []*v1.ArchitectureRule) (string, error) {
+	if len(rules) == 0 {
+		return "", nil
+	}
+	data, err := protojson.Marshal(&v1.ArchitectureRules{Rules: rules})
+	return string(data), err
+}
+
+func unmarshalRules(data string) []*v1.ArchitectureRule {
+	if len(data) == 0 {
+		return nil
+	}
+	rules := &v1.ArchitectureRules{}
+	if err := protojson.Unmarshal([]byte(data), rules); err != nil {
+		return nil
+	}
+	return rules.Rules
+}
+
+// compositeRepo wires MySQL (for repos) + GraphStore (for code graph)
 type compositeRepo struct {
 	sql *gormRepo
-	g   *projectRepo
+	g   GraphStore
 }
 
-func NewCompositeRepo(driver neo4j.DriverWithContext, db *gorm.DB) (biz.ProjectRepo, error) {
-	// neo4j part
-	pr := &projectRepo{neo4jDriver: driver}
+func NewCompositeRepo(graph GraphStore, db *gorm.DB) (biz.ProjectRepo, error) {
 	// mysql part
 	gr := &gormRepo{db: db}
 	if err := autoMigrateRepo(db); err != nil {
 		return nil, err
 	}
-	return &compositeRepo{sql: gr, g: pr}, nil
+	return &compositeRepo{sql: gr, g: graph}, nil
 }
 
-// Project graph ops delegate to neo4j
+// Project graph ops delegate to the graph store
 func (r *compositeRepo) SaveProject(ctx context.Context, p *biz.Project) error {
 	return r.g.SaveProject(ctx, p)
 }
-func (r *compositeRepo) QueryCallChain(ctx context.Context, id string) ([]*v1.CallRelationship, error) {
-	return r.g.QueryCallChain(ctx, id)
+func (r *compositeRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	return r.g.GetFileHashes(ctx, rootPkgId)
+}
+func (r *compositeRepo) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	return r.g.GetDependentFiles(ctx, fileIds)
+}
+func (r *compositeRepo) QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error) {
+	return r.g.QueryCallersChain(ctx, req)
+}
+func (r *compositeRepo) QueryFunctionTests(ctx context.Context, id string) ([]*v1.FunctionTest, error) {
+	return r.g.QueryFunctionTests(ctx, id)
+}
+func (r *compositeRepo) SearchSymbols(ctx context.Context, query *biz.SymbolQuery) ([]*v1.Symbol, error) {
+	return r.g.SearchSymbols(ctx, query)
+}
+func (r *compositeRepo) LookupSymbols(ctx context.Context, lookup *biz.SymbolLookup) ([]*v1.Symbol, error) {
+	return r.g.LookupSymbols(ctx, lookup)
+}
+func (r *compositeRepo) QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*biz.ImpactEdge, error) {
+	return r.g.QueryImpactEdges(ctx, ids, relationTypes, limit)
+}
+func (r *compositeRepo) GetFileSymbols(ctx context.Context, fileID string) (*biz.FileSymbols, error) {
+	return r.g.GetFileSymbols(ctx, fileID)
+}
+func (r *compositeRepo) GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error) {
+	return r.g.GetReferenceFiles(ctx, id, limit)
+}
+func (r *compositeRepo) GetSnapshotGraph(ctx context.Context, snapshotId string) (*biz.SnapshotGraph, error) {
+	return r.g.GetSnapshotGraph(ctx, snapshotId)
+}
+func (r *compositeRepo) QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*biz.CallEdge, error) {
+	return r.g.QueryCallEdges(ctx, ids, direction, relationTypes, limit, excludeTests)
 }
 func (r *compositeRepo) BindRepoRoot(ctx context.Context, repoId, rootPkgId string) error {
 	return r.g.BindRepoRoot(ctx, repoId, rootPkgId)
 }
+func (r *compositeRepo) GetPackageDependencies(ctx context.Context, scope string) ([]*v1.PackageNode, []*v1.PackageDependency, error) {
+	return r.g.GetPackageDependencies(ctx, scope)
+}
 func (r *compositeRepo) GetRepoTree(ctx context.Context, id string) ([]*v1.PackageNode, []*v1.FileNode, error) {
 	return r.g.GetRepoTree(ctx, id)
 }
@@ -76,18 +184,25 @@ func (r *compositeRepo) GetFunctionByFileId(ctx context.Context,
 // Repo CRUD via MySQL
 func (r *compositeRepo) CreateRepo(ctx context.Context, req *v1.CreateRepoReq) (string, error) {
 	if r.sql == nil || r.sql.db == nil {
-		return "", errors.New("mysql is not configured")
+		return "", errors.New("database is not configured")
+	}
+	rules, err := marshalRules(req.Rules)
+	if err != nil {
+		return "", err
 	}
 	m := &RepoModel{
-		ID:          uuid.NewString(),
-		Name:        req.Name,
-		RepoType:    int32(req.RepoType),
-		Path:        req.Path,
-		Target:      req.Target,
-		Token:       req.Token,
-		Description: req.Description,
-		Language:    req.Language,
-		Excludes:    strings.Join(req.Excludes, ","),
+		ID:           uuid.NewString(),
+		Name:         req.Name,
+		RepoType:     int32(req.RepoType),
+		Path:         req.Path,
+		Target:       req.Target,
+		Token:        req.Token,
+		Description:  req.Description,
+		Language:     req.Language,
+		Excludes:     strings.Join(req.Excludes, ","),
+		CallResolver: req.CallResolver,
+		Ref:          req.Ref,
+		Rules:        rules,
 	}
 	r.sql.db.Transaction(func(session *gorm.DB) error {
 		if err := session.Create(m).Error; err != nil {
@@ -113,13 +228,15 @@ func (r *compositeRepo) ListRepos(ctx context.Context) ([]*v1.Repo, error) {
 	var out []*v1.Repo
 	for _, m := range ms {
 		out = append(out, &v1.Repo{
-			Id:          m.ID,
-			Name:        m.Name,
-			RepoType:    v1.RepoType(m.RepoType),
-			Path:        m.Path,
-			Target:      m.Target,
-			Token:       m.Token,
-			Description: m.Description,
+			Id:           m.ID,
+			Name:         m.Name,
+			RepoType:     v1.RepoType(m.RepoType),
+			Path:         m.Path,
+			Target:       m.Target,
+			Token:        m.Token,
+			Description:  m.Description,
+			CallResolver: m.CallResolver,
+			Ref:          m.Ref,
 		})
 	}
 	return out, nil
@@ -127,31 +244,34 @@ func (r *compositeRepo) ListRepos(ctx context.Context) ([]*v1.Repo, error) {
 
 func (r *compositeRepo) GetRepo(ctx context.Context, id string) (*v1.Repo, error) {
 	if r.sql == nil || r.sql.db == nil {
-		return nil, errors.New("mysql is not configured")
+		return nil, errors.New("database is not configured")
 	}
 	var m RepoModel
 	if err := r.sql.db.WithContext(ctx).First(&m, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &v1.Repo{
-		Id:          m.ID,
-		Name:        m.Name,
-		RepoType:    v1.RepoType(m.RepoType),
-		Path:        m.Path,
-		Target:      m.Target,
-		Token:       m.Token,
-		Description: m.Description,
-		Excludes:    strings.Split(m.Excludes, ","),
-		Language:    m.Language,
+		Id:           m.ID,
+		Name:         m.Name,
+		RepoType:     v1.RepoType(m.RepoType),
+		Path:         m.Path,
+		Target:       m.Target,
+		Token:        m.Token,
+		Description:  m.Description,
+		Excludes:     strings.Split(m.Excludes, ","),
+		Language:     m.Language,
+		CallResolver: m.CallResolver,
+		Ref:          m.Ref,
+		Rules:        unmarshalRules(m.Rules),
 	}, nil
 }
 
 func (r *compositeRepo) DeleteRepo(ctx context.Context, id string) error {
 	if r.sql == nil || r.sql.db == nil {
-		return errors.New("mysql is not configured")
+		return errors.New("database is not configured")
 	}
-	return r.sql.db.Transaction(func(session *gorm.DB) error {
-		if err := r.sql.db.WithContext(ctx).Delete(&RepoModel{}, "id = ?", id).Error; err != nil {
+	return r.sql.db.WithContext(ctx).Transaction(func(session *gorm.DB) error {
+		if err := session.Delete(&RepoModel{}, "id = ?", id).Error; err != nil {
 			return err
 		}
 		return r.g.DeleteRepo(ctx, id)
@@ -159,6 +279,218 @@ func (r *compositeRepo) DeleteRepo(ctx context.Context, id string) error {
 
 }
 
+func newAnalysisJobModel(job *v1.AnalysisJob) (*AnalysisJobModel, error) {
+	m := &AnalysisJobModel{
+		ID:             job.Id,
+		RepoID:         job.RepoId,
+		Status:         job.Status,
+		Phase:          job.Phase,
+		Incremental:    job.Incremental,
+		TotalFiles:     job.TotalFiles,
+		ProcessedFiles: job.ProcessedFiles,
+		Error:          job.Error,
+		DurationMs:     job.DurationMs,
+		CreatedAt:      job.CreatedAt,
+		StartedAt:      job.StartedAt,
+		FinishedAt:     job.FinishedAt,
+		Commit:         job.Commit,
+		Snapshot:       job.Snapshot,
+	}
+	if job.Summary != nil {
+		summary, err := protojson.Marshal(job.Summary)
+		if err != nil {
+			return nil, err
+		}
+		m.Summary = string(summary)
+	}
+	return m, nil
+}
+
+func (m *AnalysisJobModel) toJob() *v1.AnalysisJob {
+	job := &v1.AnalysisJob{
+		Id:             m.ID,
+		RepoId:         m.RepoID,
+		Status:         m.Status,
+		Phase:          m.Phase,
+		Incremental:    m.Incremental,
+		TotalFiles:     m.TotalFiles,
+		ProcessedFiles: m.ProcessedFiles,
+		Error:          m.Error,
+		DurationMs:     m.DurationMs,
+		CreatedAt:      m.CreatedAt,
+		StartedAt:      m.StartedAt,
+		FinishedAt:     m.FinishedAt,
+		Commit:         m.Commit,
+		Snapshot:       m.Snapshot,
+	}
+	if len(m.Summary) > 0 {
+		summary := &v1.AnalyzeSummary{}
+		if err := protojson.Unmarshal([]byte(m.Summary), summary); err == nil {
+			job.Summary = summary
+		}
+	}
+	return job
+}
+
+func (r *compositeRepo) SaveAnalysisJob(ctx context.Context, job *v1.AnalysisJob) error {
+	if r.sql == nil || r.sql.db == nil {
+		return errors.New("database is not configured")
+	}
+	m, err := newAnalysisJobModel(job)
+	if err != nil {
+		return err
+	}
+	return r.sql.db.WithContext(ctx).Save(m).Error
+}
+
+func (r *compositeRepo) GetAnalysisJob(ctx context.Context, id string) (*v1.AnalysisJob, error) {
+	if r.sql == nil || r.sql.db == nil {
+		return nil, errors.New("database is not configured")
+	}
+	var m AnalysisJobModel
+	if err := r.sql.db.WithContext(ctx).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorDataRecordNotFound("analysis job %s not found", id)
+		}
+		return nil, err
+	}
+	return m.toJob(), nil
+}
+
+func (r *compositeRepo) ListAnalysisJobs(ctx context.Context, repoId string, limit int) ([]*v1.AnalysisJob, error) {
+	if r.sql == nil || r.sql.db == nil {
+		return []*v1.AnalysisJob{}, nil
+	}
+	query := r.sql.db.WithContext(ctx).Order("created_at desc").Limit(limit)
+	if len(repoId) > 0 {
+		query = query.Where("repo_id = ?", repoId)
+	}
+	var ms []AnalysisJobModel
+	if err := query.Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	var out []*v1.AnalysisJob
+	for i := range ms {
+		out = append(out, ms[i].toJob())
+	}
+	return out, nil
+}
+
+func (r *compositeRepo) FailUnfinishedAnalysisJobs(ctx context.Context, reason string, finishedAt int64) (int64, error) {
+	if r.sql == nil || r.sql.db == nil {
+		return 0, nil
+	}
+	result := r.sql.db.WithContext(ctx).Model(&AnalysisJobModel{}).
+		Where("status IN ?", []v1.JobStatus{v1.JobStatus_Pending, v1.JobStatus_Running}).
+		Updates(map[string]any{"status": v1.JobStatus_Failed, "error": reason, "finished_at": finishedAt})
+	return result.RowsAffected, result.Error
+}
+
+func (r *compositeRepo) SaveSnapshot(ctx context.Context, snapshot *v1.Snapshot) error {
+	if r.sql == nil || r.sql.db == nil {
+		return errors.New("database is not configured")
+	}
+	return r.sql.db.WithContext(ctx).Save(&SnapshotModel{
+		ID:         snapshot.Id,
+		RepoID:     snapshot.RepoId,
+		Commit:     snapshot.Commit,
+		Ref:        snapshot.Ref,
+		JobID:      snapshot.JobId,
+		AnalyzedAt: snapshot.AnalyzedAt,
+	}).Error
+}
+
+func (r *compositeRepo) ListSnapshots(ctx context.Context, repoId string) ([]*v1.Snapshot, error) {
+	if r.sql == nil || r.sql.db == nil {
+		return []*v1.Snapshot{}, nil
+	}
+	var ms []SnapshotModel
+	if err := r.sql.db.WithContext(ctx).Where("repo_id = ?", repoId).Order("analyzed_at desc").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	var out []*v1.Snapshot
+	for _, m := range ms {
+		out = append(out, &v1.Snapshot{
+			Id:         m.ID,
+			RepoId:     m.RepoID,
+			Commit:     m.Commit,
+			Ref:        m.Ref,
+			JobId:      m.JobID,
+			AnalyzedAt: m.AnalyzedAt,
+		})
+	}
+	return out, nil
+}
+
+func (r *compositeRepo) CopySnapshot(ctx context.Context, from, to string) error {
+	return r.g.CopySnapshot(ctx, from, to)
+}
+
+// DeleteSnapshot 先删除图中的节点，失败时保留记录以便下次清理
+func (r *compositeRepo) DeleteSnapshot(ctx context.Context, snapshot *v1.Snapshot) error {
+	if r.sql == nil || r.sql.db == nil {
+		return errors.New("database is not configured")
+	}
+	if err := r.g.DeleteSnapshot(ctx, snapshot.Id); err != nil {
+		return err
+	}
+	return r.sql.db.WithContext(ctx).Transaction(func(session *gorm.DB) error {
+		if err := session.Delete(&ArchitectureViolationModel{}, "snapshot = ?", snapshot.Id).Error; err != nil {
+			return err
+		}
+		return session.Delete(&SnapshotModel{}, "id = ?", snapshot.Id).Error
+	})
+}
+
+func (r *compositeRepo) SaveArchitectureViolations(ctx context.Context, snapshotId string, violations []*v1.ArchitectureViolation) error {
+	if r.sql == nil || r.sql.db == nil {
+		return errors.New("database is not configured")
+	}
+	ms := make([]*ArchitectureViolationModel, 0, len(violations))
+	for _, v := range violations {
+		ms = append(ms, &ArchitectureViolationModel{
+			Snapshot:   snapshotId,
+			Rule:       v.Rule,
+			Kind:       v.Kind,
+			Source:     v.Source,
+			Target:     v.Target,
+			SourcePath: v.SourcePath,
+			TargetPath: v.TargetPath,
+		})
+	}
+	return r.sql.db.WithContext(ctx).Transaction(func(session *gorm.DB) error {
+		if err := session.Delete(&ArchitectureViolationModel{}, "snapshot = ?", snapshotId).Error; err != nil {
+			return err
+		}
+		if len(ms) == 0 {
+			return nil
+		}
+		return session.CreateInBatches(ms, 500).Error
+	})
+}
+
+func (r *compositeRepo) ListArchitectureViolations(ctx context.Context, snapshotId string) ([]*v1.ArchitectureViolation, error) {
+	if r.sql == nil || r.sql.db == nil {
+		return []*v1.ArchitectureViolation{}, nil
+	}
+	var ms []ArchitectureViolationModel
+	if err := r.sql.db.WithContext(ctx).Where("snapshot = ?", snapshotId).Order("id").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	var out []*v1.ArchitectureViolation
+	for _, m := range ms {
+		out = append(out, &v1.ArchitectureViolation{
+			Rule:       m.Rule,
+			Kind:       m.Kind,
+			Source:     m.Source,
+			Target:     m.Target,
+			SourcePath: m.SourcePath,
+			TargetPath: m.TargetPath,
+		})
+	}
+	return out, nil
+}
+
 func (r *compositeRepo) GetImplementByEntityId(ctx context.Context, entityID string) ([]*v1.Entity, error) {
 	return r.g.GetImplementByEntityId(ctx, entityID)
 }
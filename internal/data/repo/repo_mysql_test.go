@@ -0,0 +1,99 @@
+package repo
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"context"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"path/filepath"
+	"testing"
+)
+
+// newSQLiteRepo SQLite 元数据加内嵌代码图，与 data.NewGormDB 一样只使用一个连接
+func newSQLiteRepo(t *testing.T) *compositeRepo {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "codewiki.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	graph, err := NewMemoryGraphStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewCompositeRepo(graph, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.(*compositeRepo)
+}
+
+func TestCompositeRepoSQLite(t *testing.T) {
+	ctx := context.Background()
+	r := newSQLiteRepo(t)
+	id, err := r.CreateRepo(ctx, &v1.CreateRepoReq{
+		Name:     "app",
+		Target:   "https://example.com/app.git",
+		Language: v1.Language_Golang,
+		Excludes: []string{"vendor", "testdata"},
+		Rules:    []*v1.ArchitectureRule{{Name: "biz-no-data", Kind: v1.ArchitectureRuleKind_RuleForbidDependency, From: "internal/biz", To: "internal/data"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := r.GetRepo(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.Name != "app" || len(repo.Excludes) != 2 || len(repo.Rules) != 1 || repo.Rules[0].Name != "biz-no-data" {
+		t.Errorf("repo = %v", repo)
+	}
+
+	if err = r.SaveAnalysisJob(ctx, &v1.AnalysisJob{Id: "job", RepoId: id, Status: v1.JobStatus_Succeeded, Summary: &v1.AnalyzeSummary{}}); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err := r.ListAnalysisJobs(ctx, id, 10)
+	if err != nil || len(jobs) != 1 || jobs[0].Status != v1.JobStatus_Succeeded {
+		t.Errorf("jobs = %v, err = %v", jobs, err)
+	}
+	if err = r.SaveAnalysisJob(ctx, &v1.AnalysisJob{Id: "running", RepoId: id, Status: v1.JobStatus_Running, Summary: &v1.AnalyzeSummary{}}); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := r.FailUnfinishedAnalysisJobs(ctx, "restart", 1); err != nil || count != 1 {
+		t.Errorf("failed jobs = %d, err = %v", count, err)
+	}
+	if job, err := r.GetAnalysisJob(ctx, "running"); err != nil || job.Status != v1.JobStatus_Failed || job.Error != "restart" {
+		t.Errorf("job = %v, err = %v", job, err)
+	}
+	snapshot := &v1.Snapshot{Id: id + "~abc", RepoId: id, Commit: "abc", JobId: "job"}
+	if err = r.SaveSnapshot(ctx, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.SaveArchitectureViolations(ctx, snapshot.Id, []*v1.ArchitectureViolation{{Rule: "biz-no-data", Source: "a", Target: "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	violations, err := r.ListArchitectureViolations(ctx, snapshot.Id)
+	if err != nil || len(violations) != 1 {
+		t.Errorf("violations = %v, err = %v", violations, err)
+	}
+
+	if err = r.DeleteSnapshot(ctx, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	snapshots, _ := r.ListSnapshots(ctx, id)
+	violations, _ = r.ListArchitectureViolations(ctx, snapshot.Id)
+	if len(snapshots) != 0 || len(violations) != 0 {
+		t.Errorf("snapshots = %v, violations = %v", snapshots, violations)
+	}
+	if err = r.DeleteRepo(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if repos, _ := r.ListRepos(ctx); len(repos) != 0 {
+		t.Errorf("repos = %v", repos)
+	}
+}
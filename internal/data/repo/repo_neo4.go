@@ -6,7 +6,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 )
 
 type projectRepo struct {
@@ -18,11 +24,20 @@ func (projectRepo *projectRepo) SaveProject(ctx context.Context, project *biz.Pr
 	ctx, _ = context.WithTimeout(ctx, 5*time.Minute)
 	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
 	defer session.Close(ctx)
+	if err := deleteStaleNodes(ctx, session, project); err != nil {
+		return err
+	}
 	pkgs := project.GetPackages()
 	if err := batchSavePackage(ctx, session, pkgs); err != nil {
 		return err
 	}
-	files := project.GetFiles()
+	// 根包记录分析的提交
+	if err := runWrite(ctx, session, `MATCH (p:Package {id: $id}) SET p.commit = $commit`,
+		map[string]any{"id": project.Root.ID, "commit": project.Commit}); err != nil {
+		return err
+	}
+	// 只保存重新分析的文件，其余节点保持不变
+	files := project.GetAnalyzedFiles()
 	if err := batchSaveFile(ctx, session, files); err != nil {
 		return err
 	}
@@ -31,7 +46,8 @@ func (projectRepo *projectRepo) SaveProject(ctx context.Context, project *biz.Pr
 		return err
 	}
 	entities := getEntities(files)
-	if err := batchSaveEntity(ctx, session, entities); err != nil {
+	tests := getTestNodes(files)
+	if err := batchSaveEntity(ctx, session, entities, tests); err != nil {
 		return err
 	}
 	functions := getFunctions(files)
@@ -39,10 +55,173 @@ func (projectRepo *projectRepo) SaveProject(ctx context.Context, project *biz.Pr
 		return err
 	}
 	fields := getFields(entities)
-	if err := batchSaveField(ctx, session, fields); err != nil {
+	if err := batchSaveField(ctx, session, fields, tests); err != nil {
+		return err
+	}
+	if err := batchSaveRelation(ctx, projectRepo.neo4jDriver, project.Relations); err != nil {
+		return err
+	}
+	if err := savePackageDependencies(ctx, session, project.Root.ID); err != nil {
+		return err
+	}
+	return saveTestRelations(ctx, session, project.Root.ID)
+}
+
+// savePackageDependencies 按文件的导入重新汇总根包下包之间的 DependsOn 关系
+// 增量分析时未变化文件的导入关系只在图数据库中，因此在保存后从图中汇总
+func savePackageDependencies(ctx context.Context, session neo4j.SessionWithContext, rootPkgId string) error {
+	params := map[string]any{"root": rootPkgId}
+	if err := runWrite(ctx, session, `
+        MATCH (p:Package)-[d:DependsOn]->()
+        WHERE p.id = $root OR p.id STARTS WITH $root + '@'
+        DELETE d`, params); err != nil {
+		return err
+	}
+	return runWrite(ctx, session, `
+        MATCH (p:Package)-[:ContainsFile]->(f:File)-[:Import]->(q:Package)
+        WHERE (p.id = $root OR p.id STARTS WITH $root + '@') AND p <> q
+        WITH p, q, count(DISTINCT f) AS files
+        MERGE (p)-[d:DependsOn]->(q)
+        SET d.count = files`, params)
+}
+
+// saveTestRelations 按调用关系重新计算根包下测试函数到非测试代码的 Tests 关系
+// 与 DependsOn 一样，增量分析时未变化文件的调用关系只在图数据库中，因此在保存后从图中计算
+func saveTestRelations(ctx context.Context, session neo4j.SessionWithContext, rootPkgId string) error {
+	params := map[string]any{"prefix": rootPkgId + biz.PathSep, "types": []string{biz.Call, biz.DispatchesTo}, "test": biz.EntrypointTest}
+	result, err := session.Run(ctx, `MATCH (a:Function)-[r]->(b:Function)
+        WHERE a.id STARTS WITH $prefix AND type(r) IN $types
+        RETURN a.id, type(r), b.id, a.entrypoint = $test, coalesce(a.test, false), coalesce(b.test, false)`, params)
+	if err != nil {
+		return err
+	}
+	var calls []*biz.Relation
+	var tests []string
+	testCode := make(map[string]bool)
+	for result.Next(ctx) {
+		values := result.Record().Values
+		rel := &biz.Relation{}
+		rel.SourceID, _ = values[0].(string)
+		rel.Type, _ = values[1].(string)
+		rel.TargetID, _ = values[2].(string)
+		calls = append(calls, rel)
+		if isTest, _ := values[3].(bool); isTest && !testCode[rel.SourceID] {
+			tests = append(tests, rel.SourceID)
+		}
+		if inTestFile, _ := values[4].(bool); inTestFile {
+			testCode[rel.SourceID] = true
+		}
+		if inTestFile, _ := values[5].(bool); inTestFile {
+			testCode[rel.TargetID] = true
+		}
+	}
+	if err = result.Err(); err != nil {
+		return err
+	}
+	if err = runWrite(ctx, session, `MATCH (t:Function)-[r:Tests]->()
+        WHERE t.id STARTS WITH $prefix
+        DELETE r`, params); err != nil {
 		return err
 	}
-	return batchSaveRelation(ctx, projectRepo.neo4jDriver, project.Relations)
+	var links []map[string]any
+	for _, link := range biz.LinkTests(calls, tests, testCode) {
+		links = append(links, map[string]any{"test": link.Test, "target": link.Target, "depth": link.Depth})
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	return runWrite(ctx, session, `UNWIND $links AS link
+        MATCH (t:Function {id: link.test}), (f:Function {id: link.target})
+        MERGE (t)-[r:Tests]->(f)
+        SET r.depth = link.depth`, map[string]any{"links": links})
+}
+
+// GetPackageDependencies 源包为 scope 或其子包的 DependsOn 关系
+func (projectRepo *projectRepo) GetPackageDependencies(ctx context.Context, scope string) ([]*v1.PackageNode, []*v1.PackageDependency, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, `MATCH (p:Package)-[d:DependsOn]->(q:Package)
+        WHERE p.id = $scope OR p.id STARTS WITH $scope + '@'
+        RETURN p.id, p.name, p.parent_id, q.id, q.name, q.parent_id, d.count`, map[string]any{"scope": scope})
+	if err != nil {
+		return nil, nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var packages []*v1.PackageNode
+	var dependencies []*v1.PackageDependency
+	seen := make(map[string]bool)
+	for _, v := range rs {
+		for i := 0; i < 6; i += 3 {
+			node := &v1.PackageNode{}
+			node.Id, _ = v.Values[i].(string)
+			node.Name, _ = v.Values[i+1].(string)
+			node.ParentId, _ = v.Values[i+2].(string)
+			if !seen[node.Id] {
+				seen[node.Id] = true
+				packages = append(packages, node)
+			}
+		}
+		d := &v1.PackageDependency{}
+		d.Source, _ = v.Values[0].(string)
+		d.Target, _ = v.Values[3].(string)
+		count, _ := v.Values[6].(int64)
+		d.Count = int32(count)
+		dependencies = append(dependencies, d)
+	}
+	return packages, dependencies, nil
+}
+
+func (projectRepo *projectRepo) GetFileHashes(ctx context.Context, rootPkgId string) (map[string]string, error) {
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	hashes := make(map[string]string)
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `MATCH (f:File) WHERE f.id STARTS WITH $prefix RETURN f.id AS id, f.hash AS hash`
+		result, err := tx.Run(ctx, query, map[string]any{"prefix": rootPkgId + "@"})
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			rec := result.Record()
+			id, _ := rec.Get("id")
+			hash, _ := rec.Get("hash")
+			if v, ok := id.(string); ok {
+				// 没有哈希的旧数据视为已变化
+				h, _ := hash.(string)
+				hashes[v] = h
+			}
+		}
+		return nil, result.Err()
+	})
+	return hashes, err
+}
+
+func (projectRepo *projectRepo) GetDependentFiles(ctx context.Context, fileIds []string) ([]string, error) {
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	var files []string
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `MATCH (s)-[]->(t)
+                  WHERE (t:Entity OR t:Function) AND t.file_id IN $files
+                    AND (s:Entity OR s:Function) AND NOT s.file_id IN $files
+                  RETURN DISTINCT s.file_id AS file_id`
+		result, err := tx.Run(ctx, query, map[string]any{"files": fileIds})
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			if v, ok := result.Record().Values[0].(string); ok {
+				files = append(files, v)
+			}
+		}
+		return nil, result.Err()
+	})
+	return files, err
 }
 
 // ===== Repo management =====
@@ -168,6 +347,532 @@ func (projectRepo *projectRepo) DeleteRepo(ctx context.Context, id string) error
 	return err
 }
 
+// DeleteSnapshot 删除 ID 以快照 ID 为前缀的所有节点
+func (projectRepo *projectRepo) DeleteSnapshot(ctx context.Context, id string) error {
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	prefix := id + biz.PathSep
+	if err := runWrite(ctx, session, `
+        MATCH (i:Import) WHERE i.file_id STARTS WITH $prefix
+        DETACH DELETE i`, map[string]any{"prefix": prefix}); err != nil {
+		return err
+	}
+	return runWrite(ctx, session, `
+        MATCH (n) WHERE (n:Package OR n:File OR n:Entity OR n:Function OR n:Field) AND n.id STARTS WITH $prefix
+        DETACH DELETE n`, map[string]any{"prefix": prefix})
+}
+
+// snapshotIDKeys 节点中保存节点 ID 的属性，复制快照时替换前缀
+var snapshotIDKeys = []string{"id", "parent_id", "pkg_id", "file_id", "ent_id", "entity_id"}
+
+// CopySnapshot 复制快照 from 的节点、导入和关系，节点 ID 及指向其他节点的属性换成快照 to 的前缀
+// DependsOn 和 Tests 在保存时从图中重新计算，不复制
+func (projectRepo *projectRepo) CopySnapshot(ctx context.Context, from, to string) error {
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	params := map[string]any{"from": from, "to": to, "prefix": from + biz.PathSep}
+	var renames []string
+	for _, key := range snapshotIDKeys {
+		renames = append(renames, fmt.Sprintf(
+			"m.%[1]s = CASE WHEN n.%[1]s = $from OR n.%[1]s STARTS WITH $prefix THEN $to + substring(n.%[1]s, size($from)) ELSE n.%[1]s END", key))
+	}
+	for _, label := range []string{labelPackage, labelFile, labelEntity, labelFunction, labelField} {
+		if err := runWrite(ctx, session, fmt.Sprintf(`
+        MATCH (n:%s) WHERE n.id STARTS WITH $prefix
+        CREATE (m:%[1]s)
+        SET m = properties(n)
+        SET %s`, label, strings.Join(renames, ",\n            ")), params); err != nil {
+			return err
+		}
+	}
+	if err := runWrite(ctx, session, `
+        MATCH (i:Import) WHERE i.file_id STARTS WITH $prefix
+        CREATE (c:Import)
+        SET c = properties(i), c.file_id = $to + substring(i.file_id, size($from))`, params); err != nil {
+		return err
+	}
+	types := make([]string, 0, len(relationLabels))
+	for relationType := range relationLabels {
+		types = append(types, relationType)
+	}
+	sort.Strings(types)
+	for _, relationType := range types {
+		labels := relationLabels[relationType]
+		if err := runWrite(ctx, session, fmt.Sprintf(`
+        MATCH (a:%[1]s)-[r:%[3]s]->(b:%[2]s) WHERE a.id STARTS WITH $prefix AND b.id STARTS WITH $prefix
+        MATCH (c:%[1]s {id: $to + substring(a.id, size($from))}), (d:%[2]s {id: $to + substring(b.id, size($from))})
+        CREATE (c)-[s:%[3]s]->(d)
+        SET s = properties(r)`, labels[0], labels[1], relationType), params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSnapshotGraph 读取快照中的包、文件、实体、函数及它们之间的关系
+func (projectRepo *projectRepo) GetSnapshotGraph(ctx context.Context, snapshotId string) (*biz.SnapshotGraph, error) {
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	params := map[string]any{"prefix": snapshotId + biz.PathSep}
+	graph := &biz.SnapshotGraph{}
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		for _, q := range []struct {
+			query string
+			nodes *[]*biz.GraphNode
+		}{
+			{`MATCH (n:Package) WHERE n.id STARTS WITH $prefix
+              RETURN n.id, n.name, n.parent_id, null, true`, &graph.Packages},
+			{`MATCH (n:File) WHERE n.id STARTS WITH $prefix
+              RETURN n.id, n.name, n.pkg_id, n.hash, true`, &graph.Files},
+			{`MATCH (n:Entity) WHERE n.id STARTS WITH $prefix
+              RETURN n.id, n.name, n.file_id, n.hash, coalesce(n.exported, true)`, &graph.Entities},
+			{`MATCH (n:Function) WHERE n.id STARTS WITH $prefix
+              RETURN n.id, n.name, n.file_id, n.hash, coalesce(n.exported, true)`, &graph.Functions},
+		} {
+			result, err := tx.Run(ctx, q.query, params)
+			if err != nil {
+				return nil, err
+			}
+			for result.Next(ctx) {
+				values := result.Record().Values
+				node := &biz.GraphNode{}
+				node.ID, _ = values[0].(string)
+				node.Name, _ = values[1].(string)
+				node.ParentID, _ = values[2].(string)
+				node.Content, _ = values[3].(string)
+				node.Exported, _ = values[4].(bool)
+				*q.nodes = append(*q.nodes, node)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+		}
+		result, err := tx.Run(ctx, `MATCH (a)-[r]->(b)
+                  WHERE (a:Package OR a:File OR a:Entity OR a:Function) AND a.id STARTS WITH $prefix
+                  RETURN a.id, type(r), b.id`, params)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			values := result.Record().Values
+			relation := &biz.Relation{}
+			relation.SourceID, _ = values[0].(string)
+			relation.Type, _ = values[1].(string)
+			relation.TargetID, _ = values[2].(string)
+			graph.Relations = append(graph.Relations, relation)
+		}
+		return nil, result.Err()
+	})
+	return graph, err
+}
+
+// symbolIndex 包、文件、实体、函数和字段名称的全文索引，索引 name_words 和 name_grams
+// 默认分析器不拆分驼峰名称，因此保存节点时由 biz.SymbolWords 和 symbolGrams 生成索引词，索引只按空白分词
+const symbolIndex = "symbol_words"
+
+// legacySymbolIndex 旧版只索引 name 的全文索引
+const legacySymbolIndex = "symbol_name"
+
+// symbolLabels 符号节点的标签
+var symbolLabels = []string{labelPackage, labelFile, labelEntity, labelFunction, labelField}
+
+// symbolGramSize 子串索引的字符数，更短的查询只能按单词前缀匹配
+const symbolGramSize = 3
+
+// symbolGrams 小写名称中所有不重复的 symbolGramSize 个字符的子串，以空格分隔
+func symbolGrams(name string) string {
+	runes := []rune(strings.ToLower(name))
+	seen := make(map[string]bool)
+	var grams []string
+	for i := 0; i+symbolGramSize <= len(runes); i++ {
+		gram := string(runes[i : i+symbolGramSize])
+		if !seen[gram] && !strings.ContainsFunc(gram, unicode.IsSpace) {
+			seen[gram] = true
+			grams = append(grams, gram)
+		}
+	}
+	return strings.Join(grams, " ")
+}
+
+// ensureSymbolIndex 启动时创建符号节点 id 的索引和名称的全文索引，已存在时不变
+// 删除旧版的全文索引，并为还没有索引词的节点生成索引词
+func ensureSymbolIndex(ctx context.Context, driver neo4j.DriverWithContext) error {
+	session := driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	for _, label := range symbolLabels {
+		if err := runWrite(ctx, session, fmt.Sprintf(`CREATE INDEX %s_id IF NOT EXISTS FOR (n:%s) ON (n.id)`,
+			strings.ToLower(label), label), nil); err != nil {
+			return err
+		}
+	}
+	if err := runWrite(ctx, session, `DROP INDEX `+legacySymbolIndex+` IF EXISTS`, nil); err != nil {
+		return err
+	}
+	if err := runWrite(ctx, session, `CREATE FULLTEXT INDEX `+symbolIndex+` IF NOT EXISTS
+        FOR (n:Package|File|Entity|Function|Field) ON EACH [n.name_words, n.name_grams]
+        OPTIONS {indexConfig: {`+"`fulltext.analyzer`"+`: 'whitespace'}}`, nil); err != nil {
+		return err
+	}
+	return backfillSymbolWords(ctx, session)
+}
+
+// backfillSymbolWords 为旧版保存的节点分批生成索引词
+func backfillSymbolWords(ctx context.Context, session neo4j.SessionWithContext) error {
+	const batchSize = 10000
+	for {
+		result, err := session.Run(ctx, `MATCH (n) WHERE (n:Package OR n:File OR n:Entity OR n:Function OR n:Field)
+            AND n.name_words IS NULL
+        RETURN elementId(n), coalesce(n.name, '') LIMIT $limit`, map[string]any{"limit": batchSize})
+		if err != nil {
+			return err
+		}
+		rs, err := result.Collect(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rs) == 0 {
+			return nil
+		}
+		batch := make([]map[string]any, 0, len(rs))
+		for _, v := range rs {
+			name, _ := v.Values[1].(string)
+			batch = append(batch, withSymbolWords(name, map[string]any{"element": v.Values[0]}))
+		}
+		if err = runWrite(ctx, session, `UNWIND $batch AS b
+        MATCH (n) WHERE elementId(n) = b.element
+        SET n.name_words = b.name_words, n.name_grams = b.name_grams`, map[string]any{"batch": batch}); err != nil {
+			return err
+		}
+	}
+}
+
+// SearchSymbols 前缀和模糊匹配查询全文索引
+// 正则匹配按 id 索引读取快照中的名称，用与 biz 校验时相同的 Go 正则过滤，不使用 Neo4j 的 Java 正则
+func (projectRepo *projectRepo) SearchSymbols(ctx context.Context, query *biz.SymbolQuery) ([]*v1.Symbol, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	labels := make([]string, 0, len(query.Kinds))
+	for _, kind := range query.Kinds {
+		labels = append(labels, strings.TrimPrefix(kind.String(), "Symbol"))
+	}
+	if len(labels) == 0 {
+		labels = symbolLabels
+	}
+	filters := []string{"n.id STARTS WITH $prefix", "any(l IN labels(n) WHERE l IN $labels)"}
+	if query.Package != "" {
+		filters = append(filters, "(n.id = $pkg OR n.id STARTS WITH $pkg + '@' OR n.id STARTS WITH $pkg + ':')")
+	}
+	if query.ExportedOnly {
+		filters = append(filters, "coalesce(n.exported, true)")
+	}
+	if query.ExcludeTests {
+		filters = append(filters, "NOT coalesce(n.test, false)")
+	}
+	params := map[string]any{
+		"prefix": query.Snapshot + biz.PathSep,
+		"labels": labels,
+		"pkg":    query.Package,
+		"refs":   query.ReferenceTypes,
+		"limit":  query.Limit,
+	}
+	var match string
+	if query.Mode == v1.SymbolMatchMode_MatchRegex {
+		re, err := regexp.Compile(query.Query)
+		if err != nil {
+			return nil, v1.ErrorParamValidate("invalid regex %s: %v", query.Query, err)
+		}
+		if params["elements"], err = regexSymbolElements(ctx, session, re, labels, filters, params); err != nil {
+			return nil, err
+		}
+		match = `MATCH (n) WHERE elementId(n) IN $elements`
+	} else {
+		params["index"] = symbolIndex
+		params["lucene"] = luceneSymbolQuery(query.Query, query.Mode)
+		match = `CALL db.index.fulltext.queryNodes($index, $lucene) YIELD node AS n, score
+        WHERE ` + strings.Join(filters, " AND ") + `
+        WITH n, score ORDER BY score DESC LIMIT $limit`
+	}
+	result, err := session.Run(ctx, match+`
+        OPTIONAL MATCH (owner:Entity {id: n.entity_id})
+        RETURN `+symbolReturn+`, size([(n)<-[r]-() WHERE type(r) IN $refs | r])`, params)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	symbols := make([]*v1.Symbol, 0, len(rs))
+	for _, v := range rs {
+		s := symbolFromValues(v.Values)
+		fanIn, _ := v.Values[symbolColumns].(int64)
+		s.FanIn = int32(fanIn)
+		symbols = append(symbols, s)
+	}
+	return symbols, nil
+}
+
+// regexSymbolElements 快照中名称匹配 re 且满足 filters 的节点，最多 limit 个
+// 逐个标签按 id 前缀读取，只扫描快照中的节点
+func regexSymbolElements(ctx context.Context, session neo4j.SessionWithContext, re *regexp.Regexp, labels, filters []string, params map[string]any) ([]any, error) {
+	var elements []any
+	limit, _ := params["limit"].(int)
+	for _, label := range labels {
+		if !slices.Contains(symbolLabels, label) {
+			continue
+		}
+		result, err := session.Run(ctx, fmt.Sprintf(`MATCH (n:%s) WHERE %s
+        RETURN elementId(n), n.name`, label, strings.Join(filters, " AND ")), params)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			values := result.Record().Values
+			if name, _ := values[1].(string); re.MatchString(name) {
+				elements = append(elements, values[0])
+				if len(elements) >= limit {
+					_, err = result.Consume(ctx)
+					return elements, err
+				}
+			}
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return elements, nil
+}
+
+// symbolReturn 符号查询的返回列，与 symbolFromValues 对应，owner 为字段所属的实体
+const symbolReturn = `n.id, n.name, labels(n), coalesce(n.parent_id, n.pkg_id, n.file_id, n.entity_id),
+               coalesce(n.exported, true), coalesce(n.file_id, owner.file_id),
+               n.start_line, n.start_column, n.end_line, n.end_column, n.entrypoint, coalesce(n.test, owner.test, false)`
+
+// symbolColumns symbolReturn 的列数，之后为各查询附加的列
+const symbolColumns = 12
+
+// symbolFromValues 按 symbolReturn 的列转换符号
+func symbolFromValues(values []any) *v1.Symbol {
+	s := &v1.Symbol{}
+	s.Id, _ = values[0].(string)
+	s.Name, _ = values[1].(string)
+	nodeLabels, _ := values[2].([]any)
+	for _, l := range nodeLabels {
+		if kind, ok := v1.SymbolKind_value["Symbol"+fmt.Sprint(l)]; ok {
+			s.Kind = v1.SymbolKind(kind)
+			break
+		}
+	}
+	s.ParentId, _ = values[3].(string)
+	s.Exported, _ = values[4].(bool)
+	s.FileId, _ = values[5].(string)
+	s.Span = sourceSpan(values[6:10])
+	s.Entrypoint, _ = values[10].(string)
+	s.Test, _ = values[11].(bool)
+	return s
+}
+
+// LookupSymbols 按 ID、名称或所在文件查找快照中的实体、函数和字段
+func (projectRepo *projectRepo) LookupSymbols(ctx context.Context, lookup *biz.SymbolLookup) ([]*v1.Symbol, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	filters := []string{"(n:Entity OR n:Function OR n:Field)", "n.id STARTS WITH $prefix"}
+	if len(lookup.IDs) > 0 {
+		filters = append(filters, "n.id IN $ids")
+	}
+	if len(lookup.Names) > 0 {
+		filters = append(filters, "n.name IN $names")
+	}
+	result, err := session.Run(ctx, `MATCH (n) WHERE `+strings.Join(filters, " AND ")+`
+        OPTIONAL MATCH (owner:Entity {id: n.entity_id})
+        WITH n, owner WHERE $file = '' OR coalesce(n.file_id, owner.file_id) = $file
+        RETURN `+symbolReturn+`
+        ORDER BY n.id`, map[string]any{
+		"prefix": lookup.Snapshot + biz.PathSep,
+		"ids":    lookup.IDs,
+		"names":  lookup.Names,
+		"file":   lookup.FileID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	symbols := make([]*v1.Symbol, 0, len(rs))
+	for _, v := range rs {
+		symbols = append(symbols, symbolFromValues(v.Values))
+	}
+	return symbols, nil
+}
+
+// QueryImpactEdges 通过 relationTypes 关系指向 ids 中节点的实体和函数
+func (projectRepo *projectRepo) QueryImpactEdges(ctx context.Context, ids []string, relationTypes []string, limit int) ([]*biz.ImpactEdge, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, `MATCH (n)-[r]->(m)
+        WHERE m.id IN $ids AND type(r) IN $types AND (n:Entity OR n:Function)
+        WITH n, m.id AS to, type(r) AS relation
+        ORDER BY to, n.id
+        LIMIT $limit
+        OPTIONAL MATCH (owner:Entity {id: n.entity_id})
+        RETURN `+symbolReturn+`, to, relation`, map[string]any{"ids": ids, "types": relationTypes, "limit": limit})
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*biz.ImpactEdge, 0, len(rs))
+	for _, v := range rs {
+		edge := &biz.ImpactEdge{Source: symbolFromValues(v.Values)}
+		edge.To, _ = v.Values[symbolColumns].(string)
+		edge.Relation, _ = v.Values[symbolColumns+1].(string)
+		edges = append(edges, edge)
+	}
+	return edges, nil
+}
+
+// GetFileSymbols 文件中声明的符号、文件中函数的调用位置、导入和导入的包
+func (projectRepo *projectRepo) GetFileSymbols(ctx context.Context, fileID string) (*biz.FileSymbols, error) {
+	snapshot, _ := biz.SplitNodeID(fileID)
+	declarations, err := projectRepo.LookupSymbols(ctx, &biz.SymbolLookup{Snapshot: snapshot, FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+	file := &biz.FileSymbols{Declarations: declarations}
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	params := map[string]any{"file": fileID}
+	result, err := session.Run(ctx, `MATCH (:Function {file_id: $file})-[rel:Call]->(n:Function)
+        WHERE rel.start_line > 0
+        OPTIONAL MATCH (owner:Entity {id: n.entity_id})
+        RETURN `+symbolReturn+`, rel.start_line, rel.start_column, rel.end_line, rel.end_column`, params)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range rs {
+		call := &biz.FileCall{Callee: symbolFromValues(v.Values)}
+		if site := sourceSpan(v.Values[symbolColumns : symbolColumns+4]); site != nil {
+			call.Site = biz.Span{StartLine: int(site.StartLine), StartColumn: int(site.StartColumn), EndLine: int(site.EndLine), EndColumn: int(site.EndColumn)}
+		}
+		file.Calls = append(file.Calls, call)
+	}
+	if result, err = session.Run(ctx, `MATCH (i:Import {file_id: $file}) RETURN i.path, i.name`, params); err != nil {
+		return nil, err
+	}
+	if rs, err = result.Collect(ctx); err != nil {
+		return nil, err
+	}
+	for _, v := range rs {
+		imp := &biz.Import{FileId: fileID}
+		imp.Path, _ = v.Values[0].(string)
+		imp.Name, _ = v.Values[1].(string)
+		file.Imports = append(file.Imports, imp)
+	}
+	if result, err = session.Run(ctx, `MATCH (:File {id: $file})-[:Import]->(p:Package) RETURN p.id`, params); err != nil {
+		return nil, err
+	}
+	if rs, err = result.Collect(ctx); err != nil {
+		return nil, err
+	}
+	for _, v := range rs {
+		if id, ok := v.Values[0].(string); ok {
+			file.Packages = append(file.Packages, id)
+		}
+	}
+	return file, nil
+}
+
+// GetReferenceFiles 可能引用符号的文件，字段和方法还包括调用所属类型其他方法的文件
+func (projectRepo *projectRepo) GetReferenceFiles(ctx context.Context, id string, limit int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, `MATCH (n {id: $id}) WHERE n:Entity OR n:Function OR n:Field
+        OPTIONAL MATCH (fieldOwner:Entity {id: n.entity_id})
+        OPTIONAL MATCH (methodOwner:Entity)-[:HasMethod]->(n)
+        WITH n, coalesce(fieldOwner, methodOwner) AS owner
+        WITH n, owner, coalesce(n.pkg_id, owner.pkg_id) AS pkg
+        CALL {
+            WITH pkg
+            MATCH (f:File {pkg_id: pkg}) RETURN f.id AS file
+            UNION
+            WITH pkg
+            MATCH (f:File)-[:Import]->(:Package {id: pkg}) RETURN f.id AS file
+            UNION
+            WITH n
+            MATCH (caller:Function)-[:Call|DispatchesTo]->(n) RETURN caller.file_id AS file
+            UNION
+            WITH owner
+            MATCH (caller:Function)-[:Call|DispatchesTo]->(:Function)<-[:HasMethod]-(owner) RETURN caller.file_id AS file
+        }
+        RETURN DISTINCT file ORDER BY file LIMIT $limit`, map[string]any{"id": id, "limit": limit})
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(rs))
+	for _, v := range rs {
+		if file, ok := v.Values[0].(string); ok {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// luceneSymbolQuery 把 query 中的每个词转为单词前缀查询，模糊匹配时再加上编辑距离和子串查询
+func luceneSymbolQuery(query string, mode v1.SymbolMatchMode) string {
+	terms := strings.Fields(strings.ToLower(query))
+	clauses := make([]string, 0, len(terms))
+	for _, t := range terms {
+		word := escapeLucene(t)
+		if mode != v1.SymbolMatchMode_MatchFuzzy {
+			clauses = append(clauses, "name_words:"+word+"*")
+			continue
+		}
+		clause := "name_words:" + word + "* OR name_words:" + word + "~2"
+		if grams := strings.Fields(symbolGrams(t)); len(grams) > 0 {
+			for i, gram := range grams {
+				grams[i] = "name_grams:" + escapeLucene(gram)
+			}
+			clause += " OR (" + strings.Join(grams, " AND ") + ")"
+		}
+		clauses = append(clauses, "("+clause+")")
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// escapeLucene 转义 Lucene 查询语法中的特殊字符
+func escapeLucene(term string) string {
+	var b strings.Builder
+	for _, r := range term {
+		if strings.ContainsRune(`\+-!():^[]"{}~*?|&/`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 func (projectRepo *projectRepo) BindRepoRoot(ctx context.Context, repoId, rootPkgId string) error {
 	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
 	defer session.Close(ctx)
@@ -245,6 +950,21 @@ func (projectRepo *projectRepo) GetRepoTree(ctx context.Context, id string) (pac
 	return
 }
 
+// getTestNodes 测试文件及其中实体的 ID
+func getTestNodes(files []*biz.File) map[string]bool {
+	tests := make(map[string]bool)
+	for _, file := range files {
+		if !file.Test {
+			continue
+		}
+		tests[file.ID] = true
+		for _, entity := range file.GetEntities() {
+			tests[entity.ID] = true
+		}
+	}
+	return tests
+}
+
 func getEntities(files []*biz.File) []*biz.Entity {
 	var entities []*biz.Entity
 	for _, file := range files {
@@ -278,26 +998,193 @@ func getImports(files []*biz.File) []*biz.Import {
 	return imports
 }
 
-func (projectRepo *projectRepo) QueryCallChain(ctx context.Context, id string) ([]*v1.CallRelationship, error) {
-	ctx, _ = context.WithTimeout(ctx, 5*time.Minute)
+// QueryCallEdges 查询与 ids 中函数直接相连的调用关系，只展开一层
+func (projectRepo *projectRepo) QueryCallEdges(ctx context.Context, ids []string, direction v1.CallDirection, relationTypes []string, limit int, excludeTests bool) ([]*biz.CallEdge, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	pattern := "(a:Function)-[rel:%s]->(b:Function)"
+	switch direction {
+	case v1.CallDirection_CallIncoming:
+		pattern = "(a:Function)<-[rel:%s]-(b:Function)"
+	case v1.CallDirection_CallBoth:
+		pattern = "(a:Function)-[rel:%s]-(b:Function)"
+	}
+	query := fmt.Sprintf(`MATCH `+pattern+`
+        WHERE a.id IN $ids AND NOT ($excludeTests AND coalesce(b.test, false))
+        WITH a.id AS fromID, b.id AS toID, rel, startNode(rel) AS caller, endNode(rel) AS callee, type(rel) AS relation
+        ORDER BY fromID, toID
+        LIMIT $limit
+        %s, fromID, toID`, strings.Join(relationTypes, "|"), callRelationshipReturn)
+	result, err := session.Run(ctx, query, map[string]any{"ids": ids, "limit": limit, "excludeTests": excludeTests})
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*biz.CallEdge, 0, len(rs))
+	for _, v := range rs {
+		edge := &biz.CallEdge{Relationship: callRelationship(v.Values)}
+		edge.From, _ = v.Values[15].(string)
+		edge.To, _ = v.Values[16].(string)
+		edges = append(edges, edge)
+	}
+	return edges, nil
+}
+
+// QueryCallersChain 反向逐层查找调用方，每层查询一次图数据库，路径中间的函数是 stopAt 中的入口时不再继续向上
+// 与 memory 实现一样只展开每个函数一次，不枚举所有路径
+func (projectRepo *projectRepo) QueryCallersChain(ctx context.Context, req *v1.CallersChainReq) ([]*v1.CallRelationship, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	relTypes := []string{biz.Call}
+	if req.FollowDispatch {
+		relTypes = append(relTypes, biz.DispatchesTo)
+	}
+	var entrypoints []string
+	var exported bool
+	for _, kind := range req.StopAt {
+		switch kind {
+		case v1.EntrypointKind_EntrypointMain:
+			entrypoints = append(entrypoints, biz.EntrypointMain)
+		case v1.EntrypointKind_EntrypointHTTP:
+			entrypoints = append(entrypoints, biz.EntrypointHTTP)
+		case v1.EntrypointKind_EntrypointExported:
+			exported = true
+		case v1.EntrypointKind_EntrypointTest:
+			entrypoints = append(entrypoints, biz.EntrypointTest)
+		}
+	}
+	targets, err := projectRepo.matchFunctions(ctx, `NOT ($excludeTests AND coalesce(f.test, false))`,
+		map[string]any{"ids": []string{req.Id}, "excludeTests": req.ExcludeTests})
+	if err != nil || !targets[req.Id] {
+		return nil, err
+	}
+	var relationships []*v1.CallRelationship
+	seen := make(map[string]bool)
+	visited := map[string]bool{req.Id: true}
+	level := []string{req.Id}
+	for depth := 1; depth <= int(req.MaxDepth) && len(level) > 0; depth++ {
+		edges, err := projectRepo.QueryCallEdges(ctx, level, v1.CallDirection_CallIncoming, relTypes, math.MaxInt32, req.ExcludeTests)
+		if err != nil {
+			return nil, err
+		}
+		var callers []string
+		for _, e := range edges {
+			if key := e.To + "->" + e.From; !seen[key] {
+				seen[key] = true
+				relationships = append(relationships, e.Relationship)
+			}
+			if !visited[e.To] {
+				visited[e.To] = true
+				callers = append(callers, e.To)
+			}
+		}
+		// 入口函数只作为路径的起点
+		stops, err := projectRepo.matchFunctions(ctx, `f.entrypoint IN $entrypoints OR ($exported AND coalesce(f.exported, false))`,
+			map[string]any{"ids": callers, "entrypoints": entrypoints, "exported": exported})
+		if err != nil {
+			return nil, err
+		}
+		level = nil
+		for _, id := range callers {
+			if !stops[id] {
+				level = append(level, id)
+			}
+		}
+	}
+	return relationships, nil
+}
+
+// matchFunctions ids 中满足 condition 的函数，condition 中的函数为 f
+func (projectRepo *projectRepo) matchFunctions(ctx context.Context, condition string, params map[string]any) (map[string]bool, error) {
+	matched := make(map[string]bool)
+	if ids, _ := params["ids"].([]string); len(ids) == 0 {
+		return matched, nil
+	}
 	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
 	defer session.Close(ctx)
-	query := `MATCH path = (start:Function {id: $id})-[:Call*]->(end:Function)
-        UNWIND relationships(path) AS rel
-        WITH startNode(rel) AS caller, endNode(rel) AS callee
-        RETURN caller.id AS callerID, caller.name AS callerName,
+	result, err := session.Run(ctx, `MATCH (f:Function) WHERE f.id IN $ids AND (`+condition+`) RETURN f.id`, params)
+	if err != nil {
+		return nil, err
+	}
+	for result.Next(ctx) {
+		if id, ok := result.Record().Values[0].(string); ok {
+			matched[id] = true
+		}
+	}
+	return matched, result.Err()
+}
+
+// QueryFunctionTests 通过 Tests 关系指向函数的测试函数，按层数、ID 排序
+func (projectRepo *projectRepo) QueryFunctionTests(ctx context.Context, id string) ([]*v1.FunctionTest, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	session := projectRepo.neo4jDriver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, `MATCH (n:Function)-[r:Tests]->(:Function {id: $id})
+        WITH n, r.depth AS depth
+        ORDER BY depth, n.id
+        OPTIONAL MATCH (owner:Entity {id: n.entity_id})
+        RETURN `+symbolReturn+`, depth`, map[string]any{"id": id})
+	if err != nil {
+		return nil, err
+	}
+	rs, err := result.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tests := make([]*v1.FunctionTest, 0, len(rs))
+	for _, v := range rs {
+		depth, _ := v.Values[symbolColumns].(int64)
+		tests = append(tests, &v1.FunctionTest{Symbol: symbolFromValues(v.Values), Depth: int32(depth)})
+	}
+	return tests, nil
+}
+
+// callRelationshipReturn 调用关系查询的返回列，与 collectCallRelationships 对应
+const callRelationshipReturn = `RETURN caller.id AS callerID, caller.name AS callerName,
                callee.id AS calleeID, callee.name AS calleeName,
                callee.file_id AS calleeFileID, caller.file_id AS callerFileID,
                callee.scope AS calleeScope, caller.scope AS callerScope,
-               callee.ent_id AS calleeEntId, caller.ent_id AS callerEntId`
+               callee.ent_id AS calleeEntId, caller.ent_id AS callerEntId,
+               relation, rel.start_line, rel.start_column, rel.end_line, rel.end_column`
 
-	result, err := session.Run(ctx, query, map[string]interface{}{"id": id},
-		func(config *neo4j.TransactionConfig) {
+// callRelationship 按 callRelationshipReturn 的列转换调用关系
+func callRelationship(values []any) *v1.CallRelationship {
+	r := &v1.CallRelationship{}
+	r.CallerId, _ = values[0].(string)
+	r.CallerName, _ = values[1].(string)
+	r.CalleeId, _ = values[2].(string)
+	r.CalleeName, _ = values[3].(string)
+	r.CalleeFileId, _ = values[4].(string)
+	r.CallerFileId, _ = values[5].(string)
+	r.CalleeScope, _ = values[6].(int64)
+	r.CallerScope, _ = values[7].(int64)
+	r.CalleeEntityId, _ = values[8].(string)
+	r.CallerEntityId, _ = values[9].(string)
+	r.Relation, _ = values[10].(string)
+	r.CallSite = sourceSpan(values[11:15])
+	return r
+}
 
-		})
-	if err != nil {
-		return nil, err
+// sourceSpan 按起始行、列，结束行、列的顺序转换源码位置，未记录位置时返回 nil
+func sourceSpan(values []any) *v1.SourceSpan {
+	startLine, _ := values[0].(int64)
+	if startLine <= 0 {
+		return nil
 	}
+	startColumn, _ := values[1].(int64)
+	endLine, _ := values[2].(int64)
+	endColumn, _ := values[3].(int64)
+	return &v1.SourceSpan{StartLine: int32(startLine), StartColumn: int32(startColumn), EndLine: int32(endLine), EndColumn: int32(endColumn)}
+}
+
+// collectCallRelationships 读取调用关系并去重
+func collectCallRelationships(ctx context.Context, result neo4j.ResultWithContext) ([]*v1.CallRelationship, error) {
 	var relationships []*v1.CallRelationship
 	rs, err := result.Collect(ctx)
 	if err != nil {
@@ -305,23 +1192,12 @@ func (projectRepo *projectRepo) QueryCallChain(ctx context.Context, id string) (
 	}
 	uniqueRelations := make(map[string]bool)
 	for _, v := range rs {
-		relationKey := fmt.Sprintf("%s->%s", v.Values[0].(string), v.Values[3].(string))
+		relationKey := fmt.Sprintf("%s->%s", v.Values[0].(string), v.Values[2].(string))
 		if uniqueRelations[relationKey] {
 			continue
 		}
 		uniqueRelations[relationKey] = true
-		relationships = append(relationships, &v1.CallRelationship{
-			CallerId:       v.Values[0].(string),
-			CallerName:     v.Values[1].(string),
-			CalleeId:       v.Values[2].(string),
-			CalleeName:     v.Values[3].(string),
-			CalleeFileId:   v.Values[4].(string),
-			CallerFileId:   v.Values[5].(string),
-			CalleeScope:    v.Values[6].(int64),
-			CallerScope:    v.Values[7].(int64),
-			CalleeEntityId: v.Values[8].(string),
-			CallerEntityId: v.Values[9].(string),
-		})
+		relationships = append(relationships, callRelationship(v.Values))
 	}
 	return relationships, nil
 }
@@ -355,6 +1231,7 @@ func (projectRepo *projectRepo) GetFunctionByFileId(ctx context.Context,
 				if v, ok := n.Props["ent_id"].(string); ok {
 					fn.FileId = v
 				}
+				fn.Span = sourceSpan([]any{n.Props["start_line"], n.Props["start_column"], n.Props["end_line"], n.Props["end_column"]})
 				functions = append(functions, fn)
 			}
 		}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	v1 "codewiki/api/codewiki/v1"
+	"testing"
+)
+
+func TestLuceneSymbolQuery(t *testing.T) {
+	if got, want := symbolGrams("GetUser"), "get etu tus use ser"; got != want {
+		t.Errorf("grams = %q, want %q", got, want)
+	}
+	if got := symbolGrams("id"); got != "" {
+		t.Errorf("grams of short name = %q", got)
+	}
+	for _, tt := range []struct {
+		query string
+		mode  v1.SymbolMatchMode
+		want  string
+	}{
+		{"User", v1.SymbolMatchMode_MatchPrefix, "name_words:user*"},
+		{"get user", v1.SymbolMatchMode_MatchPrefix, "name_words:get* AND name_words:user*"},
+		{"a+b", v1.SymbolMatchMode_MatchPrefix, `name_words:a\+b*`},
+		// 模糊匹配时子串通过三元组查询
+		{"sEr", v1.SymbolMatchMode_MatchFuzzy, "(name_words:ser* OR name_words:ser~2 OR (name_grams:ser))"},
+		{"id", v1.SymbolMatchMode_MatchFuzzy, "(name_words:id* OR name_words:id~2)"},
+	} {
+		if got := luceneSymbolQuery(tt.query, tt.mode); got != tt.want {
+			t.Errorf("luceneSymbolQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package repo
+
+import (
+	"codewiki/internal/biz"
+	"codewiki/internal/conf"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// 代码块向量存储的驱动
+const (
+	VectorDriverMilvus = "milvus"
+	VectorDriverQdrant = "qdrant"
+)
+
+const (
+	defaultQdrantCollection = "code_chunks"
+	defaultQdrantPort       = 6334
+)
+
+// qdrantIndexedFields 建立 keyword 索引的 payload 字段，搜索时按这些字段过滤
+var qdrantIndexedFields = []string{"repo_id", "scope", "path"}
+
+// NewIndexerRepo 按 data.vectorStore.driver 创建代码块向量存储
+func NewIndexerRepo(c *conf.Data) (biz.IndexerRepo, error) {
+	switch c.GetVectorStore().GetDriver() {
+	case "", VectorDriverMilvus:
+		return NewMilvus(c)
+	case VectorDriverQdrant:
+		return NewQdrant(c)
+	case VectorDriverLocal:
+		return NewLocalVectorStore(c.GetVectorStore().GetDir())
+	}
+	return nil, fmt.Errorf("unknown vector store driver %s", c.GetVectorStore().GetDriver())
+}
+
+// Qdrant 所有仓库的代码块保存在同一个 collection 中，按 payload 的 repo_id 区分
+type Qdrant struct {
+	client     *qdrant.Client
+	collection string
+	dimension  int
+
+	mu      sync.Mutex
+	ensured bool
+}
+
+func NewQdrant(c *conf.Data) (biz.IndexerRepo, error) {
+	store := c.GetVectorStore()
+	host, port, err := splitQdrantTarget(store.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+	client, err := qdrant.NewClient(&qdrant.Config{
+		Host:   host,
+		Port:   port,
+		APIKey: store.GetApiKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	collection := store.GetCollection()
+	if collection == "" {
+		collection = defaultQdrantCollection
+	}
+	return &Qdrant{client: client, collection: collection, dimension: int(c.GetEmbedding().GetDimension())}, nil
+}
+
+// splitQdrantTarget 解析 host:port，未指定端口时使用 gRPC 默认端口
+func splitQdrantTarget(target string) (string, int, error) {
+	if target == "" {
+		return "", 0, fmt.Errorf("qdrant target is empty")
+	}
+	host, portStr, err := net.SplitHostPort(target)
+	if err != nil {
+		return target, defaultQdrantPort, nil
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid qdrant target %s: %w", target, err)
+	}
+	return host, port, nil
+}
+
+// ensureCollection collection 不存在时按向量维度创建，并为过滤字段建立索引
+// 未配置 embedding.dimension 时使用第一批向量的长度
+func (q *Qdrant) ensureCollection(ctx context.Context, dimension int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.ensured {
+		return nil
+	}
+	exists, err := q.client.CollectionExists(ctx, q.collection)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if q.dimension > 0 {
+			dimension = q.dimension
+		}
+		if dimension <= 0 {
+			return fmt.Errorf("unknown vector dimension for qdrant collection %s", q.collection)
+		}
+		err = q.client.CreateCollection(ctx, &qdrant.CreateCollection{
+			CollectionName: q.collection,
+			VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
+				Size:     uint64(dimension),
+				Distance: qdrant.Distance_Cosine,
+			}),
+		})
+		if err != nil {
+			return err
+		}
+		for _, field := range qdrantIndexedFields {
+			_, err = q.client.CreateFieldIndex(ctx, &qdrant.CreateFieldIndexCollection{
+				CollectionName: q.collection,
+				FieldName:      field,
+				FieldType:      qdrant.FieldType_FieldTypeKeyword.Enum(),
+				Wait:           qdrant.PtrOf(true),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	q.ensured = true
+	return nil
+}
+
+// qdrantPointID 点 ID 只能是整数或 UUID，由仓库和代码块 ID 生成，重新索引时覆盖原来的点
+func qdrantPointID(partition, chunkID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(partition+"/"+chunkID)).String()
+}
+
+// qdrantFilter 按仓库过滤，范围和路径不为空时同时过滤
+func qdrantFilter(req *biz.SearchCodeChunksReq) *qdrant.Filter {
+	filter := &qdrant.Filter{}
+	if req.Partition != "" {
+		filter.Must = append(filter.Must, qdrant.NewMatch("repo_id", req.Partition))
+	}
+	if req.Scope != "" {
+		filter.Must = append(filter.Must, qdrant.NewMatch("scope", string(req.Scope)))
+	}
+	if req.Path != "" {
+		filter.Must = append(filter.Must, qdrant.NewMatch("path", req.Path))
+	}
+	return filter
+}
+
+func qdrantPayload(projectName, partition string, codeChunk *biz.CodeChunk) map[string]*qdrant.Value {
+	return qdrant.NewValueMap(map[string]any{
+		"repo_id":  partition,
+		"project":  projectName,
+		"chunk_id": codeChunk.Id,
+		"path":     codeChunk.Path,
+		"content":  codeChunk.Content,
+		"document": codeChunk.Document,
+		"logic":    codeChunk.Logic,
+		"scope":    string(codeChunk.Scope),
+	})
+}
+
+func qdrantCodeChunk(payload map[string]*qdrant.Value) *biz.CodeChunk {
+	return &biz.CodeChunk{
+		Id:       payload["chunk_id"].GetStringValue(),
+		Path:     payload["path"].GetStringValue(),
+		Content:  payload["content"].GetStringValue(),
+		Document: payload["document"].GetStringValue(),
+		Logic:    payload["logic"].GetStringValue(),
+		Scope:    biz.Scope(payload["scope"].GetStringValue()),
+	}
+}
+
+func (q *Qdrant) SaveCodeChunk(ctx context.Context, projectName, partition string, codeChunks []*biz.CodeChunk) error {
+	if len(codeChunks) == 0 {
+		return nil
+	}
+	ctx = context.WithoutCancel(ctx)
+	if err := q.ensureCollection(ctx, len(codeChunks[0].CodeVector())); err != nil {
+		return err
+	}
+	// 删除这些文件已有的代码块，文件中已删除的函数不再残留
+	seen := make(map[string]bool)
+	var paths []string
+	for _, codeChunk := range codeChunks {
+		if !seen[codeChunk.Path] {
+			seen[codeChunk.Path] = true
+			paths = append(paths, codeChunk.Path)
+		}
+	}
+	if err := q.delete(ctx, qdrant.NewMatch("repo_id", partition), qdrant.NewMatchKeywords("path", paths...)); err != nil {
+		return err
+	}
+	points := make([]*qdrant.PointStruct, 0, len(codeChunks))
+	for _, codeChunk := range codeChunks {
+		points = append(points, &qdrant.PointStruct{
+			Id:      qdrant.NewID(qdrantPointID(partition, codeChunk.Id)),
+			Vectors: qdrant.NewVectorsDense(codeChunk.CodeVector()),
+			Payload: qdrantPayload(projectName, partition, codeChunk),
+		})
+	}
+	_, err := q.client.Upsert(ctx, &qdrant.UpsertPoints{
+		CollectionName: q.collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         points,
+	})
+	return err
+}
+
+// delete 删除满足所有条件的点
+func (q *Qdrant) delete(ctx context.Context, conditions ...*qdrant.Condition) error {
+	_, err := q.client.Delete(ctx, &qdrant.DeletePoints{
+		CollectionName: q.collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         qdrant.NewPointsSelectorFilter(&qdrant.Filter{Must: conditions}),
+	})
+	return err
+}
+
+// DeleteCodeChunks 删除仓库的所有点，collection 不存在时不做任何事
+func (q *Qdrant) DeleteCodeChunks(ctx context.Context, projectName, partition string) error {
+	ctx = context.WithoutCancel(ctx)
+	exists, err := q.client.CollectionExists(ctx, q.collection)
+	if err != nil || !exists {
+		return err
+	}
+	return q.delete(ctx, qdrant.NewMatch("repo_id", partition))
+}
+
+// SearchCodeChunk 搜索代码块
+func (q *Qdrant) SearchCodeChunk(ctx context.Context, req *biz.SearchCodeChunksReq) ([]*biz.CodeChunk, error) {
+	ctx = context.WithoutCancel(ctx)
+	if err := q.ensureCollection(ctx, len(req.QueryVector)); err != nil {
+		return nil, err
+	}
+	points, err := q.client.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: q.collection,
+		Query:          qdrant.NewQueryDense(req.QueryVector),
+		Filter:         qdrantFilter(req),
+		Limit:          qdrant.PtrOf(uint64(req.Limit)),
+		WithPayload:    qdrant.NewWithPayload(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*biz.CodeChunk, 0, len(points))
+	for _, point := range points {
+		results = append(results, qdrantCodeChunk(point.GetPayload()))
+	}
+	return results, nil
+}
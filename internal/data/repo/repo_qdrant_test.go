@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"codewiki/internal/biz"
+	"reflect"
+	"testing"
+)
+
+func TestQdrantHelpers(t *testing.T) {
+	for target, want := range map[string]int{"localhost": defaultQdrantPort, "qdrant:7000": 7000} {
+		if _, port, err := splitQdrantTarget(target); err != nil || port != want {
+			t.Errorf("%s port = %d, err = %v", target, port, err)
+		}
+	}
+	if _, _, err := splitQdrantTarget("qdrant:grpc"); err == nil {
+		t.Error("invalid port accepted")
+	}
+
+	// 同一仓库同一代码块的点 ID 稳定，不同仓库不冲突
+	if qdrantPointID("repo", "a@main.go") != qdrantPointID("repo", "a@main.go") ||
+		qdrantPointID("repo", "a@main.go") == qdrantPointID("other", "a@main.go") {
+		t.Error("point id is not stable per repo")
+	}
+
+	chunk := &biz.CodeChunk{Id: "a@main.go", Path: "main.go", Content: "package main", Document: "doc", Logic: "logic", Scope: biz.ChunkFileScope}
+	payload := qdrantPayload("app", "repo", chunk)
+	if payload["repo_id"].GetStringValue() != "repo" {
+		t.Errorf("payload = %v", payload)
+	}
+	if got := qdrantCodeChunk(payload); !reflect.DeepEqual(got, chunk) {
+		t.Errorf("chunk = %+v, want %+v", got, chunk)
+	}
+
+	filter := qdrantFilter(&biz.SearchCodeChunksReq{Partition: "repo", Scope: biz.ChunkFunctionScope})
+	var fields []string
+	for _, condition := range filter.Must {
+		fields = append(fields, condition.GetField().GetKey()+"="+condition.GetField().GetMatch().GetKeyword())
+	}
+	if want := []string{"repo_id=repo", "scope=function"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("filter = %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,398 @@
+package repo
+
+import (
+	"codewiki/internal/biz"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// VectorDriverLocal 内嵌的本地向量存储
+const VectorDriverLocal = "local"
+
+const (
+	// vectorFileExt 每个仓库的代码块保存为数据目录中的一个文件
+	vectorFileExt = ".gob"
+	// defaultExactSearchLimit 仓库的代码块数不超过该值时精确搜索，超过时使用 IVF 索引
+	defaultExactSearchLimit = 20000
+	// ivfIterations 构建 IVF 索引时 k-means 的迭代次数
+	ivfIterations = 8
+	// ivfMinProbe 搜索时至少探查的聚类数
+	ivfMinProbe = 4
+)
+
+// localChunk 保存的代码块，向量已归一化，余弦相似度即点积
+type localChunk struct {
+	Id       string
+	Path     string
+	Content  string
+	Document string
+	Logic    string
+	Scope    string
+	Vector   []float32
+}
+
+// localPartitionData 一个仓库保存到磁盘的数据
+type localPartitionData struct {
+	Partition string
+	Project   string
+	Chunks    []*localChunk
+}
+
+// localPartition 一个仓库的代码块，ivf 在代码块变更后失效，搜索时重新构建
+type localPartition struct {
+	data  localPartitionData
+	index map[string]int
+	ivf   *ivfIndex
+}
+
+// ivfIndex 倒排文件索引，lists[i] 为归属第 i 个聚类中心的代码块下标
+type ivfIndex struct {
+	centroids [][]float32
+	lists     [][]int
+}
+
+// localVectorStore 纯 Go 的向量存储，按仓库（partition）分区，dir 不为空时每个仓库保存为一个文件
+type localVectorStore struct {
+	mu         sync.Mutex
+	dir        string
+	partitions map[string]*localPartition
+	// exactLimit 分区代码块数超过该值时使用 IVF 索引
+	exactLimit int
+}
+
+// NewLocalVectorStore 创建本地向量存储，dir 为空时只保存在内存中，否则从 dir 中加载已保存的代码块
+func NewLocalVectorStore(dir string) (biz.IndexerRepo, error) {
+	s := &localVectorStore{dir: dir, partitions: make(map[string]*localPartition), exactLimit: defaultExactSearchLimit}
+	if dir == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"+vectorFileExt))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		p, err := loadLocalPartition(file)
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", file, err)
+		}
+		s.partitions[p.data.Partition] = p
+	}
+	return s, nil
+}
+
+func loadLocalPartition(file string) (*localPartition, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p := &localPartition{}
+	if err = gob.NewDecoder(f).Decode(&p.data); err != nil {
+		return nil, err
+	}
+	p.reindex()
+	return p, nil
+}
+
+func (p *localPartition) reindex() {
+	p.index = make(map[string]int, len(p.data.Chunks))
+	for i, c := range p.data.Chunks {
+		p.index[c.Id] = i
+	}
+	p.ivf = nil
+}
+
+// persist 写入临时文件后替换，写入中途失败不会损坏已保存的分区
+func (s *localVectorStore) persist(p *localPartition) error {
+	if s.dir == "" {
+		return nil
+	}
+	file := s.file(p.data.Partition)
+	f, err := os.CreateTemp(s.dir, filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if err = gob.NewEncoder(f).Encode(&p.data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), file)
+}
+
+// file 仓库保存的文件
+func (s *localVectorStore) file(partition string) string {
+	return filepath.Join(s.dir, url.PathEscape(partition)+vectorFileExt)
+}
+
+// normalize 返回归一化后的向量，零向量原样返回
+func normalize(vector []float32) []float32 {
+	var sum float64
+	for _, v := range vector {
+		sum += float64(v) * float64(v)
+	}
+	normalized := make([]float32, len(vector))
+	if sum == 0 {
+		copy(normalized, vector)
+		return normalized
+	}
+	norm := float32(math.Sqrt(sum))
+	for i, v := range vector {
+		normalized[i] = v / norm
+	}
+	return normalized
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// SaveCodeChunk 先删除仓库中与这些代码块同路径的代码块，再按代码块 ID 覆盖或追加
+func (s *localVectorStore) SaveCodeChunk(ctx context.Context, projectName, partition string, codeChunks []*biz.CodeChunk) error {
+	if len(codeChunks) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.partitions[partition]
+	if p == nil {
+		p = &localPartition{data: localPartitionData{Partition: partition}}
+		p.reindex()
+	}
+	dimension := 0
+	if len(p.data.Chunks) > 0 {
+		dimension = len(p.data.Chunks[0].Vector)
+	}
+	chunks := make([]*localChunk, 0, len(codeChunks))
+	for _, cc := range codeChunks {
+		vector := cc.CodeVector()
+		if dimension == 0 {
+			dimension = len(vector)
+		}
+		if len(vector) == 0 || len(vector) != dimension {
+			return fmt.Errorf("code chunk %s has vector dimension %d, want %d", cc.Id, len(vector), dimension)
+		}
+		chunks = append(chunks, &localChunk{
+			Id:       cc.Id,
+			Path:     cc.Path,
+			Content:  cc.Content,
+			Document: cc.Document,
+			Logic:    cc.Logic,
+			Scope:    string(cc.Scope),
+			Vector:   normalize(vector),
+		})
+	}
+	p.data.Project = projectName
+	replaced := make(map[string]bool)
+	for _, c := range chunks {
+		replaced[c.Path] = true
+	}
+	kept := p.data.Chunks[:0]
+	for _, c := range p.data.Chunks {
+		if !replaced[c.Path] {
+			kept = append(kept, c)
+		}
+	}
+	p.data.Chunks = kept
+	p.reindex()
+	for _, c := range chunks {
+		if i, ok := p.index[c.Id]; ok {
+			p.data.Chunks[i] = c
+			continue
+		}
+		p.index[c.Id] = len(p.data.Chunks)
+		p.data.Chunks = append(p.data.Chunks, c)
+	}
+	p.ivf = nil
+	s.partitions[partition] = p
+	return s.persist(p)
+}
+
+// DeleteCodeChunks 删除仓库的分区及保存的文件
+func (s *localVectorStore) DeleteCodeChunks(ctx context.Context, projectName, partition string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.partitions, partition)
+	if s.dir == "" {
+		return nil
+	}
+	err := os.Remove(s.file(partition))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// localHit 搜索命中的代码块和相似度
+type localHit struct {
+	chunk *localChunk
+	score float32
+}
+
+// SearchCodeChunk 按余弦相似度返回前 Limit 个代码块，Partition 为空时搜索所有仓库
+func (s *localVectorStore) SearchCodeChunk(ctx context.Context, req *biz.SearchCodeChunksReq) ([]*biz.CodeChunk, error) {
+	if req.Limit <= 0 || len(req.QueryVector) == 0 {
+		return nil, nil
+	}
+	query := normalize(req.QueryVector)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var hits []localHit
+	for partition, p := range s.partitions {
+		if req.Partition != "" && partition != req.Partition || len(p.data.Chunks) == 0 {
+			continue
+		}
+		if dimension := len(p.data.Chunks[0].Vector); dimension != len(query) {
+			return nil, fmt.Errorf("query vector dimension %d, want %d", len(query), dimension)
+		}
+		hits = append(hits, s.searchPartition(p, query, req)...)
+	}
+	hits = topHits(hits, req.Limit)
+	results := make([]*biz.CodeChunk, 0, len(hits))
+	for _, hit := range hits {
+		c := hit.chunk
+		result := &biz.CodeChunk{Id: c.Id, Path: c.Path, Content: c.Content, Document: c.Document, Logic: c.Logic, Scope: biz.Scope(c.Scope)}
+		result.SetCodeVector(c.Vector)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// searchPartition 代码块数较少或按路径过滤时精确搜索，否则在 IVF 索引中探查最近的聚类
+// 探查结果不足 Limit 个时退回精确搜索
+func (s *localVectorStore) searchPartition(p *localPartition, query []float32, req *biz.SearchCodeChunksReq) []localHit {
+	match := func(c *localChunk) bool {
+		return (req.Scope == "" || c.Scope == string(req.Scope)) && (req.Path == "" || c.Path == req.Path)
+	}
+	chunks := p.data.Chunks
+	if len(chunks) > s.exactLimit && req.Path == "" {
+		if p.ivf == nil {
+			p.ivf = buildIVF(chunks)
+		}
+		var hits []localHit
+		for _, list := range p.ivf.probe(query) {
+			for _, i := range list {
+				if match(chunks[i]) {
+					hits = append(hits, localHit{chunk: chunks[i], score: dot(query, chunks[i].Vector)})
+				}
+			}
+		}
+		if len(hits) >= req.Limit {
+			return topHits(hits, req.Limit)
+		}
+	}
+	var hits []localHit
+	for _, c := range chunks {
+		if match(c) {
+			hits = append(hits, localHit{chunk: c, score: dot(query, c.Vector)})
+		}
+	}
+	return topHits(hits, req.Limit)
+}
+
+// topHits 按相似度降序取前 limit 个，相似度相同时按路径和 ID 排序
+func topHits(hits []localHit, limit int) []localHit {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		if hits[i].chunk.Path != hits[j].chunk.Path {
+			return hits[i].chunk.Path < hits[j].chunk.Path
+		}
+		return hits[i].chunk.Id < hits[j].chunk.Id
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// buildIVF 以 k-means 把代码块分为 sqrt(n) 个聚类，初始中心均匀取自代码块，结果是确定的
+func buildIVF(chunks []*localChunk) *ivfIndex {
+	k := int(math.Sqrt(float64(len(chunks))))
+	if k < 1 {
+		k = 1
+	}
+	dimension := len(chunks[0].Vector)
+	centroids := make([][]float32, k)
+	for i := range centroids {
+		centroids[i] = append([]float32(nil), chunks[i*len(chunks)/k].Vector...)
+	}
+	assign := make([]int, len(chunks))
+	for iteration := 0; iteration < ivfIterations; iteration++ {
+		for i, c := range chunks {
+			assign[i] = nearestCentroid(centroids, c.Vector)
+		}
+		sums := make([][]float32, k)
+		for i := range sums {
+			sums[i] = make([]float32, dimension)
+		}
+		counts := make([]int, k)
+		for i, c := range chunks {
+			counts[assign[i]]++
+			for d, v := range c.Vector {
+				sums[assign[i]][d] += v
+			}
+		}
+		// 空聚类保留原来的中心
+		for i := range centroids {
+			if counts[i] > 0 {
+				centroids[i] = normalize(sums[i])
+			}
+		}
+	}
+	index := &ivfIndex{centroids: centroids, lists: make([][]int, k)}
+	for i, c := range chunks {
+		cluster := nearestCentroid(centroids, c.Vector)
+		index.lists[cluster] = append(index.lists[cluster], i)
+	}
+	return index
+}
+
+func nearestCentroid(centroids [][]float32, vector []float32) int {
+	best, bestScore := 0, float32(math.Inf(-1))
+	for i, centroid := range centroids {
+		if score := dot(centroid, vector); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// probe 返回与查询最近的若干聚类，探查数为聚类数的八分之一，至少 ivfMinProbe 个
+func (idx *ivfIndex) probe(query []float32) [][]int {
+	order := make([]int, len(idx.centroids))
+	scores := make([]float32, len(idx.centroids))
+	for i, centroid := range idx.centroids {
+		order[i] = i
+		scores[i] = dot(centroid, query)
+	}
+	sort.Slice(order, func(i, j int) bool { return scores[order[i]] > scores[order[j]] })
+	n := max(len(order)/8, ivfMinProbe)
+	if n > len(order) {
+		n = len(order)
+	}
+	lists := make([][]int, 0, n)
+	for _, i := range order[:n] {
+		lists = append(lists, idx.lists[i])
+	}
+	return lists
+}
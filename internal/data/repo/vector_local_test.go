@@ -0,0 +1,137 @@
+package repo
+
+import (
+	"codewiki/internal/biz"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func newChunk(id, path string, scope biz.Scope, vector ...float32) *biz.CodeChunk {
+	chunk := &biz.CodeChunk{Id: id, Path: path, Content: id, Scope: scope}
+	chunk.SetCodeVector(vector)
+	return chunk
+}
+
+func chunkIds(chunks []*biz.CodeChunk) []string {
+	var ids []string
+	for _, c := range chunks {
+		ids = append(ids, c.Id)
+	}
+	return ids
+}
+
+func TestLocalVectorStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewLocalVectorStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.SaveCodeChunk(ctx, "app", "repo", []*biz.CodeChunk{
+		newChunk("a", "a.go", biz.ChunkFileScope, 1, 0, 0),
+		newChunk("b", "b.go", biz.ChunkFileScope, 1, 1, 0),
+		newChunk("b:Run", "b.go", biz.ChunkFunctionScope, 0, 1, 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SaveCodeChunk(ctx, "other", "other", []*biz.CodeChunk{newChunk("x", "x.go", biz.ChunkFileScope, 1, 0, 0)}); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SaveCodeChunk(ctx, "app", "repo", []*biz.CodeChunk{newChunk("c", "c.go", biz.ChunkFileScope, 1, 0)}); err == nil {
+		t.Error("dimension mismatch accepted")
+	}
+
+	// 余弦相似度与向量长度无关，只搜索指定仓库
+	results, err := store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 2, QueryVector: []float32{3, 0.1, 0}, Partition: "repo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(chunkIds(results)); got != "[a b]" {
+		t.Errorf("results = %s", got)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 5, QueryVector: []float32{1, 0, 0}, Partition: "repo", Scope: biz.ChunkFunctionScope})
+	if got := fmt.Sprint(chunkIds(results)); got != "[b:Run]" {
+		t.Errorf("function results = %s", got)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 5, QueryVector: []float32{0, 1, 0}, Partition: "repo", Path: "b.go"})
+	if got := fmt.Sprint(chunkIds(results)); got != "[b:Run b]" {
+		t.Errorf("path results = %s", got)
+	}
+
+	// 重新保存同一代码块时覆盖，重新打开数据目录后仍可搜索
+	if err = store.SaveCodeChunk(ctx, "app", "repo", []*biz.CodeChunk{newChunk("a", "a.go", biz.ChunkFileScope, 0, 0, 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if store, err = NewLocalVectorStore(dir); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 1, QueryVector: []float32{0, 0, 1}, Partition: "repo"})
+	if got := fmt.Sprint(chunkIds(results)); got != "[a]" {
+		t.Errorf("reloaded results = %s", got)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 10, QueryVector: []float32{1, 0, 0}})
+	if len(results) != 4 {
+		t.Errorf("all repos results = %v", chunkIds(results))
+	}
+
+	// 重新索引 b.go 时替换该文件的所有代码块，已删除的函数不再残留
+	if err = store.SaveCodeChunk(ctx, "app", "repo", []*biz.CodeChunk{newChunk("b", "b.go", biz.ChunkFileScope, 0, 1, 0)}); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 10, QueryVector: []float32{0, 1, 0}, Partition: "repo"})
+	if got := fmt.Sprint(chunkIds(results)); got != "[b a]" {
+		t.Errorf("replaced results = %s", got)
+	}
+
+	// 删除仓库后其他仓库不受影响，重新打开数据目录后也不再加载
+	if err = store.DeleteCodeChunks(ctx, "app", "repo"); err != nil {
+		t.Fatal(err)
+	}
+	if store, err = NewLocalVectorStore(dir); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = store.SearchCodeChunk(ctx, &biz.SearchCodeChunksReq{Limit: 10, QueryVector: []float32{1, 0, 0}})
+	if got := fmt.Sprint(chunkIds(results)); got != "[x]" {
+		t.Errorf("results after delete = %s", got)
+	}
+}
+
+func TestLocalVectorStoreIVF(t *testing.T) {
+	ctx := context.Background()
+	random := rand.New(rand.NewSource(1))
+	var chunks []*biz.CodeChunk
+	for i := 0; i < 2000; i++ {
+		vector := make([]float32, 16)
+		for d := range vector {
+			vector[d] = random.Float32()*2 - 1
+		}
+		chunks = append(chunks, newChunk(fmt.Sprintf("chunk-%d", i), "a.go", biz.ChunkFunctionScope, vector...))
+	}
+	exact, _ := NewLocalVectorStore("")
+	approximate, _ := NewLocalVectorStore("")
+	approximate.(*localVectorStore).exactLimit = 100
+	for _, store := range []biz.IndexerRepo{exact, approximate} {
+		if err := store.SaveCodeChunk(ctx, "app", "repo", chunks); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 查询已保存的向量时，IVF 索引必须找到它本身
+	for i := 0; i < 50; i++ {
+		query := chunks[random.Intn(len(chunks))]
+		req := &biz.SearchCodeChunksReq{Limit: 5, QueryVector: query.CodeVector(), Partition: "repo"}
+		want, _ := exact.SearchCodeChunk(ctx, req)
+		got, err := approximate.SearchCodeChunk(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 5 || got[0].Id != query.Id || want[0].Id != query.Id {
+			t.Fatalf("query %s: ivf = %v, exact = %v", query.Id, chunkIds(got), chunkIds(want))
+		}
+	}
+	if approximate.(*localVectorStore).partitions["repo"].ivf == nil {
+		t.Error("ivf index not built")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 
 	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
@@ -32,6 +33,7 @@ func NewGRPCServer(c *conf.Server, codeWikiService *service.CodeWikiService, log
 			middleware.Metric(),
 			middleware.TraceparentMiddleware(),
 			ratelimit.Server(ratelimit.WithLimiter(bbr.NewLimiter())),
+			selector.Server(middleware.Timeout(longRequestTimeout)).Path(longRequestOperations...).Build(),
 		),
 	}
 	if c.Grpc.Network != "" {
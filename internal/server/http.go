@@ -10,6 +10,7 @@ import (
 	"github.com/go-kratos/aegis/ratelimit/bbr"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -110,6 +111,7 @@ func NewHTTPServer(c *conf.Server, codeWikiService *service.CodeWikiService, log
 
 		middleware.Metric(),
 		ratelimit.Server(ratelimit.WithLimiter(bbr.NewLimiter())),
+		selector.Server(middleware.Timeout(longRequestTimeout)).Path(longRequestOperations...).Build(),
 	))
 	srv := http.NewServer(opts...)
 	v1.RegisterCodeWikiServiceHTTPServer(srv, codeWikiService)
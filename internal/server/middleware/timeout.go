@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Timeout 以 timeout 替换服务端统一的请求超时，用于耗时较长的接口
+// 客户端断开连接时仍然取消请求
+func Timeout(timeout time.Duration) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			parent := ctx
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(parent), timeout)
+			defer cancel()
+			stop := context.AfterFunc(parent, func() {
+				if errors.Is(parent.Err(), context.Canceled) {
+					cancel()
+				}
+			})
+			defer stop()
+			return handler(ctx, req)
+		}
+	}
+}
@@ -1,8 +1,17 @@
 package server
 
 import (
+	v1 "codewiki/api/codewiki/v1"
+	"time"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(NewHTTPServer, NewGRPCServer)
+
+// longRequestTimeout 同步拉取和解析仓库的接口的超时，不受 server.http.timeout 和 server.grpc.timeout 限制
+const longRequestTimeout = 10 * time.Minute
+
+// longRequestOperations 使用 longRequestTimeout 的接口
+var longRequestOperations = []string{v1.OperationCodeWikiServiceCheckAPICompatibility}
@@ -25,8 +25,16 @@ func NewCodeWikiService(codeWiki *biz.CodeWiki, qa *biz.QAEngine) *CodeWikiServi
 }
 
 func (s *CodeWikiService) CallChain(ctx context.Context, req *v1.CallChainReq) (*v1.CallChainResp, error) {
+	resp, err := s.codeWiki.QueryCallChain(ctx, req)
+	if err != nil {
+		return &v1.CallChainResp{Code: 1000, Msg: err.Error()}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) CallersChain(ctx context.Context, req *v1.CallersChainReq) (*v1.CallChainResp, error) {
 	resp := new(v1.CallChainResp)
-	callRelations, err := s.codeWiki.QueryCallChain(ctx, req.Id)
+	callRelations, err := s.codeWiki.QueryCallersChain(ctx, req)
 	if err != nil {
 		resp.Code = 1000
 		resp.Msg = err.Error()
@@ -36,6 +44,14 @@ func (s *CodeWikiService) CallChain(ctx context.Context, req *v1.CallChainReq) (
 	return resp, nil
 }
 
+func (s *CodeWikiService) CallPath(ctx context.Context, req *v1.CallPathReq) (*v1.CallPathResp, error) {
+	resp, err := s.codeWiki.QueryCallPath(ctx, req)
+	if err != nil {
+		return &v1.CallPathResp{}, err
+	}
+	return resp, nil
+}
+
 func (s *CodeWikiService) CreateRepo(ctx context.Context, req *v1.CreateRepoReq) (*v1.CreateRepoResp, error) {
 	id, err := s.codeWiki.CreateRepo(ctx, req)
 	if err != nil {
@@ -69,17 +85,121 @@ func (s *CodeWikiService) DeleteRepo(ctx context.Context, req *v1.DeleteRepoReq)
 
 func (s *CodeWikiService) AnalyzeRepo(ctx context.Context, req *v1.AnalyzeRepoReq) (*v1.AnalyzeResp, error) {
 	resp := new(v1.AnalyzeResp)
-	err := s.codeWiki.AnalyzeRepo(ctx, req.Id)
+	job, err := s.codeWiki.AnalyzeRepo(ctx, req)
 	if err != nil {
 		resp.Code = 1000
 		resp.Msg = err.Error()
 		return resp, err
 	}
+	resp.JobId = job.Id
+	return resp, nil
+}
+
+func (s *CodeWikiService) GetAnalysisJob(ctx context.Context, req *v1.GetAnalysisJobReq) (*v1.GetAnalysisJobResp, error) {
+	job, err := s.codeWiki.GetAnalysisJob(ctx, req.Id)
+	if err != nil {
+		return &v1.GetAnalysisJobResp{}, err
+	}
+	return &v1.GetAnalysisJobResp{Job: job}, nil
+}
+
+func (s *CodeWikiService) ListAnalysisJobs(ctx context.Context, req *v1.ListAnalysisJobsReq) (*v1.ListAnalysisJobsResp, error) {
+	jobs, err := s.codeWiki.ListAnalysisJobs(ctx, req.RepoId, int(req.Limit))
+	if err != nil {
+		return &v1.ListAnalysisJobsResp{}, err
+	}
+	return &v1.ListAnalysisJobsResp{Jobs: jobs}, nil
+}
+
+func (s *CodeWikiService) CancelAnalysisJob(ctx context.Context, req *v1.CancelAnalysisJobReq) (*v1.CancelAnalysisJobResp, error) {
+	if err := s.codeWiki.CancelAnalysisJob(ctx, req.Id); err != nil {
+		return &v1.CancelAnalysisJobResp{}, err
+	}
+	return &v1.CancelAnalysisJobResp{}, nil
+}
+
+func (s *CodeWikiService) ListSnapshots(ctx context.Context, req *v1.ListSnapshotsReq) (*v1.ListSnapshotsResp, error) {
+	snapshots, err := s.codeWiki.ListSnapshots(ctx, req.Id)
+	if err != nil {
+		return &v1.ListSnapshotsResp{}, err
+	}
+	return &v1.ListSnapshotsResp{Snapshots: snapshots}, nil
+}
+
+func (s *CodeWikiService) DiffGraphs(ctx context.Context, req *v1.DiffGraphsReq) (*v1.DiffGraphsResp, error) {
+	resp, err := s.codeWiki.DiffGraphs(ctx, req)
+	if err != nil {
+		return &v1.DiffGraphsResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) CheckAPICompatibility(ctx context.Context, req *v1.CheckAPICompatibilityReq) (*v1.CheckAPICompatibilityResp, error) {
+	resp, err := s.codeWiki.CheckAPICompatibility(ctx, req)
+	if err != nil {
+		return &v1.CheckAPICompatibilityResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) SearchSymbols(ctx context.Context, req *v1.SearchSymbolsReq) (*v1.SearchSymbolsResp, error) {
+	resp, err := s.codeWiki.SearchSymbols(ctx, req)
+	if err != nil {
+		return &v1.SearchSymbolsResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) ResolveSymbolAt(ctx context.Context, req *v1.ResolveSymbolAtReq) (*v1.ResolveSymbolAtResp, error) {
+	resp, err := s.codeWiki.ResolveSymbolAt(ctx, req)
+	if err != nil {
+		return &v1.ResolveSymbolAtResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) FindReferences(ctx context.Context, req *v1.FindReferencesReq) (*v1.FindReferencesResp, error) {
+	resp, err := s.codeWiki.FindReferences(ctx, req)
+	if err != nil {
+		return &v1.FindReferencesResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) AnalyzeImpact(ctx context.Context, req *v1.AnalyzeImpactReq) (*v1.AnalyzeImpactResp, error) {
+	resp, err := s.codeWiki.AnalyzeImpact(ctx, req)
+	if err != nil {
+		return &v1.AnalyzeImpactResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) GetFunctionTests(ctx context.Context, req *v1.GetFunctionTestsReq) (*v1.GetFunctionTestsResp, error) {
+	resp, err := s.codeWiki.GetFunctionTests(ctx, req)
+	if err != nil {
+		return &v1.GetFunctionTestsResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) GetPackageDependencies(ctx context.Context, req *v1.GetPackageDependenciesReq) (*v1.GetPackageDependenciesResp, error) {
+	resp, err := s.codeWiki.GetPackageDependencies(ctx, req)
+	if err != nil {
+		return &v1.GetPackageDependenciesResp{}, err
+	}
+	return resp, nil
+}
+
+func (s *CodeWikiService) GetArchitectureViolations(ctx context.Context, req *v1.GetArchitectureViolationsReq) (*v1.GetArchitectureViolationsResp, error) {
+	resp, err := s.codeWiki.GetArchitectureViolations(ctx, req)
+	if err != nil {
+		return &v1.GetArchitectureViolationsResp{}, err
+	}
 	return resp, nil
 }
 
 func (s *CodeWikiService) GetRepoTree(ctx context.Context, req *v1.GetRepoTreeReq) (*v1.GetRepoTreeResp, error) {
-	pkgs, files, err := s.codeWiki.GetRepoTree(ctx, req.Id)
+	pkgs, files, err := s.codeWiki.GetRepoTree(ctx, req.Id, req.Snapshot)
 	if err != nil {
 		return &v1.GetRepoTreeResp{}, err
 	}
@@ -99,7 +219,7 @@ func (s *CodeWikiService) ViewFileContent(ctx context.Context, req *v1.ViewFileR
 
 func (s *CodeWikiService) GetImplement(ctx context.Context, req *v1.GetImplementReq) (*v1.GetImplementResp, error) {
 	resp := new(v1.GetImplementResp)
-	entities, err := s.codeWiki.GetImplements(ctx, req.GetId())
+	entities, err := s.codeWiki.GetImplements(ctx, req.GetId(), req.GetSnapshot())
 	if err != nil {
 		return resp, err
 	}
@@ -118,6 +118,28 @@ export const fetchFunctionCalls = async (functionId: string, entityName?: string
   }
 };
 
+// 反向调用链：调用 functionId 的函数，返回结构与 fetchFunctionCalls 相同
+export const fetchFunctionCallers = async (
+  functionId: string,
+  options: { maxDepth?: number; stopAt?: Array<'EntrypointMain' | 'EntrypointHTTP' | 'EntrypointExported'>; followDispatch?: boolean } = {}
+): Promise<CallRelation[]> => {
+  if (!functionId || typeof functionId !== 'string') {
+    throw new Error('Invalid function ID');
+  }
+  const params = new URLSearchParams();
+  if (options.maxDepth) params.set('maxDepth', String(options.maxDepth));
+  (options.stopAt || []).forEach(kind => params.append('stopAt', kind));
+  if (options.followDispatch) params.set('followDispatch', 'true');
+  const response = await fetch(`${API_BASE_URL}/functions/${encodeURIComponent(functionId)}/callers?${params.toString()}`, {
+    method: 'GET',
+    headers: { 'Content-Type': 'application/json', 'Accept': 'application/json' },
+    credentials: 'include'
+  });
+  if (!response.ok) throw new Error('Failed to fetch function callers');
+  const data: ApiResponse = await response.json();
+  return data.callRelations || [];
+};
+
 // ---- Repo Management APIs ----
 
 export async function createRepo(req: CreateRepoReq): Promise<string> {